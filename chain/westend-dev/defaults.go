@@ -24,6 +24,9 @@ func DefaultConfig() *cfg.Config {
 	config.RPC.UnsafeRPC = true
 	config.RPC.WSExternal = true
 	config.RPC.UnsafeWSExternal = true
+	// the single dev authority finalises its best block without voting rules
+	config.Core.GrandpaBeforeBestBlockBy = 0
+	config.Core.GrandpaThreeQuarters = false
 
 	return config
 }
@@ -174,7 +174,7 @@ func addRootFlags(cmd *cobra.Command) error {
 		`Set a logging filter.
 	Syntax is a list of 'module=logLevel' (comma separated)
 	e.g. --log sync=debug,core=trace
-	Modules are global, core, digest, sync, network, rpc, state, runtime, babe, grandpa, wasmer.
+	Modules are global, core, digest, sync, network, rpc, state, runtime, babe, aura, grandpa, wasmer.
 	Log levels (least to most verbose) are error, warn, info, debug, and trace.
 	By default, all modules log 'info'.
 	The global log level can be set with --log global=debug`)
@@ -324,6 +324,14 @@ func addNetworkFlags(cmd *cobra.Command) error {
 		return fmt.Errorf("failed to add --protocol-id flag: %s", err)
 	}
 
+	if err := addStringFlagBindViper(cmd,
+		"fork-id",
+		config.Network.ForkID,
+		"Fork ID of the chain, overriding the fork id of the chain spec",
+		"network.fork-id"); err != nil {
+		return fmt.Errorf("failed to add --fork-id flag: %s", err)
+	}
+
 	if err := addBoolFlagBindViper(cmd,
 		"no-bootstrap",
 		config.Network.NoBootstrap,
@@ -363,6 +371,14 @@ func addNetworkFlags(cmd *cobra.Command) error {
 		return fmt.Errorf("failed to add --persistent-peers flag: %s", err)
 	}
 
+	if err := addBoolFlagBindViper(cmd,
+		"reserved-only",
+		config.Network.ReservedOnly,
+		"Only connect to and accept connections from the persistent and reserved peers",
+		"network.reserved-only"); err != nil {
+		return fmt.Errorf("failed to add --reserved-only flag: %s", err)
+	}
+
 	if err := addDurationFlagBindViper(cmd,
 		"discovery-interval",
 		config.Network.DiscoveryInterval,
@@ -371,6 +387,14 @@ func addNetworkFlags(cmd *cobra.Command) error {
 		return fmt.Errorf("failed to add --discovery-interval flag: %s", err)
 	}
 
+	if err := addDurationFlagBindViper(cmd,
+		"ban-duration",
+		config.Network.BanDuration,
+		"Duration of the ban of a misbehaving peer, 0 only rejects it until its reputation decays",
+		"network.ban-duration"); err != nil {
+		return fmt.Errorf("failed to add --ban-duration flag: %s", err)
+	}
+
 	if err := addStringFlagBindViper(cmd,
 		"public-ip",
 		config.Network.PublicIP,
@@ -398,11 +422,27 @@ func addNetworkFlags(cmd *cobra.Command) error {
 	if err := addStringFlagBindViper(cmd,
 		"listen-addr",
 		config.Network.ListenAddress,
-		"Overrides the listen address used for peer to peer networking",
+		"Overrides the comma separated listen addresses used for peer to peer networking",
 		"network.listen-addr"); err != nil {
 		return fmt.Errorf("failed to add --listen-addr flag: %s", err)
 	}
 
+	if err := addStringFlagBindViper(cmd,
+		"p2p-tls-cert",
+		config.Network.TLSCert,
+		"Path to the TLS certificate used by /wss peer to peer listen addresses",
+		"network.tls-cert"); err != nil {
+		return fmt.Errorf("failed to add --p2p-tls-cert flag: %s", err)
+	}
+
+	if err := addStringFlagBindViper(cmd,
+		"p2p-tls-key",
+		config.Network.TLSKey,
+		"Path to the TLS private key used by /wss peer to peer listen addresses",
+		"network.tls-key"); err != nil {
+		return fmt.Errorf("failed to add --p2p-tls-key flag: %s", err)
+	}
+
 	return nil
 }
 
@@ -477,6 +517,22 @@ func addRPCFlags(cmd *cobra.Command) error {
 		return fmt.Errorf("failed to add --ws-unsafe-external flag: %s", err)
 	}
 
+	if err := addStringFlagBindViper(cmd,
+		"ipc-path",
+		config.RPC.IPCPath,
+		"Path of the IPC unix socket serving all RPC methods, including unsafe ones",
+		"rpc.ipc-path"); err != nil {
+		return fmt.Errorf("failed to add --ipc-path flag: %s", err)
+	}
+
+	if err := addStringFlagBindViper(cmd,
+		"rpc-jwt-secret",
+		config.RPC.JWTSecretPath,
+		"Path of the file containing the hex encoded secret verifying JWTs for unsafe RPC methods",
+		"rpc.jwt-secret-path"); err != nil {
+		return fmt.Errorf("failed to add --rpc-jwt-secret flag: %s", err)
+	}
+
 	// dummy flag to conform with the substrate cli
 	cmd.Flags().String("rpc-cors",
 		"",
@@ -505,6 +561,30 @@ func addCoreFlags(cmd *cobra.Command) error {
 		return fmt.Errorf("failed to add --babe-authority flag: %s", err)
 	}
 
+	if err := addBoolFlagBindViper(cmd,
+		"babe-backoff-authoring",
+		config.Core.BabeBackoffAuthoring,
+		"Skip BABE authoring slots as the unfinalised chain grows",
+		"core.babe-backoff-authoring"); err != nil {
+		return fmt.Errorf("failed to add --babe-backoff-authoring flag: %s", err)
+	}
+
+	if err := addUintFlagBindViper(cmd,
+		"babe-equivocation-slots",
+		config.Core.BabeEquivocationSlots,
+		"Number of past slots whose block headers are kept to detect BABE equivocations",
+		"core.babe-equivocation-slots"); err != nil {
+		return fmt.Errorf("failed to add --babe-equivocation-slots flag: %s", err)
+	}
+
+	if err := addBoolFlagBindViper(cmd,
+		"aura-authority",
+		config.Core.AuraAuthority,
+		"Run as an Aura authority on the Aura chains",
+		"core.aura-authority"); err != nil {
+		return fmt.Errorf("failed to add --aura-authority flag: %s", err)
+	}
+
 	if err := addBoolFlagBindViper(cmd,
 		"grandpa-authority",
 		config.Core.GrandpaAuthority,
@@ -529,6 +609,30 @@ func addCoreFlags(cmd *cobra.Command) error {
 		return fmt.Errorf("failed to add --grandpa-interval flag: %s", err)
 	}
 
+	if err := addBoolFlagBindViper(cmd,
+		"grandpa-voter",
+		config.Core.GrandpaVoter,
+		"Vote with the finality-grandpa voter instead of the built-in GRANDPA round logic",
+		"core.grandpa-voter"); err != nil {
+		return fmt.Errorf("failed to add --grandpa-voter flag: %s", err)
+	}
+
+	if err := addUintFlagBindViper(cmd,
+		"grandpa-before-best-block-by",
+		config.Core.GrandpaBeforeBestBlockBy,
+		"Number of blocks the GRANDPA votes stay behind the best block, 0 disables the voting rule",
+		"core.grandpa-before-best-block-by"); err != nil {
+		return fmt.Errorf("failed to add --grandpa-before-best-block-by flag: %s", err)
+	}
+
+	if err := addBoolFlagBindViper(cmd,
+		"grandpa-three-quarters",
+		config.Core.GrandpaThreeQuarters,
+		"Restrict the GRANDPA votes to three quarters of the unfinalised chain",
+		"core.grandpa-three-quarters"); err != nil {
+		return fmt.Errorf("failed to add --grandpa-three-quarters flag: %s", err)
+	}
+
 	return nil
 }
 
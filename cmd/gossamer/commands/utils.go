@@ -272,6 +272,7 @@ func parseChainSpec(chain string) error {
 
 	config.Network.Bootnodes = spec.Bootnodes
 	config.Network.ProtocolID = spec.ProtocolID
+	config.Network.ForkID = spec.ForkID
 	parseIdentity()
 
 	return nil
@@ -512,6 +513,7 @@ func parseLogLevel() error {
 		"state":   config.Log.State,
 		"runtime": config.Log.Runtime,
 		"babe":    config.Log.Babe,
+		"aura":    config.Log.Aura,
 		"grandpa": config.Log.Grandpa,
 		"wasmer":  config.Log.Wasmer,
 	}
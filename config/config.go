@@ -37,6 +37,10 @@
 	DefaultRole = common.AuthorityRole
 	// DefaultWasmInterpreter is the default wasm interpreter
 	DefaultWasmInterpreter = wazero.Name
+	// DefaultGrandpaBeforeBestBlockBy is the default number of blocks GRANDPA votes stay behind the best block
+	DefaultGrandpaBeforeBestBlockBy = uint(2)
+	// DefaultBabeEquivocationSlots is the default number of past slots checked for BABE equivocations
+	DefaultBabeEquivocationSlots = uint(1000)
 
 	// DefaultNetworkPort is the default network port
 	DefaultNetworkPort = uint16(7001)
@@ -46,6 +50,9 @@
 	DefaultMinPeers = 5
 	// DefaultMaxPeers is the default maximum number of peers
 	DefaultMaxPeers = 50
+	// DefaultBanDuration is the default duration of the ban of a peer whose reputation falls
+	// below the banned threshold, zero keeping the peer rejected only until its reputation decays
+	DefaultBanDuration = time.Duration(0)
 
 	// DefaultRPCPort is the default RPC port
 	DefaultRPCPort = uint32(8545)
@@ -71,6 +78,8 @@
 	"state",
 	"rpc",
 	"grandpa",
+	"babe",
+	"beefy",
 	"offchain",
 	"childstate",
 	"syncstate",
@@ -154,6 +163,7 @@ type LogConfig struct {
 	State   string `mapstructure:"state,omitempty"`
 	Runtime string `mapstructure:"runtime,omitempty"`
 	Babe    string `mapstructure:"babe,omitempty"`
+	Aura    string `mapstructure:"aura,omitempty"`
 	Grandpa string `mapstructure:"grandpa,omitempty"`
 	Wasmer  string `mapstructure:"wasmer,omitempty"`
 }
@@ -169,16 +179,21 @@ type NetworkConfig struct {
 	Port              uint16        `mapstructure:"port"`
 	Bootnodes         []string      `mapstructure:"bootnodes"`
 	ProtocolID        string        `mapstructure:"protocol"`
+	ForkID            string        `mapstructure:"fork-id"`
 	NoBootstrap       bool          `mapstructure:"no-bootstrap"`
 	NoMDNS            bool          `mapstructure:"no-mdns"`
 	MinPeers          int           `mapstructure:"min-peers"`
 	MaxPeers          int           `mapstructure:"max-peers"`
 	PersistentPeers   []string      `mapstructure:"persistent-peers"`
+	ReservedOnly      bool          `mapstructure:"reserved-only"`
 	DiscoveryInterval time.Duration `mapstructure:"discovery-interval"`
+	BanDuration       time.Duration `mapstructure:"ban-duration"`
 	PublicIP          string        `mapstructure:"public-ip"`
 	PublicDNS         string        `mapstructure:"public-dns"`
 	NodeKey           string        `mapstructure:"node-key"`
 	ListenAddress     string        `mapstructure:"listen-addr"`
+	TLSCert           string        `mapstructure:"tls-cert"`
+	TLSKey            string        `mapstructure:"tls-key"`
 }
 
 // CoreConfig is to marshal/unmarshal toml core config vars
@@ -188,6 +203,19 @@ type CoreConfig struct {
 	GrandpaAuthority bool               `mapstructure:"grandpa-authority"`
 	WasmInterpreter  string             `mapstructure:"wasm-interpreter,omitempty"`
 	GrandpaInterval  time.Duration      `mapstructure:"grandpa-interval,omitempty"`
+	GrandpaVoter     bool               `mapstructure:"grandpa-voter"`
+	// GrandpaBeforeBestBlockBy is the number of blocks the GRANDPA votes stay behind
+	// the best block, 0 disabling the voting rule.
+	GrandpaBeforeBestBlockBy uint `mapstructure:"grandpa-before-best-block-by"`
+	// GrandpaThreeQuarters restricts the GRANDPA votes to three quarters of the unfinalised chain.
+	GrandpaThreeQuarters bool `mapstructure:"grandpa-three-quarters"`
+	// BabeBackoffAuthoring skips BABE authoring slots as the unfinalised chain grows.
+	BabeBackoffAuthoring bool `mapstructure:"babe-backoff-authoring"`
+	// BabeEquivocationSlots is the number of past slots whose block headers are kept
+	// to detect BABE equivocations.
+	BabeEquivocationSlots uint `mapstructure:"babe-equivocation-slots"`
+	// AuraAuthority authors the blocks of the chains whose consensus engine is Aura.
+	AuraAuthority bool `mapstructure:"aura-authority"`
 }
 
 // StateConfig contains the configuration for the state.
@@ -206,6 +234,9 @@ type RPCConfig struct {
 	WSPort            uint32   `mapstructure:"ws-port,omitempty"`
 	WSExternal        bool     `mapstructure:"ws-external,omitempty"`
 	UnsafeWSExternal  bool     `mapstructure:"unsafe-ws-external,omitempty"`
+	IPCPath           string   `mapstructure:"ipc-path,omitempty"`
+	JWTSecretPath     string   `mapstructure:"jwt-secret-path,omitempty"`
+	BearerTokens      []string `mapstructure:"bearer-tokens,omitempty"`
 }
 
 // PprofConfig contains the configuration for Pprof.
@@ -325,6 +356,11 @@ func (r *RPCConfig) IsWSEnabled() bool {
 	return r.WSExternal || r.UnsafeWSExternal
 }
 
+// IsIPCEnabled returns true if the IPC unix socket is enabled.
+func (r *RPCConfig) IsIPCEnabled() bool {
+	return r.IPCPath != ""
+}
+
 // DefaultConfig returns the default configuration.
 func DefaultConfig() *Config {
 	return &Config{
@@ -350,6 +386,7 @@ func DefaultConfig() *Config {
 			State:   DefaultLogLevel,
 			Runtime: DefaultLogLevel,
 			Babe:    DefaultLogLevel,
+			Aura:    DefaultLogLevel,
 			Grandpa: DefaultLogLevel,
 			Wasmer:  DefaultLogLevel,
 		},
@@ -358,22 +395,31 @@ func DefaultConfig() *Config {
 			Unlock: "",
 		},
 		Core: &CoreConfig{
-			Role:             DefaultRole,
-			BabeAuthority:    true,
-			GrandpaAuthority: true,
-			WasmInterpreter:  DefaultWasmInterpreter,
-			GrandpaInterval:  DefaultDiscoveryInterval,
+			Role:                     DefaultRole,
+			BabeAuthority:            true,
+			GrandpaAuthority:         true,
+			WasmInterpreter:          DefaultWasmInterpreter,
+			GrandpaInterval:          DefaultDiscoveryInterval,
+			GrandpaVoter:             false,
+			GrandpaBeforeBestBlockBy: DefaultGrandpaBeforeBestBlockBy,
+			GrandpaThreeQuarters:     true,
+			BabeBackoffAuthoring:     false,
+			BabeEquivocationSlots:    DefaultBabeEquivocationSlots,
+			AuraAuthority:            true,
 		},
 		Network: &NetworkConfig{
 			Port:              DefaultNetworkPort,
 			Bootnodes:         nil,
 			ProtocolID:        "/gossamer/gssmr/0",
+			ForkID:            "",
 			NoBootstrap:       false,
 			NoMDNS:            true,
 			MinPeers:          DefaultMinPeers,
 			MaxPeers:          DefaultMaxPeers,
 			PersistentPeers:   nil,
+			ReservedOnly:      false,
 			DiscoveryInterval: DefaultDiscoveryInterval,
+			BanDuration:       DefaultBanDuration,
 			PublicIP:          "",
 			PublicDNS:         "",
 			NodeKey:           "",
@@ -431,6 +477,7 @@ func DefaultConfigFromSpec(nodeSpec *genesis.Genesis) *Config {
 			State:   DefaultLogLevel,
 			Runtime: DefaultLogLevel,
 			Babe:    DefaultLogLevel,
+			Aura:    DefaultLogLevel,
 			Grandpa: DefaultLogLevel,
 			Wasmer:  DefaultLogLevel,
 		},
@@ -439,22 +486,31 @@ func DefaultConfigFromSpec(nodeSpec *genesis.Genesis) *Config {
 			Unlock: "",
 		},
 		Core: &CoreConfig{
-			Role:             DefaultRole,
-			BabeAuthority:    true,
-			GrandpaAuthority: true,
-			WasmInterpreter:  DefaultWasmInterpreter,
-			GrandpaInterval:  DefaultDiscoveryInterval,
+			Role:                     DefaultRole,
+			BabeAuthority:            true,
+			GrandpaAuthority:         true,
+			WasmInterpreter:          DefaultWasmInterpreter,
+			GrandpaInterval:          DefaultDiscoveryInterval,
+			GrandpaVoter:             false,
+			GrandpaBeforeBestBlockBy: DefaultGrandpaBeforeBestBlockBy,
+			GrandpaThreeQuarters:     true,
+			BabeBackoffAuthoring:     false,
+			BabeEquivocationSlots:    DefaultBabeEquivocationSlots,
+			AuraAuthority:            true,
 		},
 		Network: &NetworkConfig{
 			Port:              DefaultNetworkPort,
 			Bootnodes:         nodeSpec.Bootnodes,
 			ProtocolID:        nodeSpec.ProtocolID,
+			ForkID:            nodeSpec.ForkID,
 			NoBootstrap:       false,
 			NoMDNS:            false,
 			MinPeers:          DefaultMinPeers,
 			MaxPeers:          DefaultMaxPeers,
 			PersistentPeers:   nil,
+			ReservedOnly:      false,
 			DiscoveryInterval: DefaultDiscoveryInterval,
+			BanDuration:       DefaultBanDuration,
 			PublicIP:          "",
 			PublicDNS:         "",
 			NodeKey:           "",
@@ -512,6 +568,7 @@ func Copy(c *Config) Config {
 			State:   c.Log.State,
 			Runtime: c.Log.Runtime,
 			Babe:    c.Log.Babe,
+			Aura:    c.Log.Aura,
 			Grandpa: c.Log.Grandpa,
 			Wasmer:  c.Log.Wasmer,
 		},
@@ -520,26 +577,37 @@ func Copy(c *Config) Config {
 			Unlock: c.Account.Unlock,
 		},
 		Core: &CoreConfig{
-			Role:             c.Core.Role,
-			BabeAuthority:    c.Core.BabeAuthority,
-			GrandpaAuthority: c.Core.GrandpaAuthority,
-			WasmInterpreter:  c.Core.WasmInterpreter,
-			GrandpaInterval:  c.Core.GrandpaInterval,
+			Role:                     c.Core.Role,
+			BabeAuthority:            c.Core.BabeAuthority,
+			GrandpaAuthority:         c.Core.GrandpaAuthority,
+			WasmInterpreter:          c.Core.WasmInterpreter,
+			GrandpaInterval:          c.Core.GrandpaInterval,
+			GrandpaVoter:             c.Core.GrandpaVoter,
+			GrandpaBeforeBestBlockBy: c.Core.GrandpaBeforeBestBlockBy,
+			GrandpaThreeQuarters:     c.Core.GrandpaThreeQuarters,
+			BabeBackoffAuthoring:     c.Core.BabeBackoffAuthoring,
+			BabeEquivocationSlots:    c.Core.BabeEquivocationSlots,
+			AuraAuthority:            c.Core.AuraAuthority,
 		},
 		Network: &NetworkConfig{
 			Port:              c.Network.Port,
 			Bootnodes:         c.Network.Bootnodes,
 			ProtocolID:        c.Network.ProtocolID,
+			ForkID:            c.Network.ForkID,
 			NoBootstrap:       c.Network.NoBootstrap,
 			NoMDNS:            c.Network.NoMDNS,
 			MinPeers:          c.Network.MinPeers,
 			MaxPeers:          c.Network.MaxPeers,
 			PersistentPeers:   c.Network.PersistentPeers,
+			ReservedOnly:      c.Network.ReservedOnly,
 			DiscoveryInterval: c.Network.DiscoveryInterval,
+			BanDuration:       c.Network.BanDuration,
 			PublicIP:          c.Network.PublicIP,
 			PublicDNS:         c.Network.PublicDNS,
 			NodeKey:           c.Network.NodeKey,
 			ListenAddress:     c.Network.ListenAddress,
+			TLSCert:           c.Network.TLSCert,
+			TLSKey:            c.Network.TLSKey,
 		},
 		State: &StateConfig{
 			Rewind: c.State.Rewind,
@@ -554,6 +622,9 @@ func Copy(c *Config) Config {
 			WSPort:            c.RPC.WSPort,
 			WSExternal:        c.RPC.WSExternal,
 			UnsafeWSExternal:  c.RPC.UnsafeWSExternal,
+			IPCPath:           c.RPC.IPCPath,
+			JWTSecretPath:     c.RPC.JWTSecretPath,
+			BearerTokens:      c.RPC.BearerTokens,
 		},
 		Pprof: &PprofConfig{
 			Enabled:          c.Pprof.Enabled,
@@ -149,6 +149,9 @@ func WriteConfigFile(basePath string, config *Config) error {
 # BABE module log level
 babe = "{{ .Log.Babe }}"
 
+# Aura module log level
+aura = "{{ .Log.Aura }}"
+
 # GRANDPA module log level
 grandpa = "{{ .Log.Grandpa }}"
 
@@ -182,6 +185,9 @@ func WriteConfigFile(basePath string, config *Config) error {
 # Protocol ID to use
 protocol-id = "{{ .Network.ProtocolID }}"
 
+# Fork ID of the chain, set by chains which fork from a shared genesis
+fork-id = "{{ .Network.ForkID }}"
+
 # Disables network bootstrapping (mDNS still enabled)
 # Defaults to false
 no-bootstrap = {{ .Network.NoBootstrap }}
@@ -201,10 +207,20 @@ func WriteConfigFile(basePath string, config *Config) error {
 # Comma separated list of peers to always keep connected to
 persistent-peers = "{{ StringsJoin .Network.PersistentPeers ", " }}"
 
+# Only connect to and accept connections from the persistent and reserved peers
+reserved-only = {{ .Network.ReservedOnly }}
+
 # Interval to perform peer discovery in duration
 # Format: "10s", "1m", "1h"
 discovery-interval = "{{ .Network.DiscoveryInterval }}"
 
+# Duration of the ban of a peer whose reputation falls below the banned threshold,
+# also used for manual bans without a duration.
+# If zero, the peer is only rejected until its reputation decays back above the threshold,
+# and manual bans without a duration last one hour.
+# Format: "10s", "1m", "1h"
+ban-duration = "{{ .Network.BanDuration }}"
+
 # Overrides the public IP address used for peer to peer networking"
 public-ip = "{{ .Network.PublicIP }}"
 
@@ -214,9 +230,17 @@ func WriteConfigFile(basePath string, config *Config) error {
 # Overrides the secret Ed25519 key to use for libp2p networking
 node-key = "{{ .Network.NodeKey }}"
 
-# Multiaddress to listen on
+# Comma separated list of multiaddresses to listen on
+# Use /ws or /wss addresses to accept connections from browser light clients
+# Example: "/ip4/0.0.0.0/tcp/7001,/ip4/0.0.0.0/tcp/7002/ws,/ip4/0.0.0.0/tcp/7003/wss"
 listen-addr = "{{ .Network.ListenAddress }}"
 
+# Path to the TLS certificate used by /wss listen addresses
+tls-cert = "{{ .Network.TLSCert }}"
+
+# Path to the TLS private key used by /wss listen addresses
+tls-key = "{{ .Network.TLSKey }}"
+
 #######################################################
 ###             Core Configuration Options          ###
 #######################################################
@@ -231,6 +255,18 @@ func WriteConfigFile(basePath string, config *Config) error {
 # Defaults to true
 babe-authority = {{ .Core.BabeAuthority }}
 
+# Skip BABE authoring slots as the unfinalised chain grows
+# Defaults to false
+babe-backoff-authoring = {{ .Core.BabeBackoffAuthoring }}
+
+# Number of past slots whose block headers are kept to detect BABE equivocations
+# Defaults to 1000
+babe-equivocation-slots = {{ .Core.BabeEquivocationSlots }}
+
+# Enable Aura authoring on the Aura chains
+# Defaults to true
+aura-authority = {{ .Core.AuraAuthority }}
+
 # Enable GRANDPA authoring
 # Defaults to true
 grandpa-authority = {{ .Core.GrandpaAuthority }}
@@ -242,6 +278,18 @@ func WriteConfigFile(basePath string, config *Config) error {
 # Grandpa interval
 grandpa-interval = "{{ .Core.GrandpaInterval }}"
 
+# Vote with the finality-grandpa voter instead of the built-in GRANDPA round logic
+# Defaults to false
+grandpa-voter = {{ .Core.GrandpaVoter }}
+
+# Number of blocks the GRANDPA votes stay behind the best block, 0 disables the voting rule
+# Defaults to 2
+grandpa-before-best-block-by = {{ .Core.GrandpaBeforeBestBlockBy }}
+
+# Restrict the GRANDPA votes to three quarters of the unfinalised chain
+# Defaults to true
+grandpa-three-quarters = {{ .Core.GrandpaThreeQuarters }}
+
 #######################################################
 ###            State Configuration Options          ###
 #######################################################
@@ -291,6 +339,22 @@ func WriteConfigFile(basePath string, config *Config) error {
 # Defaults to false
 unsafe-ws-external = {{ .RPC.UnsafeWSExternal }}
 
+# Path of the IPC unix socket serving all RPC methods, including unsafe ones
+# Access is restricted to the node user by the socket file permissions
+# Defaults to "" (disabled)
+ipc-path = "{{ .RPC.IPCPath }}"
+
+# Path of the file containing the hex encoded 32 bytes secret used to verify
+# the HS256 JWTs authenticating calls to unsafe methods
+# Defaults to "" (disabled)
+jwt-secret-path = "{{ .RPC.JWTSecretPath }}"
+
+# Static bearer tokens authenticating calls to unsafe methods
+# When a JWT secret or bearer tokens are set, unsafe methods require an
+# "Authorization: Bearer <token>" header on HTTP and WebSocket connections
+# Defaults to [] (disabled)
+bearer-tokens = [{{ range .RPC.BearerTokens }}"{{ . }}", {{ end }}]
+
 #######################################################
 ###            PPROF Configuration Options          ###
 #######################################################
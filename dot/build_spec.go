@@ -26,12 +26,14 @@ type BuildSpec struct {
 // ToJSON outputs genesis JSON in human-readable form
 func (b *BuildSpec) ToJSON() ([]byte, error) {
 	tmpGen := &genesis.Genesis{
-		Name:       b.genesis.Name,
-		ID:         b.genesis.ID,
-		ChainType:  b.genesis.ChainType,
-		Bootnodes:  b.genesis.Bootnodes,
-		ProtocolID: b.genesis.ProtocolID,
-		Properties: b.genesis.Properties,
+		Name:            b.genesis.Name,
+		ID:              b.genesis.ID,
+		ChainType:       b.genesis.ChainType,
+		Bootnodes:       b.genesis.Bootnodes,
+		ProtocolID:      b.genesis.ProtocolID,
+		ForkID:          b.genesis.ForkID,
+		Properties:      b.genesis.Properties,
+		ConsensusEngine: b.genesis.ConsensusEngine,
 		Genesis: genesis.Fields{
 			Runtime: b.genesis.GenesisFields().Runtime,
 		},
@@ -42,12 +44,14 @@ func (b *BuildSpec) ToJSON() ([]byte, error) {
 // ToJSONRaw outputs genesis JSON in raw form
 func (b *BuildSpec) ToJSONRaw() ([]byte, error) {
 	tmpGen := &genesis.Genesis{
-		Name:       b.genesis.Name,
-		ID:         b.genesis.ID,
-		ChainType:  b.genesis.ChainType,
-		Bootnodes:  b.genesis.Bootnodes,
-		ProtocolID: b.genesis.ProtocolID,
-		Properties: b.genesis.Properties,
+		Name:            b.genesis.Name,
+		ID:              b.genesis.ID,
+		ChainType:       b.genesis.ChainType,
+		Bootnodes:       b.genesis.Bootnodes,
+		ProtocolID:      b.genesis.ProtocolID,
+		ForkID:          b.genesis.ForkID,
+		Properties:      b.genesis.Properties,
+		ConsensusEngine: b.genesis.ConsensusEngine,
 		Genesis: genesis.Fields{
 			Raw: b.genesis.GenesisFields().Raw,
 		},
@@ -139,6 +143,8 @@ func BuildFromDB(path string) (*BuildSpec, error) {
 	tmpGen.ID = gData.ID
 	tmpGen.Bootnodes = common.BytesToStringArray(gData.Bootnodes)
 	tmpGen.ProtocolID = gData.ProtocolID
+	tmpGen.ForkID = gData.ForkID
+	tmpGen.ConsensusEngine = gData.ConsensusEngine
 
 	bs := &BuildSpec{
 		genesis: tmpGen,
@@ -67,7 +67,7 @@ func TestBuildSpec_ToJSON(t *testing.T) {
     "properties": null,
     "forkBlocks": null,
     "badBlocks": null,
-    "consensusEngine": "",
+    "consensusEngine": "babe",
     "codeSubstitutes": null
 }`,
 		},
@@ -106,7 +106,7 @@ func TestBuildSpec_ToJSON(t *testing.T) {
     },
     "forkBlocks": null,
     "badBlocks": null,
-    "consensusEngine": "",
+    "consensusEngine": "babe",
     "codeSubstitutes": null
 }`,
 		},
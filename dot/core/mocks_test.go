@@ -1,9 +1,9 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: github.com/ChainSafe/gossamer/dot/core (interfaces: BlockState,StorageState,TransactionState,Network,CodeSubstitutedState,Telemetry,BlockImportDigestHandler,GrandpaState)
+// Source: github.com/ChainSafe/gossamer/dot/core (interfaces: BlockState,StorageState,TransactionState,Network,CodeSubstitutedState,Telemetry,BlockImportDigestHandler,GrandpaState,EpochState)
 //
 // Generated by this command:
 //
-//	mockgen -destination=mocks_test.go -package core . BlockState,StorageState,TransactionState,Network,CodeSubstitutedState,Telemetry,BlockImportDigestHandler,GrandpaState
+//	mockgen -destination=mocks_test.go -package core . BlockState,StorageState,TransactionState,Network,CodeSubstitutedState,Telemetry,BlockImportDigestHandler,GrandpaState,EpochState
 //
 
 // Package core is a generated GoMock package.
@@ -636,3 +636,56 @@ func (mr *MockGrandpaStateMockRecorder) ApplyForcedChanges(arg0 any) *gomock.Cal
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApplyForcedChanges", reflect.TypeOf((*MockGrandpaState)(nil).ApplyForcedChanges), arg0)
 }
+
+// MockEpochState is a mock of EpochState interface.
+type MockEpochState struct {
+	ctrl     *gomock.Controller
+	recorder *MockEpochStateMockRecorder
+	isgomock struct{}
+}
+
+// MockEpochStateMockRecorder is the mock recorder for MockEpochState.
+type MockEpochStateMockRecorder struct {
+	mock *MockEpochState
+}
+
+// NewMockEpochState creates a new mock instance.
+func NewMockEpochState(ctrl *gomock.Controller) *MockEpochState {
+	mock := &MockEpochState{ctrl: ctrl}
+	mock.recorder = &MockEpochStateMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockEpochState) EXPECT() *MockEpochStateMockRecorder {
+	return m.recorder
+}
+
+// GetEpochForBlock mocks base method.
+func (m *MockEpochState) GetEpochForBlock(arg0 *types.Header) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEpochForBlock", arg0)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEpochForBlock indicates an expected call of GetEpochForBlock.
+func (mr *MockEpochStateMockRecorder) GetEpochForBlock(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEpochForBlock", reflect.TypeOf((*MockEpochState)(nil).GetEpochForBlock), arg0)
+}
+
+// UpdateSkippedEpochDefinitions mocks base method.
+func (m *MockEpochState) UpdateSkippedEpochDefinitions(arg0, arg1 uint64, arg2 *types.Header) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateSkippedEpochDefinitions", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateSkippedEpochDefinitions indicates an expected call of UpdateSkippedEpochDefinitions.
+func (mr *MockEpochStateMockRecorder) UpdateSkippedEpochDefinitions(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSkippedEpochDefinitions", reflect.TypeOf((*MockEpochState)(nil).UpdateSkippedEpochDefinitions), arg0, arg1, arg2)
+}
@@ -21,6 +21,7 @@
 	wazero_runtime "github.com/ChainSafe/gossamer/lib/runtime/wazero"
 	"github.com/ChainSafe/gossamer/lib/transaction"
 	"github.com/ChainSafe/gossamer/pkg/trie"
+	"github.com/ChainSafe/gossamer/pkg/trie/inmemory"
 
 	cscale "github.com/centrifuge/go-substrate-rpc-client/v4/scale"
 	ctypes "github.com/centrifuge/go-substrate-rpc-client/v4/types"
@@ -153,7 +154,8 @@ func (s *Service) StorageRoot() (common.Hash, error) {
 // HandleBlockImport handles a block that was imported via the network
 func (s *Service) HandleBlockImport(block *types.Block, state *rtstorage.TrieState, announce bool) error {
 	parentHash := block.Header.ParentHash
-	if parentHash != s.blockState.GenesisHash() {
+	// Aura has no epochs, so the epochs skipped by the Aura blocks are not recorded.
+	if parentHash != s.blockState.GenesisHash() && !types.IsAuraHeader(&block.Header) {
 		parentHeader, err := s.blockState.GetHeader(parentHash)
 		if err != nil {
 			return fmt.Errorf("getting parent header: %w", err)
@@ -622,6 +624,65 @@ func (s *Service) GetMetadata(bhash *common.Hash) (metadata []byte, err error) {
 	return rt.Metadata()
 }
 
+// DryRun applies the extrinsic given on top of the state of the block hash given, or of the best
+// block if the block hash is nil, and returns the SCALE encoded ApplyExtrinsicResult.
+// The state changes are applied on a throwaway trie state and are never stored.
+func (s *Service) DryRun(ext types.Extrinsic, bhash *common.Hash) (applyExtrinsicResult []byte, err error) {
+	rt, err := prepareRuntime(bhash, s.storageState, s.blockState)
+	if err != nil {
+		return nil, fmt.Errorf("setting up runtime: %w", err)
+	}
+
+	applyExtrinsicResult, err = rt.ApplyExtrinsic(ext)
+	if err != nil {
+		return nil, fmt.Errorf("applying extrinsic: %w", err)
+	}
+
+	return applyExtrinsicResult, nil
+}
+
+// AccountNonce returns the nonce of the account given using the AccountNonceApi runtime API
+// at the state of the block hash given, or of the best block if the block hash is nil.
+// It returns an error wrapping runtime.ErrAPINotFound if the runtime does not expose the API.
+func (s *Service) AccountNonce(accountID []byte, bhash *common.Hash) (nonce uint64, err error) {
+	rt, err := prepareRuntime(bhash, s.storageState, s.blockState)
+	if err != nil {
+		return 0, fmt.Errorf("setting up runtime: %w", err)
+	}
+
+	version, err := rt.Version()
+	if err != nil {
+		return 0, fmt.Errorf("getting runtime version: %w", err)
+	}
+
+	if !version.HasAPI(runtime.AccountNonceAPI) {
+		return 0, fmt.Errorf("%w: %s", runtime.ErrAPINotFound, runtime.AccountNonceAPI)
+	}
+
+	nonce, err = rt.AccountNonce(accountID)
+	if err != nil {
+		return 0, fmt.Errorf("getting account nonce: %w", err)
+	}
+
+	return nonce, nil
+}
+
+// AuthorityDiscoveryAuthorities returns the authority discovery keys of the authority set
+// at the best block, using the AuthorityDiscoveryApi runtime API.
+func (s *Service) AuthorityDiscoveryAuthorities() (authorities []types.AuthorityID, err error) {
+	rt, err := prepareRuntime(nil, s.storageState, s.blockState)
+	if err != nil {
+		return nil, fmt.Errorf("setting up runtime: %w", err)
+	}
+
+	authorities, err = rt.AuthorityDiscoveryAuthorities()
+	if err != nil {
+		return nil, fmt.Errorf("getting authority discovery authorities: %w", err)
+	}
+
+	return authorities, nil
+}
+
 // GetReadProofAt will return an array with the proofs for the keys passed as params
 // based on the block hash passed as param as well, if block hash is nil then the current state will take place
 func (s *Service) GetReadProofAt(block common.Hash, keys [][]byte) (
@@ -643,6 +704,44 @@ func (s *Service) GetReadProofAt(block common.Hash, keys [][]byte) (
 	return block, proofForKeys, nil
 }
 
+// GetChildReadProofAt returns the proof for the keys of the child trie stored at the child storage key
+// given. The proof contains the main trie nodes leading to the child trie root followed by the child
+// trie nodes for each of the keys. If block hash is empty then the best block state is used.
+func (s *Service) GetChildReadProofAt(block common.Hash, childStorageKey []byte, keys [][]byte) (
+	hash common.Hash, proofForKeys [][]byte, err error) {
+	if block.IsEmpty() {
+		block = s.blockState.BestBlockHash()
+	}
+
+	stateRoot, err := s.blockState.GetBlockStateRoot(block)
+	if err != nil {
+		return hash, nil, err
+	}
+
+	trieState, err := s.storageState.TrieState(&stateRoot)
+	if err != nil {
+		return hash, nil, fmt.Errorf("getting trie state: %w", err)
+	}
+
+	childRoot, err := trieState.GetChildRoot(childStorageKey)
+	if err != nil {
+		return hash, nil, fmt.Errorf("getting child trie root: %w", err)
+	}
+
+	childTrieKey := bytes.Join([][]byte{inmemory.ChildStorageKeyPrefix, childStorageKey}, nil)
+	proofForKeys, err = s.storageState.GenerateTrieProof(stateRoot, [][]byte{childTrieKey})
+	if err != nil {
+		return hash, nil, fmt.Errorf("generating proof for child trie root: %w", err)
+	}
+
+	childProofForKeys, err := s.storageState.GenerateTrieProof(childRoot, keys)
+	if err != nil {
+		return hash, nil, fmt.Errorf("generating child trie proof: %w", err)
+	}
+
+	return block, append(proofForKeys, childProofForKeys...), nil
+}
+
 // buildExternalTransaction builds an external transaction based on the current transaction queue API version
 // See https://github.com/paritytech/substrate/blob/polkadot-v0.9.25/primitives/transaction-pool/src/runtime_api.rs#L25-L55
 func (s *Service) buildExternalTransaction(rt runtime.Instance, ext types.Extrinsic) (types.Extrinsic, error) {
@@ -595,6 +595,90 @@ func Test_Service_HandleBlockProduced(t *testing.T) {
 	})
 }
 
+func Test_Service_HandleBlockImport(t *testing.T) {
+	t.Parallel()
+
+	genesisHash := common.Hash{1}
+	parentHeader := types.NewEmptyHeader()
+	parentHeader.ParentHash = genesisHash
+	parentHeader.Number = 1
+
+	newBlock := func(t *testing.T, preDigest types.PreRuntimeDigest) *types.Block {
+		t.Helper()
+		digest := types.NewDigest()
+		err := digest.Add(preDigest)
+		require.NoError(t, err)
+		header := types.NewHeader(parentHeader.Hash(), common.Hash{}, common.Hash{}, 2, digest)
+		block := types.NewBlock(*header, *types.NewBody(nil))
+		return &block
+	}
+
+	testCases := map[string]struct {
+		block              *types.Block
+		epochStateBuilder  func(ctrl *gomock.Controller, block *types.Block) EpochState
+		blockStateExpected func(mockBlockState *MockBlockState)
+	}{
+		"babe_block_in_skipped_epoch": {
+			block: newBlock(t, *types.NewBABEPreRuntimeDigest(
+				common.MustHexToBytes("0x0201000000ef55a50f00000000"))),
+			epochStateBuilder: func(ctrl *gomock.Controller, block *types.Block) EpochState {
+				mockEpochState := NewMockEpochState(ctrl)
+				mockEpochState.EXPECT().GetEpochForBlock(parentHeader).Return(uint64(0), nil)
+				mockEpochState.EXPECT().GetEpochForBlock(&block.Header).Return(uint64(2), nil)
+				mockEpochState.EXPECT().UpdateSkippedEpochDefinitions(uint64(1), uint64(2), &block.Header).
+					Return(nil)
+				return mockEpochState
+			},
+			blockStateExpected: func(mockBlockState *MockBlockState) {
+				mockBlockState.EXPECT().GetHeader(parentHeader.Hash()).Return(parentHeader, nil)
+			},
+		},
+		"aura_block_after_more_than_an_epoch_length_of_slots": {
+			block: newBlock(t, *types.NewAuraPreRuntimeDigest(2 * types.AuraEpochLength)),
+			epochStateBuilder: func(ctrl *gomock.Controller, _ *types.Block) EpochState {
+				return NewMockEpochState(ctrl)
+			},
+			blockStateExpected: func(*MockBlockState) {},
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+
+			block := testCase.block
+			trieState := rtstorage.NewTrieState(inmemory_trie.NewEmptyTrie())
+			runtimeMock := NewMockInstance(ctrl)
+			mockStorageState := NewMockStorageState(ctrl)
+			mockStorageState.EXPECT().StoreTrie(trieState, &block.Header).Return(nil)
+			mockBlockState := NewMockBlockState(ctrl)
+			mockBlockState.EXPECT().GenesisHash().Return(genesisHash)
+			testCase.blockStateExpected(mockBlockState)
+			mockBlockState.EXPECT().AddBlock(block).Return(nil)
+			mockBlockState.EXPECT().GetRuntime(block.Header.ParentHash).Return(runtimeMock, nil)
+			mockBlockState.EXPECT().HandleRuntimeChanges(trieState, runtimeMock, block.Header.Hash()).Return(nil)
+			onBlockImportHandlerMock := NewMockBlockImportDigestHandler(ctrl)
+			onBlockImportHandlerMock.EXPECT().HandleDigests(&block.Header).Return(nil)
+			mockGrandpaState := NewMockGrandpaState(ctrl)
+			mockGrandpaState.EXPECT().ApplyForcedChanges(&block.Header).Return(nil)
+
+			service := &Service{
+				storageState:  mockStorageState,
+				blockState:    mockBlockState,
+				epochState:    testCase.epochStateBuilder(ctrl, block),
+				grandpaState:  mockGrandpaState,
+				ctx:           context.Background(),
+				onBlockImport: onBlockImportHandlerMock,
+			}
+
+			err := service.HandleBlockImport(block, trieState, false)
+			require.NoError(t, err)
+		})
+	}
+}
+
 func Test_Service_maintainTransactionPool(t *testing.T) {
 	t.Parallel()
 	t.Run("Validate_Transaction_err", func(t *testing.T) {
@@ -1443,6 +1527,67 @@ func TestServiceGetMetadata(t *testing.T) {
 	})
 }
 
+func TestService_DryRun(t *testing.T) {
+	t.Parallel()
+
+	ext := types.Extrinsic{1, 2, 3}
+
+	t.Run("get_state_root_error", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		mockStorageState := NewMockStorageState(ctrl)
+		mockStorageState.EXPECT().GetStateRootFromBlock(&common.Hash{1}).Return(nil, errDummyErr)
+		service := &Service{
+			storageState: mockStorageState,
+		}
+		res, err := service.DryRun(ext, &common.Hash{1})
+		assert.ErrorIs(t, err, errDummyErr)
+		assert.EqualError(t, err, "setting up runtime: getting state root from block hash: dummy error for testing")
+		assert.Nil(t, res)
+	})
+
+	t.Run("apply_extrinsic_error", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		mockStorageState := NewMockStorageState(ctrl)
+		mockStorageState.EXPECT().TrieState(nil).Return(&rtstorage.TrieState{}, nil)
+		runtimeMock := NewMockInstance(ctrl)
+		mockBlockState := NewMockBlockState(ctrl)
+		mockBlockState.EXPECT().BestBlockHash().Return(common.Hash{1})
+		mockBlockState.EXPECT().GetRuntime(common.Hash{1}).Return(runtimeMock, nil)
+		runtimeMock.EXPECT().SetContextStorage(&rtstorage.TrieState{})
+		runtimeMock.EXPECT().ApplyExtrinsic(ext).Return(nil, errDummyErr)
+		service := &Service{
+			storageState: mockStorageState,
+			blockState:   mockBlockState,
+		}
+		res, err := service.DryRun(ext, nil)
+		assert.ErrorIs(t, err, errDummyErr)
+		assert.EqualError(t, err, "applying extrinsic: dummy error for testing")
+		assert.Nil(t, res)
+	})
+
+	t.Run("happy_path", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		mockStorageState := NewMockStorageState(ctrl)
+		mockStorageState.EXPECT().TrieState(nil).Return(&rtstorage.TrieState{}, nil)
+		runtimeMock := NewMockInstance(ctrl)
+		mockBlockState := NewMockBlockState(ctrl)
+		mockBlockState.EXPECT().BestBlockHash().Return(common.Hash{1})
+		mockBlockState.EXPECT().GetRuntime(common.Hash{1}).Return(runtimeMock, nil)
+		runtimeMock.EXPECT().SetContextStorage(&rtstorage.TrieState{})
+		runtimeMock.EXPECT().ApplyExtrinsic(ext).Return([]byte{0, 0}, nil)
+		service := &Service{
+			storageState: mockStorageState,
+			blockState:   mockBlockState,
+		}
+		res, err := service.DryRun(ext, nil)
+		require.NoError(t, err)
+		assert.Equal(t, []byte{0, 0}, res)
+	})
+}
+
 func TestService_GetReadProofAt(t *testing.T) {
 	t.Parallel()
 	execTest := func(t *testing.T, s *Service, block common.Hash, keys [][]byte,
@@ -1500,3 +1645,143 @@ func TestService_GetReadProofAt(t *testing.T) {
 		execTest(t, service, common.Hash{}, [][]byte{{1}}, common.Hash{2}, [][]byte{{2}}, nil)
 	})
 }
+
+func TestService_GetChildReadProofAt(t *testing.T) {
+	t.Parallel()
+
+	childStorageKey := []byte(":child_storage_key")
+	trieState := rtstorage.NewTrieState(inmemory_trie.NewEmptyTrie())
+	err := trieState.SetChildStorage(childStorageKey, []byte{1}, []byte{2})
+	require.NoError(t, err)
+	childRoot, err := trieState.GetChildRoot(childStorageKey)
+	require.NoError(t, err)
+	childTrieKey := append([]byte(":child_storage:default:"), childStorageKey...)
+
+	t.Run("get_block_state_root_error", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		mockBlockState := NewMockBlockState(ctrl)
+		mockBlockState.EXPECT().BestBlockHash().Return(common.Hash{2})
+		mockBlockState.EXPECT().GetBlockStateRoot(common.Hash{2}).Return(common.Hash{}, errDummyErr)
+		service := &Service{
+			blockState: mockBlockState,
+		}
+		hash, proof, err := service.GetChildReadProofAt(common.Hash{}, childStorageKey, nil)
+		assert.ErrorIs(t, err, errDummyErr)
+		assert.Equal(t, common.Hash{}, hash)
+		assert.Nil(t, proof)
+	})
+
+	t.Run("child_trie_not_found", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		mockBlockState := NewMockBlockState(ctrl)
+		mockBlockState.EXPECT().GetBlockStateRoot(common.Hash{2}).Return(common.Hash{3}, nil)
+		mockStorageState := NewMockStorageState(ctrl)
+		mockStorageState.EXPECT().TrieState(&common.Hash{3}).
+			Return(rtstorage.NewTrieState(inmemory_trie.NewEmptyTrie()), nil)
+		service := &Service{
+			blockState:   mockBlockState,
+			storageState: mockStorageState,
+		}
+		_, _, err := service.GetChildReadProofAt(common.Hash{2}, childStorageKey, [][]byte{{1}})
+		assert.ErrorContains(t, err, "getting child trie root")
+	})
+
+	t.Run("happy_path", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		mockBlockState := NewMockBlockState(ctrl)
+		mockBlockState.EXPECT().GetBlockStateRoot(common.Hash{2}).Return(common.Hash{3}, nil)
+		mockStorageState := NewMockStorageState(ctrl)
+		mockStorageState.EXPECT().TrieState(&common.Hash{3}).Return(trieState, nil)
+		mockStorageState.EXPECT().GenerateTrieProof(common.Hash{3}, [][]byte{childTrieKey}).
+			Return([][]byte{{4}}, nil)
+		mockStorageState.EXPECT().GenerateTrieProof(childRoot, [][]byte{{1}}).
+			Return([][]byte{{5}}, nil)
+		service := &Service{
+			blockState:   mockBlockState,
+			storageState: mockStorageState,
+		}
+		hash, proof, err := service.GetChildReadProofAt(common.Hash{2}, childStorageKey, [][]byte{{1}})
+		require.NoError(t, err)
+		assert.Equal(t, common.Hash{2}, hash)
+		assert.Equal(t, [][]byte{{4}, {5}}, proof)
+	})
+}
+
+func TestService_AccountNonce(t *testing.T) {
+	t.Parallel()
+
+	accountID := []byte{1, 2, 3}
+	accountNonceAPIName, err := common.Blake2b8([]byte(runtime.AccountNonceAPI))
+	require.NoError(t, err)
+
+	t.Run("api_not_found", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		mockStorageState := NewMockStorageState(ctrl)
+		mockStorageState.EXPECT().TrieState(nil).Return(&rtstorage.TrieState{}, nil)
+		runtimeMock := NewMockInstance(ctrl)
+		mockBlockState := NewMockBlockState(ctrl)
+		mockBlockState.EXPECT().BestBlockHash().Return(common.Hash{1})
+		mockBlockState.EXPECT().GetRuntime(common.Hash{1}).Return(runtimeMock, nil)
+		runtimeMock.EXPECT().SetContextStorage(&rtstorage.TrieState{})
+		runtimeMock.EXPECT().Version().Return(runtime.Version{}, nil)
+		service := &Service{
+			storageState: mockStorageState,
+			blockState:   mockBlockState,
+		}
+		nonce, err := service.AccountNonce(accountID, nil)
+		assert.ErrorIs(t, err, runtime.ErrAPINotFound)
+		assert.EqualError(t, err, "runtime API not found: AccountNonceApi")
+		assert.Zero(t, nonce)
+	})
+
+	t.Run("account_nonce_error", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		mockStorageState := NewMockStorageState(ctrl)
+		mockStorageState.EXPECT().TrieState(nil).Return(&rtstorage.TrieState{}, nil)
+		runtimeMock := NewMockInstance(ctrl)
+		mockBlockState := NewMockBlockState(ctrl)
+		mockBlockState.EXPECT().BestBlockHash().Return(common.Hash{1})
+		mockBlockState.EXPECT().GetRuntime(common.Hash{1}).Return(runtimeMock, nil)
+		runtimeMock.EXPECT().SetContextStorage(&rtstorage.TrieState{})
+		runtimeMock.EXPECT().Version().Return(runtime.Version{
+			APIItems: []runtime.APIItem{{Name: accountNonceAPIName, Ver: 1}},
+		}, nil)
+		runtimeMock.EXPECT().AccountNonce(accountID).Return(uint64(0), errDummyErr)
+		service := &Service{
+			storageState: mockStorageState,
+			blockState:   mockBlockState,
+		}
+		nonce, err := service.AccountNonce(accountID, nil)
+		assert.ErrorIs(t, err, errDummyErr)
+		assert.EqualError(t, err, "getting account nonce: dummy error for testing")
+		assert.Zero(t, nonce)
+	})
+
+	t.Run("happy_path", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		mockStorageState := NewMockStorageState(ctrl)
+		mockStorageState.EXPECT().TrieState(nil).Return(&rtstorage.TrieState{}, nil)
+		runtimeMock := NewMockInstance(ctrl)
+		mockBlockState := NewMockBlockState(ctrl)
+		mockBlockState.EXPECT().BestBlockHash().Return(common.Hash{1})
+		mockBlockState.EXPECT().GetRuntime(common.Hash{1}).Return(runtimeMock, nil)
+		runtimeMock.EXPECT().SetContextStorage(&rtstorage.TrieState{})
+		runtimeMock.EXPECT().Version().Return(runtime.Version{
+			APIItems: []runtime.APIItem{{Name: accountNonceAPIName, Ver: 1}},
+		}, nil)
+		runtimeMock.EXPECT().AccountNonce(accountID).Return(uint64(5), nil)
+		service := &Service{
+			storageState: mockStorageState,
+			blockState:   mockBlockState,
+		}
+		nonce, err := service.AccountNonce(accountID, nil)
+		require.NoError(t, err)
+		assert.Equal(t, uint64(5), nonce)
+	})
+}
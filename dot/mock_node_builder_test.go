@@ -21,7 +21,9 @@
 	sync "github.com/ChainSafe/gossamer/dot/sync"
 	system "github.com/ChainSafe/gossamer/dot/system"
 	types "github.com/ChainSafe/gossamer/dot/types"
+	aura "github.com/ChainSafe/gossamer/lib/aura"
 	babe "github.com/ChainSafe/gossamer/lib/babe"
+	beefy "github.com/ChainSafe/gossamer/lib/beefy"
 	grandpa "github.com/ChainSafe/gossamer/lib/grandpa"
 	keystore "github.com/ChainSafe/gossamer/lib/keystore"
 	runtime "github.com/ChainSafe/gossamer/lib/runtime"
@@ -51,6 +53,36 @@ func (m *MocknodeBuilderIface) EXPECT() *MocknodeBuilderIfaceMockRecorder {
 	return m.recorder
 }
 
+// createAuraService mocks base method.
+func (m *MocknodeBuilderIface) createAuraService(config *config.Config, st *state.Service, ks KeyStore, cs *core.Service, telemetryMailer Telemetry) (*aura.Service, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "createAuraService", config, st, ks, cs, telemetryMailer)
+	ret0, _ := ret[0].(*aura.Service)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// createAuraService indicates an expected call of createAuraService.
+func (mr *MocknodeBuilderIfaceMockRecorder) createAuraService(config, st, ks, cs, telemetryMailer any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "createAuraService", reflect.TypeOf((*MocknodeBuilderIface)(nil).createAuraService), config, st, ks, cs, telemetryMailer)
+}
+
+// createAuraVerifier mocks base method.
+func (m *MocknodeBuilderIface) createAuraVerifier(st *state.Service) (*aura.Verifier, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "createAuraVerifier", st)
+	ret0, _ := ret[0].(*aura.Verifier)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// createAuraVerifier indicates an expected call of createAuraVerifier.
+func (mr *MocknodeBuilderIfaceMockRecorder) createAuraVerifier(st any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "createAuraVerifier", reflect.TypeOf((*MocknodeBuilderIface)(nil).createAuraVerifier), st)
+}
+
 // createBABEService mocks base method.
 func (m *MocknodeBuilderIface) createBABEService(config *config.Config, st *state.Service, ks KeyStore, cs *core.Service, telemetryMailer Telemetry) (*babe.Service, error) {
 	m.ctrl.T.Helper()
@@ -66,6 +98,21 @@ func (mr *MocknodeBuilderIfaceMockRecorder) createBABEService(config, st, ks, cs
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "createBABEService", reflect.TypeOf((*MocknodeBuilderIface)(nil).createBABEService), config, st, ks, cs, telemetryMailer)
 }
 
+// createBEEFYService mocks base method.
+func (m *MocknodeBuilderIface) createBEEFYService(config *config.Config, st *state.Service, ks KeyStore, net *network.Service) (*beefy.Service, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "createBEEFYService", config, st, ks, net)
+	ret0, _ := ret[0].(*beefy.Service)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// createBEEFYService indicates an expected call of createBEEFYService.
+func (mr *MocknodeBuilderIfaceMockRecorder) createBEEFYService(config, st, ks, net any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "createBEEFYService", reflect.TypeOf((*MocknodeBuilderIface)(nil).createBEEFYService), config, st, ks, net)
+}
+
 // createBlockVerifier mocks base method.
 func (m *MocknodeBuilderIface) createBlockVerifier(st *state.Service) *babe.VerificationManager {
 	m.ctrl.T.Helper()
@@ -214,6 +261,21 @@ func (mr *MocknodeBuilderIfaceMockRecorder) initNode(config any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "initNode", reflect.TypeOf((*MocknodeBuilderIface)(nil).initNode), config)
 }
 
+// isBEEFYEnabled mocks base method.
+func (m *MocknodeBuilderIface) isBEEFYEnabled(st *state.Service) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "isBEEFYEnabled", st)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// isBEEFYEnabled indicates an expected call of isBEEFYEnabled.
+func (mr *MocknodeBuilderIfaceMockRecorder) isBEEFYEnabled(st any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "isBEEFYEnabled", reflect.TypeOf((*MocknodeBuilderIface)(nil).isBEEFYEnabled), st)
+}
+
 // loadRuntime mocks base method.
 func (m *MocknodeBuilderIface) loadRuntime(config *config.Config, ns *runtime.NodeStorage, stateSrvc *state.Service, ks *keystore.GlobalKeystore, net *network.Service) error {
 	m.ctrl.T.Helper()
@@ -229,16 +291,16 @@ func (mr *MocknodeBuilderIfaceMockRecorder) loadRuntime(config, ns, stateSrvc, k
 }
 
 // newSyncService mocks base method.
-func (m *MocknodeBuilderIface) newSyncService(config *config.Config, st *state.Service, finalityGadget sync.FinalityGadget, verifier *babe.VerificationManager, cs *core.Service, net *network.Service, telemetryMailer Telemetry) (network.Syncer, error) {
+func (m *MocknodeBuilderIface) newSyncService(config *config.Config, st *state.Service, finalityGadget sync.FinalityGadget, beefyImporter sync.BeefyJustificationImporter, verifier sync.BabeVerifier, cs *core.Service, net *network.Service, telemetryMailer Telemetry) (network.Syncer, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "newSyncService", config, st, finalityGadget, verifier, cs, net, telemetryMailer)
+	ret := m.ctrl.Call(m, "newSyncService", config, st, finalityGadget, beefyImporter, verifier, cs, net, telemetryMailer)
 	ret0, _ := ret[0].(network.Syncer)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // newSyncService indicates an expected call of newSyncService.
-func (mr *MocknodeBuilderIfaceMockRecorder) newSyncService(config, st, finalityGadget, verifier, cs, net, telemetryMailer any) *gomock.Call {
+func (mr *MocknodeBuilderIfaceMockRecorder) newSyncService(config, st, finalityGadget, beefyImporter, verifier, cs, net, telemetryMailer any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "newSyncService", reflect.TypeOf((*MocknodeBuilderIface)(nil).newSyncService), config, st, finalityGadget, verifier, cs, net, telemetryMailer)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "newSyncService", reflect.TypeOf((*MocknodeBuilderIface)(nil).newSyncService), config, st, finalityGadget, beefyImporter, verifier, cs, net, telemetryMailer)
 }
@@ -0,0 +1,549 @@
+// Copyright 2024 ChainSafe Systems (ON)
+// SPDX-License-Identifier: LGPL-3.0-only
+
+package network
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/ChainSafe/gossamer/dot/types"
+	"github.com/ChainSafe/gossamer/lib/common"
+	"github.com/ChainSafe/gossamer/lib/crypto/sr25519"
+	libp2precord "github.com/libp2p/go-libp2p-record"
+	"github.com/libp2p/go-libp2p/core/crypto"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/libp2p/go-libp2p/core/peerstore"
+	"github.com/libp2p/go-libp2p/core/routing"
+	"github.com/multiformats/go-multiaddr"
+	"github.com/multiformats/go-multihash"
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+var (
+	authorityDiscoveryPublishInterval = time.Hour
+	authorityDiscoveryResolveInterval = time.Minute * 10
+	authorityDiscoveryStartDelay      = time.Second * 5
+	authorityDiscoveryQueryTimeout    = time.Minute
+)
+
+var (
+	errAuthorityRecordMalformed     = errors.New("malformed authority record")
+	errAuthorityRecordSignature     = errors.New("invalid authority record signature")
+	errAuthorityRecordPeerSignature = errors.New("invalid authority record peer signature")
+	errAuthorityRecordNoAddress     = errors.New("authority record has no address")
+	errAuthorityRecordPeerMismatch  = errors.New("authority record address peer id mismatch")
+	errAuthorityRecordKey           = errors.New("invalid authority record key")
+)
+
+// authorityDiscoveryKey returns the DHT key of the authority record of the authority given,
+// which is the sha256 multihash of the authority public key as for substrate nodes.
+func authorityDiscoveryKey(authorityID types.AuthorityID) string {
+	key, err := multihash.Sum(authorityID[:], multihash.SHA2_256, -1)
+	if err != nil {
+		// sha256 is always supported
+		panic(err)
+	}
+	return string(key)
+}
+
+// isAuthorityDiscoveryKey returns true if the DHT key given is a sha256 multihash, as the keys
+// of the authority records are.
+func isAuthorityDiscoveryKey(key string) bool {
+	decoded, err := multihash.Decode([]byte(key))
+	return err == nil && decoded.Code == multihash.SHA2_256
+}
+
+// authorityRecord is the record published by an authority in the DHT. It is
+// protobuf encoded following the authority discovery schema:
+//
+//	message AuthorityRecord {
+//		repeated bytes addresses = 1;
+//		TimestampInfo creation_time = 3;
+//	}
+//	message TimestampInfo {
+//		bytes timestamp = 1; // SCALE encoded u128 nanoseconds since unix epoch
+//	}
+//	message PeerSignature {
+//		bytes signature = 1;
+//		bytes public_key = 2;
+//	}
+//	message SignedAuthorityRecord {
+//		bytes record = 1;
+//		bytes auth_signature = 2;
+//		PeerSignature peer_signature = 3;
+//	}
+type authorityRecord struct {
+	addresses    []multiaddr.Multiaddr
+	creationTime time.Time
+}
+
+func (r authorityRecord) encode() []byte {
+	var encoded []byte
+	for _, address := range r.addresses {
+		encoded = protowire.AppendTag(encoded, 1, protowire.BytesType)
+		encoded = protowire.AppendBytes(encoded, address.Bytes())
+	}
+
+	timestamp := make([]byte, 16)
+	binary.LittleEndian.PutUint64(timestamp, uint64(r.creationTime.UnixNano()))
+	var timestampInfo []byte
+	timestampInfo = protowire.AppendTag(timestampInfo, 1, protowire.BytesType)
+	timestampInfo = protowire.AppendBytes(timestampInfo, timestamp)
+
+	encoded = protowire.AppendTag(encoded, 3, protowire.BytesType)
+	encoded = protowire.AppendBytes(encoded, timestampInfo)
+	return encoded
+}
+
+func decodeAuthorityRecord(encoded []byte) (record authorityRecord, err error) {
+	err = decodeProtobufFields(encoded, func(number protowire.Number, value []byte) error {
+		switch number {
+		case 1:
+			address, err := multiaddr.NewMultiaddrBytes(value)
+			if err != nil {
+				return fmt.Errorf("decoding address: %w", err)
+			}
+			record.addresses = append(record.addresses, address)
+		case 3:
+			return decodeProtobufFields(value, func(number protowire.Number, value []byte) error {
+				if number != 1 || len(value) != 16 {
+					return nil
+				}
+				nanoseconds := binary.LittleEndian.Uint64(value[:8])
+				record.creationTime = time.Unix(0, int64(nanoseconds))
+				return nil
+			})
+		}
+		return nil
+	})
+	if err != nil {
+		return authorityRecord{}, err
+	}
+	return record, nil
+}
+
+type signedAuthorityRecord struct {
+	record        []byte
+	authSignature []byte
+	peerSignature []byte
+	peerPublicKey []byte
+}
+
+func (s signedAuthorityRecord) encode() []byte {
+	var peerSignature []byte
+	peerSignature = protowire.AppendTag(peerSignature, 1, protowire.BytesType)
+	peerSignature = protowire.AppendBytes(peerSignature, s.peerSignature)
+	peerSignature = protowire.AppendTag(peerSignature, 2, protowire.BytesType)
+	peerSignature = protowire.AppendBytes(peerSignature, s.peerPublicKey)
+
+	var encoded []byte
+	encoded = protowire.AppendTag(encoded, 1, protowire.BytesType)
+	encoded = protowire.AppendBytes(encoded, s.record)
+	encoded = protowire.AppendTag(encoded, 2, protowire.BytesType)
+	encoded = protowire.AppendBytes(encoded, s.authSignature)
+	encoded = protowire.AppendTag(encoded, 3, protowire.BytesType)
+	encoded = protowire.AppendBytes(encoded, peerSignature)
+	return encoded
+}
+
+func decodeSignedAuthorityRecord(encoded []byte) (signed signedAuthorityRecord, err error) {
+	err = decodeProtobufFields(encoded, func(number protowire.Number, value []byte) error {
+		switch number {
+		case 1:
+			signed.record = value
+		case 2:
+			signed.authSignature = value
+		case 3:
+			return decodeProtobufFields(value, func(number protowire.Number, value []byte) error {
+				switch number {
+				case 1:
+					signed.peerSignature = value
+				case 2:
+					signed.peerPublicKey = value
+				}
+				return nil
+			})
+		}
+		return nil
+	})
+	if err != nil {
+		return signedAuthorityRecord{}, err
+	}
+	return signed, nil
+}
+
+// decodeProtobufFields calls the function given for each length delimited field of the
+// protobuf message given, skipping fields of any other wire type.
+func decodeProtobufFields(encoded []byte, f func(number protowire.Number, value []byte) error) error {
+	for len(encoded) > 0 {
+		number, wireType, n := protowire.ConsumeTag(encoded)
+		if n < 0 {
+			return fmt.Errorf("%w: %s", errAuthorityRecordMalformed, protowire.ParseError(n))
+		}
+		encoded = encoded[n:]
+
+		if wireType != protowire.BytesType {
+			n = protowire.ConsumeFieldValue(number, wireType, encoded)
+			if n < 0 {
+				return fmt.Errorf("%w: %s", errAuthorityRecordMalformed, protowire.ParseError(n))
+			}
+			encoded = encoded[n:]
+			continue
+		}
+
+		value, n := protowire.ConsumeBytes(encoded)
+		if n < 0 {
+			return fmt.Errorf("%w: %s", errAuthorityRecordMalformed, protowire.ParseError(n))
+		}
+		encoded = encoded[n:]
+
+		err := f(number, value)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// verifyAuthorityRecord verifies the signed authority record given was signed by the authority
+// given and by the peer its addresses point to, and returns the peer addresses.
+func verifyAuthorityRecord(authorityID types.AuthorityID, signed signedAuthorityRecord) (
+	addrInfo peer.AddrInfo, record authorityRecord, err error) {
+	authorityKey, err := sr25519.NewPublicKey(authorityID[:])
+	if err != nil {
+		return addrInfo, record, fmt.Errorf("decoding authority public key: %w", err)
+	}
+
+	ok, err := authorityKey.Verify(signed.record, signed.authSignature)
+	if err != nil || !ok {
+		return addrInfo, record, errAuthorityRecordSignature
+	}
+
+	return verifyPeerRecord(signed)
+}
+
+// verifyPeerRecord verifies the signed authority record given was signed by the peer its
+// addresses point to, and returns the peer addresses.
+func verifyPeerRecord(signed signedAuthorityRecord) (addrInfo peer.AddrInfo, record authorityRecord, err error) {
+	peerPublicKey, err := crypto.UnmarshalPublicKey(signed.peerPublicKey)
+	if err != nil {
+		return addrInfo, record, fmt.Errorf("%w: %s", errAuthorityRecordPeerSignature, err)
+	}
+
+	ok, err := peerPublicKey.Verify(signed.record, signed.peerSignature)
+	if err != nil || !ok {
+		return addrInfo, record, errAuthorityRecordPeerSignature
+	}
+
+	peerID, err := peer.IDFromPublicKey(peerPublicKey)
+	if err != nil {
+		return addrInfo, record, fmt.Errorf("%w: %s", errAuthorityRecordPeerSignature, err)
+	}
+
+	record, err = decodeAuthorityRecord(signed.record)
+	if err != nil {
+		return addrInfo, record, err
+	}
+
+	if len(record.addresses) == 0 {
+		return addrInfo, record, errAuthorityRecordNoAddress
+	}
+
+	addrInfos, err := peer.AddrInfosFromP2pAddrs(record.addresses...)
+	if err != nil {
+		return addrInfo, record, fmt.Errorf("%w: %s", errAuthorityRecordMalformed, err)
+	}
+
+	for _, info := range addrInfos {
+		if info.ID != peerID {
+			return addrInfo, record, fmt.Errorf("%w: expected %s, got %s",
+				errAuthorityRecordPeerMismatch, peerID, info.ID)
+		}
+		addrInfo.ID = info.ID
+		addrInfo.Addrs = append(addrInfo.Addrs, info.Addrs...)
+	}
+
+	return addrInfo, record, nil
+}
+
+// authorityRecordValidator is the DHT record validator of the authority records.
+// The key of a record is the hash of the authority public key, which is not part of the
+// record, so the authority signature can only be verified for the authorities we know of.
+// The records of the other authorities are only checked to be signed by the peer their
+// addresses point to, as substrate nodes store them.
+type authorityRecordValidator struct {
+	mu          sync.RWMutex
+	authorities map[string]types.AuthorityID
+}
+
+func newAuthorityRecordValidator() *authorityRecordValidator {
+	return &authorityRecordValidator{
+		authorities: make(map[string]types.AuthorityID),
+	}
+}
+
+// setAuthorities sets the authorities whose records must be signed by the authority.
+func (v *authorityRecordValidator) setAuthorities(authorityIDs []types.AuthorityID) {
+	authorities := make(map[string]types.AuthorityID, len(authorityIDs))
+	for _, authorityID := range authorityIDs {
+		authorities[authorityDiscoveryKey(authorityID)] = authorityID
+	}
+
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	v.authorities = authorities
+}
+
+// verify checks the value given is an authority record signed by the peer its addresses
+// point to, and by the authority of the key given if we know of it.
+func (v *authorityRecordValidator) verify(key string, value []byte) (record authorityRecord, err error) {
+	if !isAuthorityDiscoveryKey(key) {
+		return record, fmt.Errorf("%w: %q", errAuthorityRecordKey, key)
+	}
+
+	signed, err := decodeSignedAuthorityRecord(value)
+	if err != nil {
+		return record, err
+	}
+
+	v.mu.RLock()
+	authorityID, known := v.authorities[key]
+	v.mu.RUnlock()
+	if known {
+		_, record, err = verifyAuthorityRecord(authorityID, signed)
+		return record, err
+	}
+
+	_, record, err = verifyPeerRecord(signed)
+	return record, err
+}
+
+// Validate checks the value given is an authority record signed by the peer its addresses
+// point to, and by the authority of the key given if we know of it.
+func (v *authorityRecordValidator) Validate(key string, value []byte) error {
+	_, err := v.verify(key, value)
+	return err
+}
+
+// Select returns the index of the most recently created valid authority record.
+func (v *authorityRecordValidator) Select(key string, values [][]byte) (selected int, err error) {
+	var latest time.Time
+	for i, value := range values {
+		record, err := v.verify(key, value)
+		if err != nil {
+			continue
+		}
+
+		if record.creationTime.After(latest) {
+			latest = record.creationTime
+			selected = i
+		}
+	}
+	return selected, nil
+}
+
+// dhtRecordValidator is the record validator of the DHT. The authority records are stored
+// under keys without namespace, so they are validated by the authority record validator and
+// the namespaced keys by the default public key validator.
+type dhtRecordValidator struct {
+	authorityRecords *authorityRecordValidator
+	namespaced       libp2precord.NamespacedValidator
+}
+
+func newDHTRecordValidator(authorityRecords *authorityRecordValidator) dhtRecordValidator {
+	return dhtRecordValidator{
+		authorityRecords: authorityRecords,
+		namespaced:       libp2precord.NamespacedValidator{"pk": libp2precord.PublicKeyValidator{}},
+	}
+}
+
+// Validate validates the record given with the validator of its key.
+func (v dhtRecordValidator) Validate(key string, value []byte) error {
+	if isAuthorityDiscoveryKey(key) {
+		return v.authorityRecords.Validate(key, value)
+	}
+	return v.namespaced.Validate(key, value)
+}
+
+// Select selects the best record with the validator of the key given.
+func (v dhtRecordValidator) Select(key string, values [][]byte) (int, error) {
+	if isAuthorityDiscoveryKey(key) {
+		return v.authorityRecords.Select(key, values)
+	}
+	return v.namespaced.Select(key, values)
+}
+
+// authorityDiscovery publishes the addresses of the node under its authority discovery keys
+// in the DHT, and resolves the addresses of the current authority set from the DHT.
+type authorityDiscovery struct {
+	ctx         context.Context
+	h           *host
+	dht         routing.ValueStore
+	validator   *authorityRecordValidator
+	keystore    AuthorityKeystore
+	authorities AuthoritySetProvider
+	handler     PeerSetHandler
+}
+
+func newAuthorityDiscovery(ctx context.Context, h *host, keystore AuthorityKeystore,
+	authorities AuthoritySetProvider, handler PeerSetHandler) *authorityDiscovery {
+	return &authorityDiscovery{
+		ctx:         ctx,
+		h:           h,
+		keystore:    keystore,
+		authorities: authorities,
+		handler:     handler,
+	}
+}
+
+// start periodically publishes our addresses and resolves the authorities addresses
+// using the DHT given, until the context is done. The validator given is the authority
+// record validator of the DHT, which is given the authorities to resolve.
+func (a *authorityDiscovery) start(dht routing.ValueStore, validator *authorityRecordValidator) {
+	a.dht = dht
+	a.validator = validator
+
+	// give some time to the DHT to fill its routing table
+	publishTimer := time.NewTimer(authorityDiscoveryStartDelay)
+	defer publishTimer.Stop()
+	resolveTimer := time.NewTimer(authorityDiscoveryStartDelay)
+	defer resolveTimer.Stop()
+
+	for {
+		select {
+		case <-a.ctx.Done():
+			return
+		case <-publishTimer.C:
+			err := a.publish()
+			if err != nil {
+				logger.Warnf("failed to publish authority discovery record: %s", err)
+			}
+			publishTimer.Reset(authorityDiscoveryPublishInterval)
+		case <-resolveTimer.C:
+			err := a.resolve()
+			if err != nil {
+				logger.Warnf("failed to resolve authority addresses: %s", err)
+			}
+			resolveTimer.Reset(authorityDiscoveryResolveInterval)
+		}
+	}
+}
+
+// publish signs our addresses with each of our authority discovery keys
+// and puts the signed records in the DHT.
+func (a *authorityDiscovery) publish() error {
+	keypairs := a.keystore.Keypairs()
+	if len(keypairs) == 0 {
+		return nil
+	}
+
+	record := authorityRecord{
+		addresses:    a.h.multiaddrs(),
+		creationTime: time.Now(),
+	}.encode()
+
+	peerKey := a.h.p2pHost.Peerstore().PrivKey(a.h.id())
+	peerSignature, err := peerKey.Sign(record)
+	if err != nil {
+		return fmt.Errorf("signing record with peer key: %w", err)
+	}
+
+	peerPublicKey, err := crypto.MarshalPublicKey(peerKey.GetPublic())
+	if err != nil {
+		return fmt.Errorf("encoding peer public key: %w", err)
+	}
+
+	for _, kp := range keypairs {
+		authSignature, err := kp.Sign(record)
+		if err != nil {
+			return fmt.Errorf("signing record with authority key: %w", err)
+		}
+
+		var authorityID types.AuthorityID
+		copy(authorityID[:], kp.Public().Encode())
+
+		signed := signedAuthorityRecord{
+			record:        record,
+			authSignature: authSignature,
+			peerSignature: peerSignature,
+			peerPublicKey: peerPublicKey,
+		}
+
+		ctx, cancel := context.WithTimeout(a.ctx, authorityDiscoveryQueryTimeout)
+		err = a.dht.PutValue(ctx, authorityDiscoveryKey(authorityID), signed.encode())
+		cancel()
+		if err != nil {
+			return fmt.Errorf("putting record of authority %s in the DHT: %w",
+				common.BytesToHex(authorityID[:]), err)
+		}
+
+		logger.Debugf("published authority discovery record for authority %s",
+			common.BytesToHex(authorityID[:]))
+	}
+
+	return nil
+}
+
+// resolve looks up the records of the current authority set in the DHT, and adds
+// the peers of the valid records to the peer set.
+func (a *authorityDiscovery) resolve() error {
+	authorities, err := a.authorities.AuthorityDiscoveryAuthorities()
+	if err != nil {
+		return fmt.Errorf("getting authorities: %w", err)
+	}
+
+	ours := make(map[types.AuthorityID]struct{})
+	known := append([]types.AuthorityID{}, authorities...)
+	for _, kp := range a.keystore.Keypairs() {
+		var authorityID types.AuthorityID
+		copy(authorityID[:], kp.Public().Encode())
+		ours[authorityID] = struct{}{}
+		known = append(known, authorityID)
+	}
+	// the DHT then only accepts the records of the authorities signed by the authorities.
+	a.validator.setAuthorities(known)
+
+	var resolved int
+	for _, authorityID := range authorities {
+		if _, ok := ours[authorityID]; ok {
+			continue
+		}
+
+		ctx, cancel := context.WithTimeout(a.ctx, authorityDiscoveryQueryTimeout)
+		value, err := a.dht.GetValue(ctx, authorityDiscoveryKey(authorityID))
+		cancel()
+		if err != nil {
+			logger.Debugf("cannot get record of authority %s from the DHT: %s",
+				common.BytesToHex(authorityID[:]), err)
+			continue
+		}
+
+		signed, err := decodeSignedAuthorityRecord(value)
+		if err != nil {
+			logger.Debugf("cannot decode record of authority %s: %s", common.BytesToHex(authorityID[:]), err)
+			continue
+		}
+
+		addrInfo, _, err := verifyAuthorityRecord(authorityID, signed)
+		if err != nil {
+			logger.Debugf("invalid record for authority %s: %s", common.BytesToHex(authorityID[:]), err)
+			continue
+		}
+
+		resolved++
+		if addrInfo.ID == a.h.id() {
+			continue
+		}
+
+		a.h.p2pHost.Peerstore().AddAddrs(addrInfo.ID, addrInfo.Addrs, peerstore.PermanentAddrTTL)
+		a.handler.AddPeer(0, addrInfo.ID)
+	}
+
+	logger.Debugf("resolved addresses of %d authorities out of %d", resolved, len(authorities))
+	return nil
+}
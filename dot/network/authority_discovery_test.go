@@ -0,0 +1,232 @@
+// Copyright 2024 ChainSafe Systems (ON)
+// SPDX-License-Identifier: LGPL-3.0-only
+
+package network
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"testing"
+	"time"
+
+	"github.com/ChainSafe/gossamer/dot/types"
+	"github.com/ChainSafe/gossamer/lib/common"
+	"github.com/ChainSafe/gossamer/lib/crypto/sr25519"
+	"github.com/libp2p/go-libp2p/core/crypto"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/multiformats/go-multiaddr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestSignedAuthorityRecord(t *testing.T, authorityKeypair *sr25519.Keypair,
+	peerKey crypto.PrivKey, addresses []string, creationTime time.Time) signedAuthorityRecord {
+	t.Helper()
+
+	record := authorityRecord{creationTime: creationTime}
+	for _, address := range addresses {
+		record.addresses = append(record.addresses, multiaddr.StringCast(address))
+	}
+	encoded := record.encode()
+
+	authSignature, err := authorityKeypair.Sign(encoded)
+	require.NoError(t, err)
+
+	peerSignature, err := peerKey.Sign(encoded)
+	require.NoError(t, err)
+
+	peerPublicKey, err := crypto.MarshalPublicKey(peerKey.GetPublic())
+	require.NoError(t, err)
+
+	return signedAuthorityRecord{
+		record:        encoded,
+		authSignature: authSignature,
+		peerSignature: peerSignature,
+		peerPublicKey: peerPublicKey,
+	}
+}
+
+func Test_authorityRecord_encodeDecode(t *testing.T) {
+	t.Parallel()
+
+	record := authorityRecord{
+		addresses: []multiaddr.Multiaddr{
+			multiaddr.StringCast("/ip4/127.0.0.1/tcp/30333"),
+			multiaddr.StringCast("/ip4/10.0.0.1/tcp/30334"),
+		},
+		creationTime: time.Unix(1700000000, 123),
+	}
+
+	decoded, err := decodeAuthorityRecord(record.encode())
+	require.NoError(t, err)
+	assert.Equal(t, record.addresses, decoded.addresses)
+	assert.True(t, record.creationTime.Equal(decoded.creationTime))
+
+	signed := signedAuthorityRecord{
+		record:        []byte{1},
+		authSignature: []byte{2},
+		peerSignature: []byte{3},
+		peerPublicKey: []byte{4},
+	}
+	decodedSigned, err := decodeSignedAuthorityRecord(signed.encode())
+	require.NoError(t, err)
+	assert.Equal(t, signed, decodedSigned)
+
+	_, err = decodeSignedAuthorityRecord([]byte{0xff})
+	assert.ErrorIs(t, err, errAuthorityRecordMalformed)
+}
+
+func Test_verifyAuthorityRecord(t *testing.T) {
+	t.Parallel()
+
+	authorityKeypair, err := sr25519.GenerateKeypair()
+	require.NoError(t, err)
+	var authorityID types.AuthorityID
+	copy(authorityID[:], authorityKeypair.Public().Encode())
+
+	otherKeypair, err := sr25519.GenerateKeypair()
+	require.NoError(t, err)
+
+	peerKey, _, err := crypto.GenerateEd25519Key(rand.Reader)
+	require.NoError(t, err)
+	peerID, err := peer.IDFromPrivateKey(peerKey)
+	require.NoError(t, err)
+
+	otherPeerKey, _, err := crypto.GenerateEd25519Key(rand.Reader)
+	require.NoError(t, err)
+	otherPeerID, err := peer.IDFromPrivateKey(otherPeerKey)
+	require.NoError(t, err)
+
+	address := "/ip4/127.0.0.1/tcp/30333/p2p/" + peerID.String()
+	now := time.Now()
+
+	testCases := map[string]struct {
+		signed     signedAuthorityRecord
+		addrInfo   peer.AddrInfo
+		errWrapped error
+	}{
+		"valid": {
+			signed: newTestSignedAuthorityRecord(t, authorityKeypair, peerKey, []string{address}, now),
+			addrInfo: peer.AddrInfo{
+				ID:    peerID,
+				Addrs: []multiaddr.Multiaddr{multiaddr.StringCast("/ip4/127.0.0.1/tcp/30333")},
+			},
+		},
+		"signed_by_another_authority": {
+			signed:     newTestSignedAuthorityRecord(t, otherKeypair, peerKey, []string{address}, now),
+			errWrapped: errAuthorityRecordSignature,
+		},
+		"no_address": {
+			signed:     newTestSignedAuthorityRecord(t, authorityKeypair, peerKey, nil, now),
+			errWrapped: errAuthorityRecordNoAddress,
+		},
+		"address_of_another_peer": {
+			signed: newTestSignedAuthorityRecord(t, authorityKeypair, peerKey,
+				[]string{"/ip4/127.0.0.1/tcp/30333/p2p/" + otherPeerID.String()}, now),
+			errWrapped: errAuthorityRecordPeerMismatch,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			addrInfo, _, err := verifyAuthorityRecord(authorityID, testCase.signed)
+			assert.ErrorIs(t, err, testCase.errWrapped)
+			assert.Equal(t, testCase.addrInfo, addrInfo)
+		})
+	}
+}
+
+func Test_authorityRecordValidator(t *testing.T) {
+	t.Parallel()
+
+	authorityKeypair, err := sr25519.GenerateKeypair()
+	require.NoError(t, err)
+	var authorityID types.AuthorityID
+	copy(authorityID[:], authorityKeypair.Public().Encode())
+	otherKeypair, err := sr25519.GenerateKeypair()
+	require.NoError(t, err)
+	var otherAuthorityID types.AuthorityID
+	copy(otherAuthorityID[:], otherKeypair.Public().Encode())
+
+	peerKey, _, err := crypto.GenerateEd25519Key(rand.Reader)
+	require.NoError(t, err)
+	peerID, err := peer.IDFromPrivateKey(peerKey)
+	require.NoError(t, err)
+
+	key := authorityDiscoveryKey(authorityID)
+	unknownKey := authorityDiscoveryKey(otherAuthorityID)
+	older := newTestSignedAuthorityRecord(t, authorityKeypair, peerKey,
+		[]string{"/ip4/127.0.0.1/tcp/30333/p2p/" + peerID.String()}, time.Unix(1000, 0)).encode()
+	newer := newTestSignedAuthorityRecord(t, authorityKeypair, peerKey,
+		[]string{"/ip4/127.0.0.1/tcp/30334/p2p/" + peerID.String()}, time.Unix(2000, 0)).encode()
+	forged := newTestSignedAuthorityRecord(t, otherKeypair, peerKey,
+		[]string{"/ip4/127.0.0.1/tcp/30335/p2p/" + peerID.String()}, time.Unix(3000, 0)).encode()
+
+	validator := newAuthorityRecordValidator()
+	validator.setAuthorities([]types.AuthorityID{authorityID})
+
+	err = validator.Validate(key, newer)
+	require.NoError(t, err)
+
+	err = validator.Validate(key, []byte{0xff})
+	assert.ErrorIs(t, err, errAuthorityRecordMalformed)
+
+	err = validator.Validate(key, forged)
+	assert.ErrorIs(t, err, errAuthorityRecordSignature)
+
+	err = validator.Validate("/audi/"+string(authorityID[:]), newer)
+	assert.ErrorIs(t, err, errAuthorityRecordKey)
+
+	// the authority of the key is unknown, so only the peer signature can be verified.
+	err = validator.Validate(unknownKey, newer)
+	require.NoError(t, err)
+
+	selected, err := validator.Select(key, [][]byte{older, forged, newer})
+	require.NoError(t, err)
+	assert.Equal(t, 2, selected)
+
+	selected, err = validator.Select(unknownKey, [][]byte{older, forged, newer})
+	require.NoError(t, err)
+	assert.Equal(t, 1, selected)
+}
+
+func Test_authorityDiscoveryKey(t *testing.T) {
+	t.Parallel()
+
+	// sha256 multihash of the public key of alice.
+	authorityID := types.AuthorityID(common.MustHexToBytes(
+		"0xd43593c715fdd31c61141abd04a99fd6822c8558854ccde39a5684e7a56da27d"))
+	digest := sha256.Sum256(authorityID[:])
+	expected := append([]byte{0x12, 0x20}, digest[:]...)
+
+	key := authorityDiscoveryKey(authorityID)
+
+	assert.Equal(t, string(expected), key)
+	assert.True(t, isAuthorityDiscoveryKey(key))
+	assert.False(t, isAuthorityDiscoveryKey("/pk/"+string(authorityID[:])))
+}
+
+func Test_dhtRecordValidator(t *testing.T) {
+	t.Parallel()
+
+	peerKey, _, err := crypto.GenerateEd25519Key(rand.Reader)
+	require.NoError(t, err)
+	peerID, err := peer.IDFromPrivateKey(peerKey)
+	require.NoError(t, err)
+	publicKey, err := crypto.MarshalPublicKey(peerKey.GetPublic())
+	require.NoError(t, err)
+
+	validator := newDHTRecordValidator(newAuthorityRecordValidator())
+
+	err = validator.Validate(authorityDiscoveryKey(types.AuthorityID{1}), []byte{0xff})
+	assert.ErrorIs(t, err, errAuthorityRecordMalformed)
+
+	err = validator.Validate("/pk/"+string(peerID), publicKey)
+	require.NoError(t, err)
+
+	err = validator.Validate("/other/key", publicKey)
+	assert.Error(t, err)
+}
@@ -0,0 +1,176 @@
+// Copyright 2024 ChainSafe Systems (ON)
+// SPDX-License-Identifier: LGPL-3.0-only
+
+package network
+
+import (
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/libp2p/go-libp2p/core/protocol"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	"github.com/ChainSafe/gossamer/lib/common"
+)
+
+const (
+	inboundDirection  = "in"
+	outboundDirection = "out"
+
+	unknownRoleLabel     = "unknown"
+	otherProtocolLabel   = "other"
+	grandpaProtocolLabel = "grandpa"
+)
+
+var (
+	bandwidthBytesTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "gossamer_network_bandwidth",
+		Name:      "bytes_total",
+		Help:      "total number of bytes sent and received, by protocol and peer role",
+	}, []string{"direction", "protocol", "role"})
+	bandwidthMessagesTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "gossamer_network_bandwidth",
+		Name:      "messages_total",
+		Help:      "total number of messages sent and received, by protocol and peer role",
+	}, []string{"direction", "protocol", "role"})
+)
+
+// protocolLabels maps the protocol id suffixes to the label used for their bandwidth.
+// The suffixes are matched in order, so the warp sync suffix must come before the sync one.
+var protocolLabels = []struct {
+	suffix string
+	label  string
+}{
+	{suffix: blockAnnounceID, label: "block-announces"},
+	{suffix: transactionsID, label: "transactions"},
+	{suffix: WarpSyncID, label: "warp-sync"},
+	{suffix: SyncID, label: "sync"},
+	{suffix: lightID, label: "light"},
+	{suffix: "/grandpa/1", label: grandpaProtocolLabel},
+	{suffix: "/beefy/2", label: "beefy"},
+}
+
+// protocolLabel returns the bandwidth label of the protocol id, which is the same for
+// its genesis hash prefixed and legacy forms.
+func protocolLabel(pid protocol.ID) string {
+	for _, protocolLabel := range protocolLabels {
+		if strings.HasSuffix(string(pid), protocolLabel.suffix) {
+			return protocolLabel.label
+		}
+	}
+	return otherProtocolLabel
+}
+
+// roleLabel returns the bandwidth label of the network role.
+func roleLabel(role common.NetworkRole) string {
+	switch role {
+	case common.FullNodeRole:
+		return "full"
+	case common.LightClientRole:
+		return "light"
+	case common.AuthorityRole:
+		return "authority"
+	default:
+		return unknownRoleLabel
+	}
+}
+
+type bandwidthKey struct {
+	protocol string
+	role     string
+}
+
+// bandwidthTracker counts the bytes and messages sent and received per protocol
+// and per role of the remote peer. The role of a peer is learnt from its block
+// announces handshake, until then its traffic is accounted under the unknown role.
+type bandwidthTracker struct {
+	mu       sync.RWMutex
+	roles    map[peer.ID]common.NetworkRole
+	counters map[bandwidthKey]*common.ProtocolBandwidth
+}
+
+func newBandwidthTracker() *bandwidthTracker {
+	return &bandwidthTracker{
+		roles:    make(map[peer.ID]common.NetworkRole),
+		counters: make(map[bandwidthKey]*common.ProtocolBandwidth),
+	}
+}
+
+// setPeerRole records the role of the peer, used to label its subsequent traffic.
+func (bt *bandwidthTracker) setPeerRole(p peer.ID, role common.NetworkRole) {
+	bt.mu.Lock()
+	defer bt.mu.Unlock()
+	bt.roles[p] = role
+}
+
+// deletePeerRole forgets the role of a disconnected peer.
+func (bt *bandwidthTracker) deletePeerRole(p peer.ID) {
+	bt.mu.Lock()
+	defer bt.mu.Unlock()
+	delete(bt.roles, p)
+}
+
+// logSent accounts for a message of the given size sent to the peer using the protocol.
+func (bt *bandwidthTracker) logSent(pid protocol.ID, p peer.ID, size uint64) {
+	bt.log(outboundDirection, pid, p, size)
+}
+
+// logReceived accounts for a message of the given size received from the peer using the protocol.
+func (bt *bandwidthTracker) logReceived(pid protocol.ID, p peer.ID, size uint64) {
+	bt.log(inboundDirection, pid, p, size)
+}
+
+func (bt *bandwidthTracker) log(direction string, pid protocol.ID, p peer.ID, size uint64) {
+	bt.mu.Lock()
+	defer bt.mu.Unlock()
+
+	key := bandwidthKey{
+		protocol: protocolLabel(pid),
+		role:     unknownRoleLabel,
+	}
+	if role, has := bt.roles[p]; has {
+		key.role = roleLabel(role)
+	}
+
+	counter, has := bt.counters[key]
+	if !has {
+		counter = &common.ProtocolBandwidth{
+			Protocol: key.protocol,
+			Role:     key.role,
+		}
+		bt.counters[key] = counter
+	}
+
+	if direction == inboundDirection {
+		counter.BytesIn += size
+		counter.MessagesIn++
+	} else {
+		counter.BytesOut += size
+		counter.MessagesOut++
+	}
+
+	bandwidthBytesTotal.WithLabelValues(direction, key.protocol, key.role).Add(float64(size))
+	bandwidthMessagesTotal.WithLabelValues(direction, key.protocol, key.role).Inc()
+}
+
+// totals returns a copy of the counters, sorted by protocol and role.
+func (bt *bandwidthTracker) totals() []common.ProtocolBandwidth {
+	bt.mu.RLock()
+	defer bt.mu.RUnlock()
+
+	totals := make([]common.ProtocolBandwidth, 0, len(bt.counters))
+	for _, counter := range bt.counters {
+		totals = append(totals, *counter)
+	}
+
+	sort.Slice(totals, func(i, j int) bool {
+		if totals[i].Protocol != totals[j].Protocol {
+			return totals[i].Protocol < totals[j].Protocol
+		}
+		return totals[i].Role < totals[j].Role
+	})
+	return totals
+}
@@ -0,0 +1,94 @@
+// Copyright 2024 ChainSafe Systems (ON)
+// SPDX-License-Identifier: LGPL-3.0-only
+
+package network
+
+import (
+	"testing"
+
+	"github.com/libp2p/go-libp2p/core/protocol"
+	"github.com/libp2p/go-libp2p/core/test"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ChainSafe/gossamer/lib/common"
+)
+
+func Test_protocolLabel(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[protocol.ID]string{
+		"/91b171bb158e2d3848fa23a9f1c25182/block-announces/1": "block-announces",
+		"/dot/block-announces/1":                              "block-announces",
+		"/dot/transactions/1":                                 "transactions",
+		"/91b171bb158e2d3848fa23a9f1c25182/sync/2":            "sync",
+		"/91b171bb158e2d3848fa23a9f1c25182/sync/warp":         "warp-sync",
+		"/dot/light/2":                                        "light",
+		"/91b171bb158e2d3848fa23a9f1c25182/grandpa/1":         "grandpa",
+		"/paritytech/grandpa/1":                               "grandpa",
+		"/91b171bb158e2d3848fa23a9f1c25182/beefy/2":           "beefy",
+		"/ipfs/kad/1.0.0":                                     "other",
+	}
+
+	for pid, label := range testCases {
+		pid, label := pid, label
+		t.Run(string(pid), func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, label, protocolLabel(pid))
+		})
+	}
+}
+
+func Test_bandwidthTracker(t *testing.T) {
+	t.Parallel()
+
+	const (
+		blockAnnounces protocol.ID = "/dot/block-announces/1"
+		grandpa        protocol.ID = "/paritytech/grandpa/1"
+	)
+
+	authority := test.RandPeerIDFatal(t)
+	unknown := test.RandPeerIDFatal(t)
+
+	bt := newBandwidthTracker()
+	bt.setPeerRole(authority, common.AuthorityRole)
+
+	bt.logReceived(blockAnnounces, authority, 100)
+	bt.logReceived(blockAnnounces, authority, 20)
+	bt.logSent(blockAnnounces, authority, 50)
+	bt.logSent(grandpa, authority, 10)
+	bt.logReceived(blockAnnounces, unknown, 7)
+
+	// once a peer disconnects, its traffic is accounted under the unknown role.
+	bt.deletePeerRole(authority)
+	bt.logSent(grandpa, authority, 3)
+
+	expected := []common.ProtocolBandwidth{
+		{
+			Protocol:    "block-announces",
+			Role:        "authority",
+			BytesIn:     120,
+			BytesOut:    50,
+			MessagesIn:  2,
+			MessagesOut: 1,
+		},
+		{
+			Protocol:   "block-announces",
+			Role:       "unknown",
+			BytesIn:    7,
+			MessagesIn: 1,
+		},
+		{
+			Protocol:    "grandpa",
+			Role:        "authority",
+			BytesOut:    10,
+			MessagesOut: 1,
+		},
+		{
+			Protocol:    "grandpa",
+			Role:        "unknown",
+			BytesOut:    3,
+			MessagesOut: 1,
+		},
+	}
+	assert.Equal(t, expected, bt.totals())
+}
@@ -171,6 +171,9 @@ func (s *Service) validateBlockAnnounceHandshake(from peer.ID, hs Handshake) err
 		return errors.New("genesis hash mismatch")
 	}
 
+	s.host.bandwidth.setPeerRole(from, bhs.Roles)
+	s.host.goodPeers.setRole(from, bhs.Roles, s.host.p2pHost.Peerstore().Addrs(from))
+
 	np, ok := s.notificationsProtocols[blockAnnounceMsgType]
 	if !ok {
 		// this should never happen.
@@ -198,6 +201,10 @@ func (s *Service) handleBlockAnnounceMessage(from peer.ID, msg NotificationsMess
 	}
 
 	err := s.syncer.HandleBlockAnnounce(from, bam)
-	shouldPropagate := err == nil
-	return shouldPropagate, err
+	if err != nil {
+		return false, err
+	}
+
+	s.host.goodPeers.useful(from)
+	return true, nil
 }
@@ -87,16 +87,30 @@ type Config struct {
 	NoBootstrap bool
 	// NoMDNS disables MDNS discovery
 	NoMDNS bool
-	// ListenAddress is the multiaddress to listen on
+	// ListenAddress is the comma separated list of multiaddresses to listen on,
+	// it may contain /ws and /wss addresses for browser light clients
 	ListenAddress string
+	// TLSCert is the path to the tls certificate used for /wss listen addresses
+	TLSCert string
+	// TLSKey is the path to the tls private key used for /wss listen addresses
+	TLSKey string
+
+	// ForkID is the optional fork id of the chain, set in the protocol ids after the genesis hash
+	ForkID string
 
 	MinPeers int
 	MaxPeers int
 
+	// BanDuration is the duration of the ban applied to a peer when its
+	// reputation falls below the banned threshold, zero disabling these bans
+	BanDuration time.Duration
+
 	DiscoveryInterval time.Duration
 
 	// PersistentPeers is a list of multiaddrs which the node should remain connected to
 	PersistentPeers []string
+	// ReservedOnly restricts the connections to the persistent and reserved peers
+	ReservedOnly bool
 
 	// NodeKey is the private hex encoded Ed25519 key to build the p2p identity
 	NodeKey string
@@ -115,6 +129,9 @@ type Config struct {
 	Telemetry Telemetry
 	Metrics   metrics.IntervalConfig
 
+	// simulation, if set, runs the service on a simulated network.
+	simulation *SimulationConfig
+
 	// Spam limiters configuration
 	warpSyncSpamLimiter RateLimiter
 }
@@ -6,6 +6,7 @@
 import (
 	"context"
 	"fmt"
+	"sync"
 	"time"
 
 	ethmetrics "github.com/ethereum/go-ethereum/metrics"
@@ -35,7 +36,9 @@
 
 // discovery handles discovery of new peers via the kademlia DHT
 type discovery struct {
-	ctx       context.Context
+	ctx context.Context
+	// dhtMu protects dht, which is created by start concurrently with its first users.
+	dhtMu     sync.RWMutex
 	dht       *dual.DHT
 	rd        *routing.RoutingDiscovery
 	h         libp2phost.Host
@@ -44,19 +47,22 @@ type discovery struct {
 	pid       protocol.ID
 	maxPeers  int
 	handler   PeerSetHandler
+	// authorityRecords validates the authority discovery records stored in the DHT.
+	authorityRecords *authorityRecordValidator
 }
 
 func newDiscovery(ctx context.Context, h libp2phost.Host,
 	bootnodes []peer.AddrInfo, ds *badger.Datastore,
 	pid protocol.ID, max int, handler PeerSetHandler) *discovery {
 	return &discovery{
-		ctx:       ctx,
-		h:         h,
-		bootnodes: bootnodes,
-		ds:        ds,
-		pid:       pid,
-		maxPeers:  max,
-		handler:   handler,
+		ctx:              ctx,
+		h:                h,
+		bootnodes:        bootnodes,
+		ds:               ds,
+		pid:              pid,
+		maxPeers:         max,
+		handler:          handler,
+		authorityRecords: newAuthorityRecordValidator(),
 	}
 }
 
@@ -111,7 +117,10 @@ func (d *discovery) start() error {
 		dual.DHTOption(kaddht.Datastore(d.ds)),
 		dual.DHTOption(kaddht.BootstrapPeers(d.bootnodes...)),
 		dual.DHTOption(kaddht.V1ProtocolOverride(d.pid + "/kad")),
+		// the DHT only accepts a validator other than the default one outside of the /ipfs prefix.
+		dual.DHTOption(kaddht.ProtocolPrefix(d.pid)),
 		dual.DHTOption(kaddht.Mode(kaddht.ModeAutoServer)),
+		dual.DHTOption(kaddht.Validator(newDHTRecordValidator(d.authorityRecords))),
 		dual.DHTOption(kaddht.AddressFilter(func(as []multiaddr.Multiaddr) []multiaddr.Multiaddr {
 			var addrs []multiaddr.Multiaddr
 			for _, addr := range as {
@@ -130,7 +139,9 @@ func (d *discovery) start() error {
 		return err
 	}
 
+	d.dhtMu.Lock()
 	d.dht = dht
+	d.dhtMu.Unlock()
 	return d.discoverAndAdvertise()
 }
 
@@ -223,7 +234,23 @@ func (d *discovery) findPeers() {
 	}
 }
 
+// findPeer searches the DHT for the addresses of the peer given.
+func (d *discovery) findPeer(ctx context.Context, peerID peer.ID) (peer.AddrInfo, error) {
+	d.dhtMu.RLock()
+	dht := d.dht
+	d.dhtMu.RUnlock()
+
+	if dht == nil {
+		return peer.AddrInfo{}, errDHTNotStarted
+	}
+
+	return dht.FindPeer(ctx, peerID)
+}
+
 func (d *discovery) stop() error {
+	d.dhtMu.RLock()
+	defer d.dhtMu.RUnlock()
+
 	if d.dht == nil {
 		return nil
 	}
@@ -37,10 +37,11 @@ func newTestDiscovery(t *testing.T, num int) []*discovery {
 		ds, err := badger.NewDatastore("", &opts)
 		require.NoError(t, err)
 		disc := &discovery{
-			ctx: srvc.ctx,
-			h:   srvc.host.p2pHost,
-			ds:  ds,
-			pid: protocol.ID("/testing"),
+			ctx:              srvc.ctx,
+			h:                srvc.host.p2pHost,
+			ds:               ds,
+			pid:              protocol.ID("/testing"),
+			authorityRecords: newAuthorityRecordValidator(),
 		}
 
 		go disc.start()
@@ -0,0 +1,26 @@
+// Copyright 2024 ChainSafe Systems (ON)
+// SPDX-License-Identifier: LGPL-3.0-only
+
+package network
+
+import (
+	"context"
+	"testing"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_discovery_findPeer_notStarted(t *testing.T) {
+	t.Parallel()
+
+	d := &discovery{ctx: context.Background()}
+
+	// peerset connect messages can be processed before the DHT is started.
+	addrInfo, err := d.findPeer(context.Background(), peer.ID("peer"))
+	require.ErrorIs(t, err, errDHTNotStarted)
+	require.Equal(t, peer.AddrInfo{}, addrInfo)
+
+	err = d.stop()
+	require.NoError(t, err)
+}
@@ -17,6 +17,8 @@
 	errHandshakeTimeout          = errors.New("handshake timeout reached")
 	errInboundHanshakeExists     = errors.New("an inbound handshake already exists for given peer")
 	errInvalidRole               = errors.New("invalid role")
+	errForkIDMismatch            = errors.New("fork id mismatch")
+	errDHTNotStarted             = errors.New("DHT not started")
 	ErrFailedToReadEntireMessage = errors.New("failed to read entire message")
 	ErrNilStream                 = errors.New("nil stream")
 	ErrInvalidLEB128EncodedData  = errors.New("invalid LEB128 encoded data")
@@ -0,0 +1,303 @@
+// Copyright 2024 ChainSafe Systems (ON)
+// SPDX-License-Identifier: LGPL-3.0-only
+
+package network
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/ipfs/go-datastore"
+	"github.com/ipfs/go-datastore/query"
+	"github.com/libp2p/go-libp2p/core/peer"
+	ma "github.com/multiformats/go-multiaddr"
+
+	"github.com/ChainSafe/gossamer/lib/common"
+)
+
+const (
+	// maxGoodPeers is the maximum number of peers kept in the good peers cache.
+	maxGoodPeers = 50
+	// goodPeerTTL is the duration after which a peer not seen is evicted from the cache.
+	goodPeerTTL = 7 * 24 * time.Hour
+	// maxUsefulnessScore caps the score a peer gets from its usefulness to the sync,
+	// so that long lived peers do not outrank the others forever.
+	maxUsefulnessScore = 100
+	// maxGoodPeerAddrs is the maximum number of addresses kept for a good peer.
+	maxGoodPeerAddrs = 8
+	// goodPeersPersistInterval is the interval at which the good peers cache is
+	// persisted, if it changed.
+	goodPeersPersistInterval = 5 * time.Minute
+
+	// goodPeerHeaderLength is the length of the fixed part of an encoded good peer: its role,
+	// usefulness and last seen time. It is followed by its length prefixed addresses.
+	goodPeerHeaderLength = 1 + 4 + 8
+)
+
+var goodPeersPrefix = datastore.NewKey("/network/goodpeers")
+
+var errInvalidGoodPeerAddress = errors.New("invalid good peer address")
+
+// goodPeer is a peer that completed the block announces handshake with us.
+type goodPeer struct {
+	id   peer.ID
+	role common.NetworkRole
+	// usefulness is the number of block announces imported and of
+	// responses received from the peer.
+	usefulness uint32
+	lastSeen   time.Time
+	// addrs are the addresses the peer was reachable at, kept with the peer since the
+	// addresses of the peerstore expire long before the peer is evicted from the cache.
+	addrs []ma.Multiaddr
+}
+
+// score returns the score of the peer, based on its role and on its usefulness to the sync.
+func (gp *goodPeer) score() uint32 {
+	var roleScore uint32
+	switch gp.role {
+	case common.FullNodeRole:
+		roleScore = 50
+	case common.AuthorityRole:
+		roleScore = 25
+	}
+	return roleScore + gp.usefulness
+}
+
+// goodPeerCache keeps the peers that were recently good to us, persisted in a datastore
+// so that they are dialled first when the node restarts. Light clients are not cached
+// since they cannot serve blocks.
+type goodPeerCache struct {
+	mu    sync.Mutex
+	ds    datastore.Batching
+	peers map[peer.ID]*goodPeer
+	// changed is true if the cache changed since it was last persisted.
+	changed bool
+	// closed is true once the cache is persisted for the last time, before its datastore is closed.
+	closed bool
+}
+
+// newGoodPeerCache creates a good peers cache loading the peers persisted in the datastore,
+// evicting the ones not seen for longer than the good peer TTL.
+func newGoodPeerCache(ds datastore.Batching) (*goodPeerCache, error) {
+	gpc := &goodPeerCache{
+		ds:    ds,
+		peers: make(map[peer.ID]*goodPeer),
+	}
+
+	expiry := time.Now().Add(-goodPeerTTL)
+	err := gpc.iterate(func(gp *goodPeer) error {
+		if !gp.lastSeen.Before(expiry) {
+			gpc.peers[gp.id] = gp
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("loading good peers: %w", err)
+	}
+
+	return gpc, nil
+}
+
+// setRole records the role and the addresses of a peer which completed the block announces handshake.
+func (gpc *goodPeerCache) setRole(p peer.ID, role common.NetworkRole, addrs []ma.Multiaddr) {
+	gpc.mu.Lock()
+	defer gpc.mu.Unlock()
+
+	gpc.changed = true
+	if role == common.LightClientRole {
+		delete(gpc.peers, p)
+		return
+	}
+
+	gp, has := gpc.peers[p]
+	if !has {
+		gp = &goodPeer{id: p}
+		gpc.peers[p] = gp
+	}
+	gp.role = role
+	gp.lastSeen = time.Now()
+	if len(addrs) > 0 {
+		gp.addrs = addrs[:min(len(addrs), maxGoodPeerAddrs)]
+	}
+}
+
+// useful increases the usefulness of a cached peer, after a block announce it sent was
+// imported or a response it sent was received.
+func (gpc *goodPeerCache) useful(p peer.ID) {
+	gpc.mu.Lock()
+	defer gpc.mu.Unlock()
+
+	gp, has := gpc.peers[p]
+	if !has {
+		return
+	}
+	if gp.usefulness < maxUsefulnessScore {
+		gp.usefulness++
+	}
+	gp.lastSeen = time.Now()
+	gpc.changed = true
+}
+
+// best returns the address infos of the cached peers, the best ones first.
+func (gpc *goodPeerCache) best() []peer.AddrInfo {
+	gpc.mu.Lock()
+	defer gpc.mu.Unlock()
+
+	sorted := gpc.sorted()
+	infos := make([]peer.AddrInfo, len(sorted))
+	for i, gp := range sorted {
+		infos[i] = peer.AddrInfo{ID: gp.id, Addrs: gp.addrs}
+	}
+	return infos
+}
+
+// sorted returns the cached peers sorted by decreasing score, the most
+// recently seen first among the peers with the same score.
+func (gpc *goodPeerCache) sorted() []*goodPeer {
+	sorted := make([]*goodPeer, 0, len(gpc.peers))
+	for _, gp := range gpc.peers {
+		sorted = append(sorted, gp)
+	}
+
+	sort.Slice(sorted, func(i, j int) bool {
+		scoreI, scoreJ := sorted[i].score(), sorted[j].score()
+		if scoreI != scoreJ {
+			return scoreI > scoreJ
+		}
+		return sorted[i].lastSeen.After(sorted[j].lastSeen)
+	})
+	return sorted
+}
+
+// persistIfChanged persists the cache if it changed since it was last persisted.
+func (gpc *goodPeerCache) persistIfChanged() error {
+	gpc.mu.Lock()
+	defer gpc.mu.Unlock()
+
+	if !gpc.changed || gpc.closed {
+		return nil
+	}
+	return gpc.persist()
+}
+
+// close persists the cache a last time, the cache is not persisted anymore afterwards.
+func (gpc *goodPeerCache) close() error {
+	gpc.mu.Lock()
+	defer gpc.mu.Unlock()
+
+	if gpc.closed {
+		return nil
+	}
+	gpc.closed = true
+	return gpc.persist()
+}
+
+// persist replaces the persisted peers with the best cached ones.
+// It must be called with the mutex held.
+func (gpc *goodPeerCache) persist() error {
+	ctx := context.Background()
+	batch, err := gpc.ds.Batch(ctx)
+	if err != nil {
+		return fmt.Errorf("creating batch: %w", err)
+	}
+
+	err = gpc.iterate(func(gp *goodPeer) error {
+		return batch.Delete(ctx, goodPeersPrefix.ChildString(gp.id.String()))
+	})
+	if err != nil {
+		return fmt.Errorf("deleting previous entries: %w", err)
+	}
+
+	sorted := gpc.sorted()
+	if len(sorted) > maxGoodPeers {
+		sorted = sorted[:maxGoodPeers]
+	}
+
+	for _, gp := range sorted {
+		value := make([]byte, 1, goodPeerHeaderLength)
+		value[0] = byte(gp.role)
+		value = binary.LittleEndian.AppendUint32(value, gp.usefulness)
+		value = binary.LittleEndian.AppendUint64(value, uint64(gp.lastSeen.UnixNano())) //nolint:gosec
+		for _, addr := range gp.addrs {
+			value = binary.AppendUvarint(value, uint64(len(addr.Bytes())))
+			value = append(value, addr.Bytes()...)
+		}
+		err = batch.Put(ctx, goodPeersPrefix.ChildString(gp.id.String()), value)
+		if err != nil {
+			return fmt.Errorf("putting good peer: %w", err)
+		}
+	}
+
+	err = batch.Commit(ctx)
+	if err != nil {
+		return fmt.Errorf("committing batch: %w", err)
+	}
+
+	gpc.changed = false
+	return nil
+}
+
+func (gpc *goodPeerCache) iterate(f func(gp *goodPeer) error) error {
+	results, err := gpc.ds.Query(context.Background(), query.Query{Prefix: goodPeersPrefix.String()})
+	if err != nil {
+		return fmt.Errorf("querying datastore: %w", err)
+	}
+	defer results.Close()
+
+	for result := range results.Next() {
+		if result.Error != nil {
+			return fmt.Errorf("iterating datastore: %w", result.Error)
+		}
+
+		peerID, err := peer.Decode(datastore.RawKey(result.Key).BaseNamespace())
+		if err != nil {
+			return fmt.Errorf("decoding peer id: %w", err)
+		}
+
+		gp, err := decodeGoodPeer(peerID, result.Value)
+		if err != nil {
+			return fmt.Errorf("decoding good peer %s: %w", peerID, err)
+		}
+
+		err = f(gp)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func decodeGoodPeer(peerID peer.ID, value []byte) (*goodPeer, error) {
+	if len(value) < goodPeerHeaderLength {
+		return nil, fmt.Errorf("invalid length %d", len(value))
+	}
+
+	gp := &goodPeer{
+		id:         peerID,
+		role:       common.NetworkRole(value[0]),
+		usefulness: binary.LittleEndian.Uint32(value[1:5]),
+		lastSeen:   time.Unix(0, int64(binary.LittleEndian.Uint64(value[5:goodPeerHeaderLength]))), //nolint:gosec
+	}
+
+	value = value[goodPeerHeaderLength:]
+	for len(value) > 0 {
+		length, n := binary.Uvarint(value)
+		if n <= 0 || uint64(len(value)-n) < length {
+			return nil, errInvalidGoodPeerAddress
+		}
+		value = value[n:]
+
+		addr, err := ma.NewMultiaddrBytes(value[:length])
+		if err != nil {
+			return nil, fmt.Errorf("decoding address: %w", err)
+		}
+		gp.addrs = append(gp.addrs, addr)
+		value = value[length:]
+	}
+	return gp, nil
+}
@@ -0,0 +1,165 @@
+// Copyright 2024 ChainSafe Systems (ON)
+// SPDX-License-Identifier: LGPL-3.0-only
+
+package network
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ipfs/go-datastore"
+	dssync "github.com/ipfs/go-datastore/sync"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/libp2p/go-libp2p/core/test"
+	ma "github.com/multiformats/go-multiaddr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ChainSafe/gossamer/lib/common"
+)
+
+func Test_goodPeerCache(t *testing.T) {
+	t.Parallel()
+
+	ds := dssync.MutexWrap(datastore.NewMapDatastore())
+	gpc, err := newGoodPeerCache(ds)
+	require.NoError(t, err)
+
+	authority := test.RandPeerIDFatal(t)
+	full := test.RandPeerIDFatal(t)
+	useful := test.RandPeerIDFatal(t)
+	light := test.RandPeerIDFatal(t)
+	unknown := test.RandPeerIDFatal(t)
+	expired := test.RandPeerIDFatal(t)
+
+	addrs := []ma.Multiaddr{
+		ma.StringCast("/ip4/10.0.0.1/tcp/30333"),
+		ma.StringCast("/dns/example.com/tcp/30333/ws"),
+	}
+
+	gpc.setRole(authority, common.AuthorityRole, nil)
+	gpc.setRole(full, common.FullNodeRole, addrs)
+	gpc.setRole(useful, common.AuthorityRole, addrs[:1])
+	gpc.setRole(light, common.LightClientRole, addrs)
+	gpc.setRole(expired, common.FullNodeRole, nil)
+	for i := 0; i < 30; i++ {
+		gpc.useful(useful)
+	}
+	gpc.useful(unknown)
+	gpc.peers[expired].lastSeen = time.Now().Add(-goodPeerTTL - time.Minute)
+
+	expected := []peer.AddrInfo{
+		{ID: useful, Addrs: addrs[:1]},
+		{ID: full, Addrs: addrs},
+		{ID: expired},
+		{ID: authority},
+	}
+	assert.Equal(t, expected, gpc.best())
+
+	err = gpc.persistIfChanged()
+	require.NoError(t, err)
+	assert.False(t, gpc.changed)
+
+	loaded, err := newGoodPeerCache(ds)
+	require.NoError(t, err)
+	expected = []peer.AddrInfo{
+		{ID: useful, Addrs: addrs[:1]},
+		{ID: full, Addrs: addrs},
+		{ID: authority},
+	}
+	assert.Equal(t, expected, loaded.best())
+	assert.Equal(t, uint32(30), loaded.peers[useful].usefulness)
+
+	// a peer becoming a light client is evicted.
+	loaded.setRole(full, common.LightClientRole, nil)
+	err = loaded.close()
+	require.NoError(t, err)
+
+	// the cache is not persisted anymore once closed.
+	loaded.setRole(full, common.FullNodeRole, addrs)
+	err = loaded.persistIfChanged()
+	require.NoError(t, err)
+
+	loaded, err = newGoodPeerCache(ds)
+	require.NoError(t, err)
+	expected = []peer.AddrInfo{
+		{ID: useful, Addrs: addrs[:1]},
+		{ID: authority},
+	}
+	assert.Equal(t, expected, loaded.best())
+}
+
+func Test_goodPeerCache_persist_maxGoodPeers(t *testing.T) {
+	t.Parallel()
+
+	ds := dssync.MutexWrap(datastore.NewMapDatastore())
+	gpc, err := newGoodPeerCache(ds)
+	require.NoError(t, err)
+
+	for i := 0; i < maxGoodPeers+10; i++ {
+		gpc.setRole(test.RandPeerIDFatal(t), common.FullNodeRole, nil)
+	}
+
+	err = gpc.persistIfChanged()
+	require.NoError(t, err)
+
+	loaded, err := newGoodPeerCache(ds)
+	require.NoError(t, err)
+	assert.Len(t, loaded.peers, maxGoodPeers)
+}
+
+func Test_decodeGoodPeer(t *testing.T) {
+	t.Parallel()
+
+	peerID := test.RandPeerIDFatal(t)
+	addr := ma.StringCast("/ip4/10.0.0.1/tcp/30333")
+	header := make([]byte, goodPeerHeaderLength)
+	header[0] = byte(common.FullNodeRole)
+
+	testCases := map[string]struct {
+		value         []byte
+		expected      *goodPeer
+		errorContains string
+	}{
+		"too_short": {
+			value:         header[:goodPeerHeaderLength-1],
+			errorContains: "invalid length 12",
+		},
+		"no_address": {
+			value: header,
+			expected: &goodPeer{
+				id:       peerID,
+				role:     common.FullNodeRole,
+				lastSeen: time.Unix(0, 0),
+			},
+		},
+		"address": {
+			value: append(append(append([]byte{}, header...), byte(len(addr.Bytes()))), addr.Bytes()...),
+			expected: &goodPeer{
+				id:       peerID,
+				role:     common.FullNodeRole,
+				lastSeen: time.Unix(0, 0),
+				addrs:    []ma.Multiaddr{addr},
+			},
+		},
+		"truncated_address": {
+			value:         append(append(append([]byte{}, header...), byte(len(addr.Bytes()))), addr.Bytes()[1:]...),
+			errorContains: errInvalidGoodPeerAddress.Error(),
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			gp, err := decodeGoodPeer(peerID, testCase.value)
+			if testCase.errorContains != "" {
+				assert.ErrorContains(t, err, testCase.errorContains)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, testCase.expected, gp)
+		})
+	}
+}
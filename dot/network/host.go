@@ -9,7 +9,7 @@
 	"log"
 	"net"
 	"path"
-	"strconv"
+	"slices"
 	"strings"
 	"sync"
 	"time"
@@ -26,7 +26,7 @@
 	"github.com/libp2p/go-libp2p/core/peer"
 	"github.com/libp2p/go-libp2p/core/peerstore"
 	"github.com/libp2p/go-libp2p/core/protocol"
-	mempstore "github.com/libp2p/go-libp2p/p2p/host/peerstore/pstoremem"
+	"github.com/libp2p/go-libp2p/p2p/host/peerstore/pstoreds"
 	rm "github.com/libp2p/go-libp2p/p2p/host/resource-manager"
 	ma "github.com/multiformats/go-multiaddr"
 	"github.com/prometheus/client_golang/prometheus"
@@ -81,31 +81,26 @@ type host struct {
 	ds              *badger.Datastore
 	messageCache    *messageCache
 	bwc             *metrics.BandwidthCounter
+	bandwidth       *bandwidthTracker
+	goodPeers       *goodPeerCache
+	messageFilter   func(to peer.ID, pid protocol.ID) bool
 	closeSync       sync.Once
-	externalAddr    ma.Multiaddr
+	externalAddrs   []ma.Multiaddr
 }
 
 func newHost(ctx context.Context, cfg *Config) (*host, error) {
-	// create multiaddress (without p2p identity)
-	listenAddress := fmt.Sprintf("/ip4/0.0.0.0/tcp/%d", cfg.Port)
-	if cfg.ListenAddress != "" {
-		listenAddress = cfg.ListenAddress
-	}
-	addr, err := ma.NewMultiaddr(listenAddress)
+	// create multiaddresses (without p2p identity)
+	listenAddrs, err := listenAddresses(cfg)
 	if err != nil {
 		return nil, err
 	}
 
-	portString, err := addr.ValueForProtocol(ma.P_TCP)
+	tlsConfig, err := webSocketTLSConfig(cfg, listenAddrs)
 	if err != nil {
 		return nil, err
 	}
 
-	port, err := strconv.ParseUint(portString, 10, 64)
-	if err != nil {
-		return nil, err
-	}
-	var externalAddr ma.Multiaddr
+	var publicHost ma.Multiaddr
 
 	switch {
 	case strings.TrimSpace(cfg.PublicIP) != "":
@@ -114,13 +109,13 @@ func newHost(ctx context.Context, cfg *Config) (*host, error) {
 			return nil, fmt.Errorf("invalid public ip: %s", cfg.PublicIP)
 		}
 		logger.Debugf("using config PublicIP: %s", ip)
-		externalAddr, err = ma.NewMultiaddr(fmt.Sprintf("/ip4/%s/tcp/%d", ip, port))
+		publicHost, err = ma.NewMultiaddr(fmt.Sprintf("/ip4/%s", ip))
 		if err != nil {
 			return nil, err
 		}
 	case strings.TrimSpace(cfg.PublicDNS) != "":
 		logger.Debugf("using config PublicDNS: %s", cfg.PublicDNS)
-		externalAddr, err = ma.NewMultiaddr(fmt.Sprintf("/dns/%s/tcp/%d", cfg.PublicDNS, port))
+		publicHost, err = ma.NewMultiaddr(fmt.Sprintf("/dns/%s", cfg.PublicDNS))
 		if err != nil {
 			return nil, err
 		}
@@ -130,12 +125,13 @@ func newHost(ctx context.Context, cfg *Config) (*host, error) {
 			logger.Errorf("failed to get public IP error: %v", err)
 		} else {
 			logger.Debugf("got public IP address %s", ip)
-			externalAddr, err = ma.NewMultiaddr(fmt.Sprintf("/ip4/%s/tcp/%d", ip, port))
+			publicHost, err = ma.NewMultiaddr(fmt.Sprintf("/ip4/%s", ip))
 			if err != nil {
 				return nil, err
 			}
 		}
 	}
+	externalAddrs := externalAddresses(listenAddrs, publicHost)
 
 	// format bootnodes
 	bns, err := stringsToAddrInfos(cfg.Bootnodes)
@@ -151,7 +147,6 @@ func newHost(ctx context.Context, cfg *Config) (*host, error) {
 
 	// We have tried to set maxInPeers and maxOutPeers such that number of peer
 	// connections remain between min peers and max peers
-	const reservedOnly = false
 	peerCfgSet := peerset.NewConfigSet(
 		//TODO: there is no any understanding of maxOutPeers and maxInPirs calculations.
 		// This needs to be explicitly mentioned
@@ -160,13 +155,22 @@ func newHost(ctx context.Context, cfg *Config) (*host, error) {
 		uint32(cfg.MaxPeers-cfg.MinPeers), //nolint:gosec
 		// maxOutPeers is later used in peerstate only and defines available Outgoing connection slots
 		uint32(cfg.MaxPeers/2), //nolint:gosec
-		reservedOnly,
+		cfg.ReservedOnly,
 		peerSetSlotAllocTime,
 	)
 
+	ds, err := badger.NewDatastore(path.Join(cfg.BasePath, "libp2p-datastore"), &badger.DefaultOptions)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create libp2p datastore: %w", err)
+	}
+
+	peerCfgSet.Store = peerset.NewStore(ds)
+	peerCfgSet.BanDuration = cfg.BanDuration
+
 	// create connection manager
 	cm, err := newConnManager(cfg.MaxPeers, peerCfgSet)
 	if err != nil {
+		_ = ds.Close()
 		return nil, fmt.Errorf("failed to create connection manager: %w", err)
 	}
 
@@ -177,14 +181,17 @@ func newHost(ctx context.Context, cfg *Config) (*host, error) {
 	// format protocol id
 	pid := protocol.ID(cfg.ProtocolID)
 
-	ds, err := badger.NewDatastore(path.Join(cfg.BasePath, "libp2p-datastore"), &badger.DefaultOptions)
+	// the peerstore is persisted so that the peers addresses and protocols survive restarts.
+	ps, err := pstoreds.NewPeerstore(ctx, ds, pstoreds.DefaultOpts())
 	if err != nil {
-		return nil, fmt.Errorf("failed to create libp2p datastore: %w", err)
+		_ = ds.Close()
+		return nil, fmt.Errorf("failed to create peerstore: %w", err)
 	}
 
-	ps, err := mempstore.NewPeerstore()
+	goodPeers, err := newGoodPeerCache(ds)
 	if err != nil {
-		return nil, fmt.Errorf("failed to create peerstore: %w", err)
+		_ = ds.Close()
+		return nil, fmt.Errorf("failed to create good peers cache: %w", err)
 	}
 
 	limiter := rm.NewFixedLimiter(rm.DefaultLimits.AutoScale())
@@ -208,7 +215,8 @@ func newHost(ctx context.Context, cfg *Config) (*host, error) {
 	// set libp2p host options
 	opts := []libp2p.Option{
 		libp2p.ResourceManager(manager),
-		libp2p.ListenAddrs(addr),
+		libp2p.ListenAddrs(listenAddrs...),
+		transportsOption(tlsConfig),
 		libp2p.DisableRelay(),
 		libp2p.Identity(cfg.privateKey),
 		libp2p.NATPortMap(),
@@ -221,17 +229,30 @@ func newHost(ctx context.Context, cfg *Config) (*host, error) {
 					addrs = append(addrs, addr)
 				}
 			}
-			if externalAddr == nil {
-				return addrs
-			}
-			return append(addrs, externalAddr)
+			return append(addrs, externalAddrs...)
 		}),
 	}
 
 	// create libp2p host instance
-	h, err := libp2p.New(opts...)
-	if err != nil {
-		return nil, err
+	var h libp2phost.Host
+	if cfg.simulation != nil {
+		h, err = cfg.simulation.Mocknet.AddPeer(cfg.privateKey, listenAddrs[0])
+		if err != nil {
+			return nil, fmt.Errorf("adding peer to mock network: %w", err)
+		}
+		// mock network hosts do not take a connection manager option,
+		// so it is notified of the connections explicitly.
+		h.Network().Notify(cm.Notifee())
+	} else {
+		h, err = libp2p.New(opts...)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var messageFilter func(to peer.ID, pid protocol.ID) bool
+	if cfg.simulation != nil {
+		messageFilter = cfg.simulation.MessageFilter
 	}
 
 	cacheSize := 64 << 20 // 64 MB
@@ -262,7 +283,10 @@ func newHost(ctx context.Context, cfg *Config) (*host, error) {
 		persistentPeers: pps,
 		messageCache:    msgCache,
 		bwc:             bwc,
-		externalAddr:    externalAddr,
+		bandwidth:       newBandwidthTracker(),
+		goodPeers:       goodPeers,
+		messageFilter:   messageFilter,
+		externalAddrs:   externalAddrs,
 	}
 
 	cm.host = host
@@ -292,6 +316,16 @@ func (h *host) close() error {
 			return
 		}
 
+		err = h.cm.peerSetHandler.Persist()
+		if err != nil {
+			logger.Errorf("Failed to persist peerset: %s", err)
+		}
+
+		err = h.goodPeers.close()
+		if err != nil {
+			logger.Errorf("Failed to persist good peers: %s", err)
+		}
+
 		err = h.ds.Close()
 		if err != nil {
 			logger.Errorf("Failed to close libp2p host datastore: %s", err)
@@ -315,13 +349,25 @@ func (h *host) connect(p peer.AddrInfo) (err error) {
 	return err
 }
 
-// bootstrap connects the host to the configured bootnodes
+// bootstrap connects the host to the configured bootnodes, after the good peers of the
+// previous runs whose addresses are known, so that they are dialled first.
 func (h *host) bootstrap() {
 	for _, info := range h.persistentPeers {
 		h.p2pHost.Peerstore().AddAddrs(info.ID, info.Addrs, peerstore.PermanentAddrTTL)
 		h.cm.peerSetHandler.AddReservedPeer(0, info.ID)
 	}
 
+	for _, info := range h.goodPeers.best() {
+		// the addresses of the peerstore expire soon after the peer disconnects,
+		// so the addresses of the cache are added again for as long as the peer is cached.
+		h.p2pHost.Peerstore().AddAddrs(info.ID, info.Addrs, goodPeerTTL)
+		if len(h.p2pHost.Peerstore().Addrs(info.ID)) == 0 {
+			continue
+		}
+		logger.Debugf("bootstrapping to good peer %s", info.ID)
+		h.cm.peerSetHandler.AddPeer(0, info.ID)
+	}
+
 	for _, addrInfo := range h.bootnodes {
 		logger.Debugf("bootstrapping to peer %s", addrInfo.ID)
 		h.p2pHost.Peerstore().AddAddrs(addrInfo.ID, addrInfo.Addrs, peerstore.PermanentAddrTTL)
@@ -330,10 +376,12 @@ func (h *host) bootstrap() {
 }
 
 // send creates a new outbound stream with the given peer and writes the message. It also returns
-// the newly created stream.
-func (h *host) send(p peer.ID, pid protocol.ID, msg messages.P2PMessage) (network.Stream, error) {
+// the newly created stream. The fallback protocol ids are negotiated if the peer does not support
+// the protocol id.
+func (h *host) send(p peer.ID, pid protocol.ID, msg messages.P2PMessage,
+	fallbacks ...protocol.ID) (network.Stream, error) {
 	// open outbound stream with host protocol id
-	stream, err := h.p2pHost.NewStream(h.ctx, p, pid)
+	stream, err := h.p2pHost.NewStream(h.ctx, p, append([]protocol.ID{pid}, fallbacks...)...)
 	if err != nil {
 		logger.Tracef("failed to open new stream with peer %s using protocol %s: %s", p, pid, err)
 		return nil, err
@@ -341,7 +389,7 @@ func (h *host) send(p peer.ID, pid protocol.ID, msg messages.P2PMessage) (networ
 
 	logger.Tracef(
 		"Opened stream with host %s, peer %s and protocol %s",
-		h.id(), p, pid)
+		h.id(), p, stream.Protocol())
 
 	err = h.writeToStream(stream, msg)
 	if err != nil {
@@ -356,6 +404,11 @@ func (h *host) send(p peer.ID, pid protocol.ID, msg messages.P2PMessage) (networ
 }
 
 func (h *host) writeToStream(s network.Stream, msg messages.P2PMessage) error {
+	if h.messageFilter != nil && !h.messageFilter(s.Conn().RemotePeer(), s.Protocol()) {
+		logger.Tracef("dropping message to peer %s using protocol %s", s.Conn().RemotePeer(), s.Protocol())
+		return nil
+	}
+
 	encMsg, err := msg.Encode()
 	if err != nil {
 		return err
@@ -374,7 +427,8 @@ func (h *host) writeToStream(s network.Stream, msg messages.P2PMessage) error {
 		logger.Errorf("full message not sent: sent %d, message size %d", sent, len(encMsg))
 	}
 
-	h.bwc.LogSentMessage(int64(sent))
+	h.bwc.LogSentMessageStream(int64(sent), s.Protocol(), s.Conn().RemotePeer())
+	h.bandwidth.logSent(s.Protocol(), s.Conn().RemotePeer(), uint64(sent))
 
 	return nil
 }
@@ -422,10 +476,10 @@ func (h *host) removeReservedPeers(ids ...string) error {
 	return nil
 }
 
-// supportsProtocol checks if the protocol is supported by peerID
+// supportsProtocol checks if one of the protocols is supported by peerID
 // returns an error if could not get peer protocols
-func (h *host) supportsProtocol(peerID peer.ID, protocol protocol.ID) (bool, error) {
-	peerProtocols, err := h.p2pHost.Peerstore().SupportsProtocols(peerID, protocol)
+func (h *host) supportsProtocol(peerID peer.ID, protocols ...protocol.ID) (bool, error) {
+	peerProtocols, err := h.p2pHost.Peerstore().SupportsProtocols(peerID, protocols...)
 	if err != nil {
 		return false, err
 	}
@@ -467,16 +521,16 @@ func (h *host) closePeer(peer peer.ID) error {
 	return h.p2pHost.Network().ClosePeer(peer)
 }
 
-func (h *host) closeProtocolStream(pID protocol.ID, p peer.ID) {
+func (h *host) closeProtocolStream(pIDs []protocol.ID, p peer.ID) {
 	connToPeer := h.p2pHost.Network().ConnsToPeer(p)
 	for _, c := range connToPeer {
 		for _, st := range c.GetStreams() {
-			if st.Protocol() != pID {
+			if !slices.Contains(pIDs, st.Protocol()) {
 				continue
 			}
 			err := st.Close()
 			if err != nil {
-				logger.Tracef("Failed to close stream for protocol %s: %s", pID, err)
+				logger.Tracef("Failed to close stream for protocol %s: %s", st.Protocol(), err)
 			}
 		}
 	}
@@ -144,6 +144,47 @@ func TestConnect(t *testing.T) {
 	require.Equal(t, 1, peerCountB)
 }
 
+// test host connect method over a websocket listen address
+func TestConnectWebSocket(t *testing.T) {
+	t.Parallel()
+
+	configA := &Config{
+		BasePath:    t.TempDir(),
+		Port:        availablePort(t),
+		NoBootstrap: true,
+		NoMDNS:      true,
+	}
+
+	nodeA := createTestService(t, configA)
+	nodeA.noGossip = true
+
+	portB := availablePort(t)
+	configB := &Config{
+		BasePath:      t.TempDir(),
+		ListenAddress: fmt.Sprintf("/ip4/127.0.0.1/tcp/%d/ws", portB),
+		NoBootstrap:   true,
+		NoMDNS:        true,
+	}
+
+	nodeB := createTestService(t, configB)
+	nodeB.noGossip = true
+
+	addrInfoB := addrInfo(nodeB.host)
+	expectedAddr := mustNewMultiAddr(fmt.Sprintf("/ip4/127.0.0.1/tcp/%d/ws", portB))
+	require.Contains(t, addrInfoB.Addrs, expectedAddr)
+
+	err := nodeA.host.connect(addrInfoB)
+	// retry connect if "failed to dial" error
+	if failedToDial(err) {
+		time.Sleep(TestBackoffTimeout)
+		err = nodeA.host.connect(addrInfoB)
+	}
+	require.NoError(t, err)
+
+	require.Equal(t, 1, nodeA.host.peerCount())
+	require.Equal(t, 1, nodeB.host.peerCount())
+}
+
 // test host bootstrap method on start
 func TestBootstrap(t *testing.T) {
 	t.Parallel()
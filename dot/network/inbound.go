@@ -49,7 +49,8 @@ func (s *Service) readStream(stream libp2pnetwork.Stream, decoder messageDecoder
 			return
 		}
 
-		s.host.bwc.LogRecvMessage(int64(n))
+		s.host.bwc.LogRecvMessageStream(int64(n), stream.Protocol(), peer)
+		s.host.bandwidth.logReceived(stream.Protocol(), peer, uint64(n))
 	}
 }
 
@@ -61,11 +62,11 @@ func (s *Service) resetInboundStream(stream libp2pnetwork.Stream) {
 	defer s.notificationsMu.Unlock()
 
 	for _, prtl := range s.notificationsProtocols {
-		if prtl.protocolID != protocolID {
+		if !prtl.hasProtocolID(protocolID) {
 			continue
 		}
 
-		prtl.peersData.deleteInboundHandshakeData(peerID)
+		prtl.peersData.deleteInboundStreamHandshakeData(peerID, stream)
 		break
 	}
 
@@ -17,6 +17,7 @@
 	blockAnnounceMsgType MessageType = iota + 3
 	transactionMsgType
 	ConsensusMsgType
+	BeefyMsgType
 )
 
 // NotificationsMessage must be implemented by all messages sent over a notifications protocol
@@ -26,7 +27,10 @@ type NotificationsMessage interface {
 	Hash() (common.Hash, error)
 }
 
-var _ NotificationsMessage = &ConsensusMessage{}
+var (
+	_ NotificationsMessage = &ConsensusMessage{}
+	_ NotificationsMessage = &BeefyMessage{}
+)
 
 // ConsensusMessage is mostly opaque to us
 type ConsensusMessage struct {
@@ -63,3 +67,34 @@ func (cm *ConsensusMessage) Hash() (common.Hash, error) {
 	}
 	return common.Blake2bHash(encMsg)
 }
+
+// BeefyMessage is a BEEFY gossip message, opaque to us
+type BeefyMessage struct {
+	Data []byte
+}
+
+// Type returns BeefyMsgType
+func (*BeefyMessage) Type() MessageType {
+	return BeefyMsgType
+}
+
+// String is the string
+func (bm *BeefyMessage) String() string {
+	return fmt.Sprintf("BeefyMessage Data=%x", bm.Data)
+}
+
+// Encode returns the message data, which is already SCALE encoded
+func (bm *BeefyMessage) Encode() ([]byte, error) {
+	return bm.Data, nil
+}
+
+// Decode the message into a BeefyMessage
+func (bm *BeefyMessage) Decode(in []byte) error {
+	bm.Data = in
+	return nil
+}
+
+// Hash returns the Hash of BeefyMessage
+func (bm *BeefyMessage) Hash() (common.Hash, error) {
+	return common.Blake2bHash(bm.Data)
+}
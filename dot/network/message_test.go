@@ -147,6 +147,39 @@ func TestEncodeBlockResponseMessage_WithAll(t *testing.T) {
 	require.Equal(t, bm, act)
 }
 
+func TestEncodeBlockResponseMessage_WithJustifications(t *testing.T) {
+	t.Parallel()
+
+	bd := &types.BlockData{
+		Hash: common.NewHash([]byte{0}),
+		Justifications: types.Justifications{
+			{EngineID: types.GrandpaEngineID, EncodedJustification: []byte{3}},
+			{EngineID: types.BeefyEngineID, EncodedJustification: []byte{4}},
+		},
+	}
+
+	bm := &messages.BlockResponseMessage{
+		BlockData: []*types.BlockData{bd},
+	}
+
+	enc, err := bm.Encode()
+	require.NoError(t, err)
+
+	act := &messages.BlockResponseMessage{}
+	err = act.Decode(enc)
+	require.NoError(t, err)
+
+	// the GRANDPA justification is also the single justification of the block.
+	expected := &messages.BlockResponseMessage{
+		BlockData: []*types.BlockData{{
+			Hash:           bd.Hash,
+			Justification:  &[]byte{3},
+			Justifications: bd.Justifications,
+		}},
+	}
+	require.Equal(t, expected, act)
+}
+
 func TestEncodeBlockAnnounceMessage(t *testing.T) {
 	/* this value is a concatenation of:
 	 *  ParentHash: Hash: 0x4545454545454545454545454545454545454545454545454545454545454545
@@ -335,10 +368,11 @@ func TestAscendingBlockRequest(t *testing.T) {
 			targetNumber: 10,
 			expectedBlockRequestMessage: []*messages.BlockRequestMessage{
 				{
-					RequestedData: messages.BootstrapRequestData,
-					StartingBlock: *messages.NewFromBlock(uint(10)),
-					Direction:     messages.Ascending,
-					Max:           &one,
+					RequestedData:                 messages.BootstrapRequestData,
+					StartingBlock:                 *messages.NewFromBlock(uint(10)),
+					Direction:                     messages.Ascending,
+					Max:                           &one,
+					SupportMultipleJustifications: true,
 				},
 			},
 			expectedTotalOfBlocksRequested: 1,
@@ -350,10 +384,11 @@ func TestAscendingBlockRequest(t *testing.T) {
 			expectedTotalOfBlocksRequested: 128,
 			expectedBlockRequestMessage: []*messages.BlockRequestMessage{
 				{
-					RequestedData: messages.BootstrapRequestData,
-					StartingBlock: *messages.NewFromBlock(uint(1)),
-					Direction:     messages.Ascending,
-					Max:           &maxResponseSize,
+					RequestedData:                 messages.BootstrapRequestData,
+					StartingBlock:                 *messages.NewFromBlock(uint(1)),
+					Direction:                     messages.Ascending,
+					Max:                           &maxResponseSize,
+					SupportMultipleJustifications: true,
 				},
 			},
 		},
@@ -364,28 +399,32 @@ func TestAscendingBlockRequest(t *testing.T) {
 			expectedTotalOfBlocksRequested: 512,
 			expectedBlockRequestMessage: []*messages.BlockRequestMessage{
 				{
-					RequestedData: messages.BootstrapRequestData,
-					StartingBlock: *messages.NewFromBlock(uint(1)),
-					Direction:     messages.Ascending,
-					Max:           &maxResponseSize,
+					RequestedData:                 messages.BootstrapRequestData,
+					StartingBlock:                 *messages.NewFromBlock(uint(1)),
+					Direction:                     messages.Ascending,
+					Max:                           &maxResponseSize,
+					SupportMultipleJustifications: true,
 				},
 				{
-					RequestedData: messages.BootstrapRequestData,
-					StartingBlock: *messages.NewFromBlock(uint(129)),
-					Direction:     messages.Ascending,
-					Max:           &maxResponseSize,
+					RequestedData:                 messages.BootstrapRequestData,
+					StartingBlock:                 *messages.NewFromBlock(uint(129)),
+					Direction:                     messages.Ascending,
+					Max:                           &maxResponseSize,
+					SupportMultipleJustifications: true,
 				},
 				{
-					RequestedData: messages.BootstrapRequestData,
-					StartingBlock: *messages.NewFromBlock(uint(257)),
-					Direction:     messages.Ascending,
-					Max:           &maxResponseSize,
+					RequestedData:                 messages.BootstrapRequestData,
+					StartingBlock:                 *messages.NewFromBlock(uint(257)),
+					Direction:                     messages.Ascending,
+					Max:                           &maxResponseSize,
+					SupportMultipleJustifications: true,
 				},
 				{
-					RequestedData: messages.BootstrapRequestData,
-					StartingBlock: *messages.NewFromBlock(uint(385)),
-					Direction:     messages.Ascending,
-					Max:           &maxResponseSize,
+					RequestedData:                 messages.BootstrapRequestData,
+					StartingBlock:                 *messages.NewFromBlock(uint(385)),
+					Direction:                     messages.Ascending,
+					Max:                           &maxResponseSize,
+					SupportMultipleJustifications: true,
 				},
 			},
 		},
@@ -395,34 +434,39 @@ func TestAscendingBlockRequest(t *testing.T) {
 			expectedTotalOfBlocksRequested: 515,
 			expectedBlockRequestMessage: []*messages.BlockRequestMessage{
 				{
-					RequestedData: messages.BootstrapRequestData,
-					StartingBlock: *messages.NewFromBlock(uint(1)),
-					Direction:     messages.Ascending,
-					Max:           &maxResponseSize,
+					RequestedData:                 messages.BootstrapRequestData,
+					StartingBlock:                 *messages.NewFromBlock(uint(1)),
+					Direction:                     messages.Ascending,
+					Max:                           &maxResponseSize,
+					SupportMultipleJustifications: true,
 				},
 				{
-					RequestedData: messages.BootstrapRequestData,
-					StartingBlock: *messages.NewFromBlock(uint(129)),
-					Direction:     messages.Ascending,
-					Max:           &maxResponseSize,
+					RequestedData:                 messages.BootstrapRequestData,
+					StartingBlock:                 *messages.NewFromBlock(uint(129)),
+					Direction:                     messages.Ascending,
+					Max:                           &maxResponseSize,
+					SupportMultipleJustifications: true,
 				},
 				{
-					RequestedData: messages.BootstrapRequestData,
-					StartingBlock: *messages.NewFromBlock(uint(257)),
-					Direction:     messages.Ascending,
-					Max:           &maxResponseSize,
+					RequestedData:                 messages.BootstrapRequestData,
+					StartingBlock:                 *messages.NewFromBlock(uint(257)),
+					Direction:                     messages.Ascending,
+					Max:                           &maxResponseSize,
+					SupportMultipleJustifications: true,
 				},
 				{
-					RequestedData: messages.BootstrapRequestData,
-					StartingBlock: *messages.NewFromBlock(uint(385)),
-					Direction:     messages.Ascending,
-					Max:           &maxResponseSize,
+					RequestedData:                 messages.BootstrapRequestData,
+					StartingBlock:                 *messages.NewFromBlock(uint(385)),
+					Direction:                     messages.Ascending,
+					Max:                           &maxResponseSize,
+					SupportMultipleJustifications: true,
 				},
 				{
-					RequestedData: messages.BootstrapRequestData,
-					StartingBlock: *messages.NewFromBlock(uint(513)),
-					Direction:     messages.Ascending,
-					Max:           &three,
+					RequestedData:                 messages.BootstrapRequestData,
+					StartingBlock:                 *messages.NewFromBlock(uint(513)),
+					Direction:                     messages.Ascending,
+					Max:                           &three,
+					SupportMultipleJustifications: true,
 				},
 			},
 		},
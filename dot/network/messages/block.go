@@ -126,15 +126,20 @@ type BlockRequestMessage struct {
 	StartingBlock FromBlock
 	Direction     SyncDirection // 0 = ascending, 1 = descending
 	Max           *uint32
+
+	// SupportMultipleJustifications asks the peer to send the justifications of
+	// every consensus engine, such as the BEEFY ones, and not only the GRANDPA one.
+	SupportMultipleJustifications bool
 }
 
 func NewBlockRequest(startingBlock FromBlock, amount uint32,
 	requestedData byte, direction SyncDirection) *BlockRequestMessage {
 	return &BlockRequestMessage{
-		RequestedData: requestedData,
-		StartingBlock: startingBlock,
-		Direction:     direction,
-		Max:           &amount,
+		RequestedData:                 requestedData,
+		StartingBlock:                 startingBlock,
+		Direction:                     direction,
+		Max:                           &amount,
+		SupportMultipleJustifications: true,
 	}
 }
 
@@ -206,9 +211,10 @@ func (bm *BlockRequestMessage) Encode() ([]byte, error) {
 	}
 
 	msg := &pb.BlockRequest{
-		Fields:    uint32(bm.RequestedData) << 24, // put byte in most significant byte of uint32
-		Direction: pb.Direction(bm.Direction),
-		MaxBlocks: max,
+		Fields:                        uint32(bm.RequestedData) << 24, // put byte in most significant byte of uint32
+		Direction:                     pb.Direction(bm.Direction),
+		MaxBlocks:                     max,
+		SupportMultipleJustifications: bm.SupportMultipleJustifications,
 	}
 
 	protoType, encoded := bm.StartingBlock.Encode()
@@ -267,6 +273,7 @@ func (bm *BlockRequestMessage) Decode(in []byte) error {
 	bm.StartingBlock = *startingBlock
 	bm.Direction = SyncDirection(byte(msg.Direction))
 	bm.Max = max
+	bm.SupportMultipleJustifications = msg.SupportMultipleJustifications
 
 	return nil
 }
@@ -369,6 +376,14 @@ func blockDataToProtobuf(bd *types.BlockData) (*pb.BlockData, error) {
 		}
 	}
 
+	if bd.Justifications != nil {
+		justifications, err := scale.Marshal(bd.Justifications)
+		if err != nil {
+			return nil, fmt.Errorf("encoding justifications: %w", err)
+		}
+		p.Justifications = justifications
+	}
+
 	return p, nil
 }
 
@@ -420,5 +435,18 @@ func protobufToBlockData(pbd *pb.BlockData) (*types.BlockData, error) {
 		bd.Justification = &[]byte{}
 	}
 
+	if pbd.Justifications != nil {
+		err := scale.Unmarshal(pbd.Justifications, &bd.Justifications)
+		if err != nil {
+			return nil, fmt.Errorf("decoding justifications: %w", err)
+		}
+
+		// the peers supporting multiple justifications do not send the single GRANDPA one.
+		grandpaJustification, ok := bd.Justifications.Get(types.GrandpaEngineID)
+		if bd.Justification == nil && ok {
+			bd.Justification = &grandpaJustification
+		}
+	}
+
 	return bd, nil
 }
@@ -0,0 +1,150 @@
+// Copyright 2024 ChainSafe Systems (ON)
+// SPDX-License-Identifier: LGPL-3.0-only
+
+package netsim
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+
+	"github.com/ChainSafe/gossamer/dot/network"
+	"github.com/ChainSafe/gossamer/dot/network/messages"
+	"github.com/ChainSafe/gossamer/dot/types"
+	"github.com/ChainSafe/gossamer/lib/common"
+)
+
+var errUnknownBlock = errors.New("unknown block")
+
+// Chain is an in-memory chain of block headers. It implements the block state
+// and the syncer needed by the network service, importing the headers of the
+// blocks announced by the peers, so that simple simulations can run without a
+// database, a runtime or a sync service.
+type Chain struct {
+	mu        sync.RWMutex
+	genesis   *types.Header
+	headers   map[common.Hash]*types.Header
+	best      *types.Header
+	finalised *types.Header
+}
+
+// NewChain returns a chain containing only the genesis header given.
+func NewChain(genesis *types.Header) *Chain {
+	return &Chain{
+		genesis:   genesis,
+		headers:   map[common.Hash]*types.Header{genesis.Hash(): genesis},
+		best:      genesis,
+		finalised: genesis,
+	}
+}
+
+// BestBlockHeader returns the header with the highest number, the first
+// one imported winning ties.
+func (c *Chain) BestBlockHeader() (*types.Header, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.best, nil
+}
+
+// GenesisHash returns the hash of the genesis header.
+func (c *Chain) GenesisHash() common.Hash {
+	return c.genesis.Hash()
+}
+
+// GetHighestFinalisedHeader returns the last finalised header.
+func (c *Chain) GetHighestFinalisedHeader() (*types.Header, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.finalised, nil
+}
+
+// AddBlock imports the header, whose parent must already be in the chain.
+func (c *Chain) AddBlock(header *types.Header) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if _, has := c.headers[header.ParentHash]; !has {
+		return fmt.Errorf("%w: parent %s of block %s", errUnknownBlock, header.ParentHash, header.Hash())
+	}
+
+	c.headers[header.Hash()] = header
+	if header.Number > c.best.Number {
+		c.best = header
+	}
+	return nil
+}
+
+// Finalise marks the header with the given hash as finalised.
+func (c *Chain) Finalise(hash common.Hash) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	header, has := c.headers[hash]
+	if !has {
+		return fmt.Errorf("%w: %s", errUnknownBlock, hash)
+	}
+	c.finalised = header
+	return nil
+}
+
+// HasHeader returns true if the header with the given hash has been imported.
+func (c *Chain) HasHeader(hash common.Hash) bool {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	_, has := c.headers[hash]
+	return has
+}
+
+// HandleBlockAnnounceHandshake accepts the handshakes of all peers.
+func (*Chain) HandleBlockAnnounceHandshake(peer.ID, *network.BlockAnnounceHandshake) error {
+	return nil
+}
+
+// HandleBlockAnnounce imports the header of the block announced, if its
+// parent is known.
+func (c *Chain) HandleBlockAnnounce(_ peer.ID, msg *network.BlockAnnounceMessage) error {
+	header := types.NewHeader(msg.ParentHash, msg.StateRoot, msg.ExtrinsicsRoot, msg.Number, msg.Digest)
+	return c.AddBlock(header)
+}
+
+// IsSynced always returns true, since blocks are only imported from announces.
+func (*Chain) IsSynced() bool {
+	return true
+}
+
+// CreateBlockResponse returns an empty block response.
+func (*Chain) CreateBlockResponse(peer.ID, *messages.BlockRequestMessage) (*messages.BlockResponseMessage, error) {
+	return &messages.BlockResponseMessage{}, nil
+}
+
+// OnConnectionClosed does nothing.
+func (*Chain) OnConnectionClosed(peer.ID) {}
+
+// AnnounceMessage returns the block announce message of the header.
+func AnnounceMessage(header *types.Header, bestBlock bool) *network.BlockAnnounceMessage {
+	return &network.BlockAnnounceMessage{
+		ParentHash:     header.ParentHash,
+		Number:         header.Number,
+		StateRoot:      header.StateRoot,
+		ExtrinsicsRoot: header.ExtrinsicsRoot,
+		Digest:         header.Digest,
+		BestBlock:      bestBlock,
+	}
+}
+
+// transactionHandler accepts and discards all transactions.
+type transactionHandler struct{}
+
+func (transactionHandler) HandleTransactionMessage(peer.ID, *network.TransactionMessage) (bool, error) {
+	return true, nil
+}
+
+func (transactionHandler) TransactionsCount() int { return 0 }
+
+// telemetry discards all telemetry messages.
+type telemetry struct{}
+
+func (telemetry) SendMessage(json.Marshaler) {}
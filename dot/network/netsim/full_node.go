@@ -0,0 +1,173 @@
+// Copyright 2024 ChainSafe Systems (ON)
+// SPDX-License-Identifier: LGPL-3.0-only
+
+package netsim
+
+import (
+	"encoding/hex"
+	"fmt"
+	"path/filepath"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/stretchr/testify/require"
+
+	westendlocal "github.com/ChainSafe/gossamer/chain/westend-local"
+	"github.com/ChainSafe/gossamer/dot"
+	"github.com/ChainSafe/gossamer/dot/network"
+	"github.com/ChainSafe/gossamer/dot/state"
+	"github.com/ChainSafe/gossamer/lib/common"
+	"github.com/ChainSafe/gossamer/lib/keystore"
+	"github.com/ChainSafe/gossamer/lib/utils"
+)
+
+const fullNodeLogLevel = "error"
+
+// FullNodeConfig is the configuration of a simulated gossamer node, running the state, core,
+// sync, BABE and GRANDPA services on the westend-local chain whose authorities are alice, bob
+// and charlie. Full nodes do not share the genesis of the in-memory chains, so they must not
+// be connected to the nodes backed by an in-memory Chain.
+type FullNodeConfig struct {
+	// Key is the name of the dev key, such as alice, bob or charlie, the node authors blocks
+	// and votes with. If it is empty, the node neither authors blocks nor votes.
+	Key string
+}
+
+// AddFullNode creates and starts a gossamer node. It is not connected to the other nodes
+// until Connect or ConnectAll is called.
+func (s *Simulator) AddFullNode(cfg FullNodeConfig) *Node {
+	s.t.Helper()
+
+	return s.AddFullNodes(cfg)[0]
+}
+
+// AddFullNodes creates gossamer nodes and starts them once they are all created, so that
+// the authorities start authoring blocks at about the same time instead of building
+// competing forks while the next nodes are created. They are not connected to the other
+// nodes until Connect or ConnectAll is called.
+func (s *Simulator) AddFullNodes(cfgs ...FullNodeConfig) []*Node {
+	s.t.Helper()
+
+	created := len(s.Nodes())
+	gossamerNodes := make([]*dot.Node, len(cfgs))
+	for i, cfg := range cfgs {
+		gossamerNodes[i] = s.newFullNode(cfg, created+i)
+	}
+
+	nodes := make([]*Node, len(cfgs))
+	for i, gossamerNode := range gossamerNodes {
+		nodes[i] = s.startFullNode(gossamerNode)
+	}
+	return nodes
+}
+
+// newFullNode creates the gossamer node of the given index in the simulation without starting it.
+func (s *Simulator) newFullNode(cfg FullNodeConfig, index int) *dot.Node {
+	s.t.Helper()
+
+	s.mu.Lock()
+	// the node key is derived from the simulation seed so that the network identity is deterministic.
+	nodeKey := make([]byte, 32)
+	_, _ = s.random.Read(nodeKey)
+	s.mu.Unlock()
+
+	rootPath, err := utils.GetProjectRootPath()
+	require.NoError(s.t, err)
+
+	config := westendlocal.DefaultConfig()
+	config.Name = fmt.Sprintf("netsim-%d", index)
+	config.BasePath = s.t.TempDir()
+	config.ChainSpec = filepath.Join(rootPath, "chain", "westend-local", "westend-local-spec-raw.json")
+	config.NoTelemetry = true
+	config.PrometheusExternal = false
+	config.Pprof.Enabled = false
+	config.RPC.RPCExternal = false
+	config.RPC.UnsafeRPC = false
+	config.RPC.UnsafeRPCExternal = false
+	config.RPC.WSExternal = false
+	config.RPC.UnsafeWSExternal = false
+	config.Network.NoBootstrap = true
+	config.Network.NoMDNS = true
+	// the public address is not looked up since the simulated nodes are not reachable anyway.
+	config.Network.PublicIP = "127.0.0.1"
+	// the simulations run with a handful of nodes, so the sync must not wait for more peers.
+	config.Network.MinPeers = 1
+	config.Network.NodeKey = hex.EncodeToString(nodeKey)
+	config.Network.ListenAddress = fmt.Sprintf("/ip4/127.0.0.1/tcp/%d", 30000+index)
+	config.LogLevel = fullNodeLogLevel
+	config.Log.Core = fullNodeLogLevel
+	config.Log.Digest = fullNodeLogLevel
+	config.Log.Sync = fullNodeLogLevel
+	config.Log.Network = fullNodeLogLevel
+	config.Log.RPC = fullNodeLogLevel
+	config.Log.State = fullNodeLogLevel
+	config.Log.Runtime = fullNodeLogLevel
+	config.Log.Babe = fullNodeLogLevel
+	config.Log.Grandpa = fullNodeLogLevel
+	config.Log.Wasmer = fullNodeLogLevel
+
+	ks := keystore.NewGlobalKeystore()
+	if cfg.Key != "" {
+		config.Core.Role = common.AuthorityRole
+		config.Core.BabeAuthority = true
+		config.Core.GrandpaAuthority = true
+
+		sr25519Keyring, err := keystore.NewSr25519Keyring()
+		require.NoError(s.t, err)
+		err = keystore.LoadKeystore(cfg.Key, ks.Babe, sr25519Keyring)
+		require.NoError(s.t, err)
+
+		ed25519Keyring, err := keystore.NewEd25519Keyring()
+		require.NoError(s.t, err)
+		err = keystore.LoadKeystore(cfg.Key, ks.Gran, ed25519Keyring)
+		require.NoError(s.t, err)
+	} else {
+		config.Core.Role = common.FullNodeRole
+		config.Core.BabeAuthority = false
+		config.Core.GrandpaAuthority = false
+	}
+
+	gossamerNode, err := dot.NewSimulatedNode(config, ks, network.SimulationConfig{
+		Mocknet:       s.mocknet,
+		MessageFilter: s.deliver,
+	})
+	require.NoError(s.t, err)
+
+	return gossamerNode
+}
+
+// startFullNode starts the gossamer node and adds it to the simulation.
+func (s *Simulator) startFullNode(gossamerNode *dot.Node) *Node {
+	s.t.Helper()
+
+	gossamerNode.ServiceRegistry.StartAll()
+	s.t.Cleanup(gossamerNode.ServiceRegistry.StopAll)
+
+	networkService, ok := gossamerNode.ServiceRegistry.Get(&network.Service{}).(*network.Service)
+	require.True(s.t, ok, "node has no network service")
+	stateService, ok := gossamerNode.ServiceRegistry.Get(&state.Service{}).(*state.Service)
+	require.True(s.t, ok, "node has no state service")
+
+	node := &Node{
+		Service: networkService,
+		State:   stateService,
+	}
+	var err error
+	node.ID, err = peer.Decode(networkService.NetworkState().PeerID)
+	require.NoError(s.t, err)
+
+	// cleanups run in the reverse order of their registration, so the node is unlinked before
+	// it is stopped and the other nodes cannot send it requests it would serve from a closed state.
+	s.t.Cleanup(func() {
+		for _, other := range s.Nodes() {
+			if other != node {
+				s.disconnect(node, other)
+			}
+		}
+	})
+
+	s.mu.Lock()
+	s.nodes = append(s.nodes, node)
+	s.mu.Unlock()
+
+	return node
+}
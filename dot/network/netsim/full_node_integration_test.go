@@ -0,0 +1,115 @@
+// Copyright 2024 ChainSafe Systems (ON)
+// SPDX-License-Identifier: LGPL-3.0-only
+
+//go:build integration
+
+package netsim
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	// the westend-local slot duration is 6 seconds.
+	fullNodeWaitFor = 3 * time.Minute
+	fullNodeTick    = 100 * time.Millisecond
+)
+
+func bestNumber(t *testing.T, node *Node) uint {
+	t.Helper()
+	header, err := node.State.Block.BestBlockHeader()
+	require.NoError(t, err)
+	return header.Number
+}
+
+func finalisedNumber(t *testing.T, node *Node) uint {
+	t.Helper()
+	header, err := node.State.Block.GetHighestFinalisedHeader()
+	require.NoError(t, err)
+	return header.Number
+}
+
+// addAuthorities adds the three authorities of the chain and connects them as soon as they
+// started, so that they do not author competing forks before they are connected.
+func addAuthorities(s *Simulator) (alice, bob, charlie *Node) {
+	nodes := s.AddFullNodes(FullNodeConfig{Key: "alice"}, FullNodeConfig{Key: "bob"}, FullNodeConfig{Key: "charlie"})
+	s.ConnectAll()
+	return nodes[0], nodes[1], nodes[2]
+}
+
+func TestFullNodes_FinalityStall(t *testing.T) {
+	s := New(t, 1)
+	alice, bob, charlie := addAuthorities(s)
+
+	require.Eventually(t, func() bool {
+		return finalisedNumber(t, alice) >= 1 && finalisedNumber(t, bob) >= 1 &&
+			finalisedNumber(t, charlie) >= 1
+	}, fullNodeWaitFor, fullNodeTick)
+
+	// the three authorities must all vote to finalise blocks, so isolating charlie stalls the
+	// finality while alice and bob keep producing blocks.
+	s.Partition([]*Node{alice, bob}, []*Node{charlie})
+
+	// give the votes in flight some blocks to be counted.
+	partitionBest := bestNumber(t, alice)
+	require.Eventually(t, func() bool {
+		return bestNumber(t, alice) >= partitionBest+2
+	}, fullNodeWaitFor, fullNodeTick)
+
+	stalled := finalisedNumber(t, alice)
+	stalledBest := bestNumber(t, alice)
+	require.Eventually(t, func() bool {
+		return bestNumber(t, alice) >= stalledBest+3
+	}, fullNodeWaitFor, fullNodeTick)
+	require.Equal(t, stalled, finalisedNumber(t, alice))
+	require.Equal(t, stalled, finalisedNumber(t, bob))
+
+	// once charlie is back, it syncs the blocks produced without it. The votes of the round
+	// lost during the partition are not gossiped again, so the finality is not expected to resume.
+	s.Heal()
+
+	// the best block of alice may be on a fork abandoned later on, so charlie is only expected to
+	// follow the chain of alice and bob, past the height it had when the partition healed.
+	healBest := bestNumber(t, alice)
+	require.Eventually(t, func() bool {
+		charlieBest, err := charlie.State.Block.BestBlockHeader()
+		require.NoError(t, err)
+		aliceBest, err := alice.State.Block.BestBlockHeader()
+		require.NoError(t, err)
+		if charlieBest.Number < healBest {
+			return false
+		}
+		// alice does not know the best block of charlie yet if charlie authored it
+		sameChain, err := alice.State.Block.IsDescendantOf(charlieBest.Hash(), aliceBest.Hash())
+		return err == nil && sameChain
+	}, fullNodeWaitFor, fullNodeTick)
+	require.LessOrEqual(t, finalisedNumber(t, charlie), stalled)
+}
+
+func TestFullNodes_SyncWhileProducing(t *testing.T) {
+	s := New(t, 2)
+	alice, bob, charlie := addAuthorities(s)
+
+	require.Eventually(t, func() bool {
+		return finalisedNumber(t, alice) >= 2
+	}, fullNodeWaitFor, fullNodeTick)
+
+	// dave joins late and syncs the chain while the authorities keep producing and
+	// finalising blocks, some of its links being slower than the others.
+	dave := s.AddFullNode(FullNodeConfig{})
+	s.ConnectAll()
+	s.SetLinkLatency(dave, charlie, 200*time.Millisecond)
+
+	target := finalisedNumber(t, alice)
+	require.Eventually(t, func() bool {
+		return finalisedNumber(t, dave) >= target
+	}, fullNodeWaitFor, fullNodeTick)
+
+	targetBest := bestNumber(t, bob)
+	require.Eventually(t, func() bool {
+		return bestNumber(t, dave) >= targetBest
+	}, fullNodeWaitFor, fullNodeTick)
+}
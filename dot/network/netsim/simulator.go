@@ -0,0 +1,299 @@
+// Copyright 2024 ChainSafe Systems (ON)
+// SPDX-License-Identifier: LGPL-3.0-only
+
+// Package netsim simulates networks of nodes inside a single process, running
+// the network services on a libp2p mock network whose latency, partitions and
+// message loss are controlled by the test.
+//
+// A node added with AddNode runs a dot/network service backed by an in-memory Chain by
+// default, to simulate the block announces quickly. A node added with AddFullNode runs all
+// the services of a gossamer node, including BABE and GRANDPA, so that the block production,
+// the finality and the sync can be simulated together.
+package netsim
+
+import (
+	"fmt"
+	"math/rand"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/libp2p/go-libp2p/core/protocol"
+	mocknet "github.com/libp2p/go-libp2p/p2p/net/mock"
+	"github.com/libp2p/go-libp2p/p2p/protocol/identify"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ChainSafe/gossamer/dot/network"
+	"github.com/ChainSafe/gossamer/dot/state"
+	"github.com/ChainSafe/gossamer/dot/types"
+	"github.com/ChainSafe/gossamer/internal/log"
+	"github.com/ChainSafe/gossamer/lib/common"
+)
+
+const identifyTimeout = 5 * time.Second
+
+// NodeConfig is the configuration of a simulated node. The block state, syncer
+// and transaction handler default to an in-memory Chain and a handler accepting
+// all transactions.
+type NodeConfig struct {
+	Roles              common.NetworkRole
+	BlockState         network.BlockState
+	Syncer             network.Syncer
+	TransactionHandler network.TransactionHandler
+	WarpSyncProvider   network.WarpSyncProvider
+}
+
+// Node is a simulated node.
+type Node struct {
+	ID      peer.ID
+	Service *network.Service
+	// Chain is the in-memory chain of the node, it is nil if
+	// the node config has a block state or if the node is a full node.
+	Chain *Chain
+	// State is the state service of a full node, it is nil for the other nodes.
+	State *state.Service
+}
+
+// Simulator runs simulated nodes on a libp2p mock network. The randomness of the
+// simulation, such as the node identities and the messages lost, is derived from
+// its seed so that a failing simulation can be reproduced.
+type Simulator struct {
+	t       testing.TB
+	mocknet mocknet.Mocknet
+	genesis *types.Header
+
+	mu       sync.Mutex
+	random   *rand.Rand
+	lossRate float64
+	dropped  int
+	nodes    []*Node
+}
+
+// New creates a simulator whose randomness is derived from the seed given.
+// Its nodes are stopped when the test ends.
+func New(t testing.TB, seed int64) *Simulator {
+	t.Helper()
+
+	s := &Simulator{
+		t:       t,
+		mocknet: mocknet.New(),
+		genesis: types.NewHeader(common.Hash{}, common.Hash{}, common.Hash{}, 0, types.NewDigest()),
+		random:  rand.New(rand.NewSource(seed)), //nolint:gosec
+	}
+
+	t.Cleanup(func() {
+		_ = s.mocknet.Close()
+	})
+
+	return s
+}
+
+// Genesis returns the genesis header of the in-memory chains of the nodes.
+func (s *Simulator) Genesis() *types.Header {
+	return s.genesis
+}
+
+// Nodes returns the nodes of the simulation, in the order they were added.
+func (s *Simulator) Nodes() []*Node {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]*Node(nil), s.nodes...)
+}
+
+// AddNode creates and starts a node. It is not connected to the other nodes
+// until Connect or ConnectAll is called.
+func (s *Simulator) AddNode(cfg NodeConfig) *Node {
+	s.t.Helper()
+
+	s.mu.Lock()
+	index := len(s.nodes)
+	// the random seed of the network identity must be non zero to be deterministic.
+	randSeed := s.random.Int63n(1<<62) + 1
+	s.mu.Unlock()
+
+	node := &Node{}
+	if cfg.BlockState == nil {
+		node.Chain = NewChain(s.genesis)
+		cfg.BlockState = node.Chain
+		if cfg.Syncer == nil {
+			cfg.Syncer = node.Chain
+		}
+	}
+
+	if cfg.TransactionHandler == nil {
+		cfg.TransactionHandler = transactionHandler{}
+	}
+
+	service, err := network.NewSimulatedService(&network.Config{
+		LogLvl:             log.Warn,
+		BasePath:           s.t.TempDir(),
+		Roles:              cfg.Roles,
+		BlockState:         cfg.BlockState,
+		Syncer:             cfg.Syncer,
+		WarpSyncProvider:   cfg.WarpSyncProvider,
+		TransactionHandler: cfg.TransactionHandler,
+		PublicIP:           "127.0.0.1",
+		ListenAddress:      fmt.Sprintf("/ip4/127.0.0.1/tcp/%d", 30000+index),
+		RandSeed:           randSeed,
+		NoBootstrap:        true,
+		NoMDNS:             true,
+		SlotDuration:       time.Second,
+		Telemetry:          telemetry{},
+	}, network.SimulationConfig{
+		Mocknet:       s.mocknet,
+		MessageFilter: s.deliver,
+	})
+	require.NoError(s.t, err)
+
+	err = service.Start()
+	require.NoError(s.t, err)
+
+	// the node must be stopped before its base path is removed,
+	// and cleanups run in the reverse order of their registration.
+	s.t.Cleanup(func() {
+		err := service.Stop()
+		if err != nil {
+			s.t.Logf("stopping node: %s", err)
+		}
+	})
+
+	node.ID, err = peer.Decode(service.NetworkState().PeerID)
+	require.NoError(s.t, err)
+	node.Service = service
+
+	s.mu.Lock()
+	s.nodes = append(s.nodes, node)
+	s.mu.Unlock()
+
+	return node
+}
+
+// Connect links the two nodes, if they are not linked yet, and connects them.
+func (s *Simulator) Connect(a, b *Node) {
+	s.t.Helper()
+
+	if len(s.mocknet.LinksBetweenPeers(a.ID, b.ID)) == 0 {
+		_, err := s.mocknet.LinkPeers(a.ID, b.ID)
+		require.NoError(s.t, err)
+	}
+
+	_, err := s.mocknet.ConnectPeers(a.ID, b.ID)
+	require.NoError(s.t, err)
+
+	s.identify(a, b)
+	s.identify(b, a)
+}
+
+// identify waits for the node to identify the remote node, so that it knows the
+// protocols supported by the remote node before sending it messages.
+func (s *Simulator) identify(node, remote *Node) {
+	s.t.Helper()
+
+	h, ok := s.mocknet.Host(node.ID).(interface{ IDService() identify.IDService })
+	if !ok {
+		return
+	}
+
+	for _, conn := range s.mocknet.Net(node.ID).ConnsToPeer(remote.ID) {
+		select {
+		case <-h.IDService().IdentifyWait(conn):
+		case <-time.After(identifyTimeout):
+			s.t.Fatalf("timed out waiting for node %s to identify peer %s", node.ID, remote.ID)
+		}
+	}
+}
+
+// ConnectAll connects every node to all the other nodes.
+func (s *Simulator) ConnectAll() {
+	s.t.Helper()
+
+	nodes := s.Nodes()
+	for i := range nodes {
+		for j := i + 1; j < len(nodes); j++ {
+			s.Connect(nodes[i], nodes[j])
+		}
+	}
+}
+
+// Partition splits the nodes into the groups given: the nodes of different groups
+// are disconnected and cannot connect to each other until Heal is called.
+func (s *Simulator) Partition(groups ...[]*Node) {
+	s.t.Helper()
+
+	for i := range groups {
+		for j := i + 1; j < len(groups); j++ {
+			for _, a := range groups[i] {
+				for _, b := range groups[j] {
+					s.disconnect(a, b)
+				}
+			}
+		}
+	}
+}
+
+func (s *Simulator) disconnect(a, b *Node) {
+	s.t.Helper()
+
+	if len(s.mocknet.LinksBetweenPeers(a.ID, b.ID)) > 0 {
+		err := s.mocknet.UnlinkPeers(a.ID, b.ID)
+		require.NoError(s.t, err)
+	}
+
+	err := s.mocknet.DisconnectPeers(a.ID, b.ID)
+	require.NoError(s.t, err)
+}
+
+// Heal removes the partitions by connecting all the nodes again.
+func (s *Simulator) Heal() {
+	s.t.Helper()
+	s.ConnectAll()
+}
+
+// SetLatency sets the latency of all the links, existing and future ones.
+func (s *Simulator) SetLatency(latency time.Duration) {
+	options := mocknet.LinkOptions{Latency: latency}
+	s.mocknet.SetLinkDefaults(options)
+
+	for _, a := range s.Nodes() {
+		for _, b := range s.Nodes() {
+			for _, link := range s.mocknet.LinksBetweenPeers(a.ID, b.ID) {
+				link.SetOptions(options)
+			}
+		}
+	}
+}
+
+// SetLinkLatency sets the latency of the link between the two nodes.
+func (s *Simulator) SetLinkLatency(a, b *Node, latency time.Duration) {
+	for _, link := range s.mocknet.LinksBetweenPeers(a.ID, b.ID) {
+		link.SetOptions(mocknet.LinkOptions{Latency: latency})
+	}
+}
+
+// SetMessageLoss sets the probability, between 0 and 1, of each message
+// written by a node to be lost.
+func (s *Simulator) SetMessageLoss(rate float64) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.lossRate = rate
+}
+
+// DroppedMessages returns the number of messages lost since the start of the simulation.
+func (s *Simulator) DroppedMessages() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.dropped
+}
+
+// deliver is the message filter of the nodes, dropping messages at the loss rate.
+func (s *Simulator) deliver(peer.ID, protocol.ID) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.lossRate <= 0 || s.random.Float64() >= s.lossRate {
+		return true
+	}
+	s.dropped++
+	return false
+}
@@ -0,0 +1,74 @@
+// Copyright 2024 ChainSafe Systems (ON)
+// SPDX-License-Identifier: LGPL-3.0-only
+
+package netsim
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ChainSafe/gossamer/dot/types"
+	"github.com/ChainSafe/gossamer/lib/common"
+)
+
+func announceBlock(t *testing.T, node *Node, parent *types.Header) *types.Header {
+	t.Helper()
+
+	// the extrinsics root differs between nodes so that they build different forks.
+	extrinsicsRoot := common.BytesToHash([]byte(node.ID))
+	header := types.NewHeader(parent.Hash(), common.Hash{}, extrinsicsRoot, parent.Number+1, types.NewDigest())
+	err := node.Chain.AddBlock(header)
+	require.NoError(t, err)
+
+	node.Service.GossipMessage(AnnounceMessage(header, true))
+	return header
+}
+
+func TestSimulator(t *testing.T) {
+	t.Parallel()
+
+	const waitFor = 5 * time.Second
+	const tick = 10 * time.Millisecond
+
+	s := New(t, 1)
+	alice := s.AddNode(NodeConfig{})
+	bob := s.AddNode(NodeConfig{})
+	charlie := s.AddNode(NodeConfig{})
+
+	s.SetLatency(10 * time.Millisecond)
+	s.ConnectAll()
+
+	block1 := announceBlock(t, alice, s.Genesis())
+	require.Eventually(t, func() bool {
+		return bob.Chain.HasHeader(block1.Hash()) && charlie.Chain.HasHeader(block1.Hash())
+	}, waitFor, tick)
+
+	s.Partition([]*Node{alice, bob}, []*Node{charlie})
+
+	block2 := announceBlock(t, alice, block1)
+	require.Eventually(t, func() bool {
+		return bob.Chain.HasHeader(block2.Hash())
+	}, waitFor, tick)
+	assert.False(t, charlie.Chain.HasHeader(block2.Hash()))
+
+	s.SetMessageLoss(1)
+
+	dropped := s.DroppedMessages()
+	block3 := announceBlock(t, alice, block2)
+	// the announce written to bob is dropped instead of being delivered.
+	require.Eventually(t, func() bool {
+		return s.DroppedMessages() > dropped
+	}, waitFor, tick)
+	assert.False(t, bob.Chain.HasHeader(block3.Hash()))
+
+	s.SetMessageLoss(0)
+	s.Heal()
+
+	block4 := announceBlock(t, bob, block2)
+	require.Eventually(t, func() bool {
+		return alice.Chain.HasHeader(block4.Hash())
+	}, waitFor, tick)
+}
@@ -6,7 +6,8 @@
 import (
 	"errors"
 	"fmt"
-	"io"
+	"slices"
+	"sync"
 	"time"
 
 	"github.com/ChainSafe/gossamer/dot/network/messages"
@@ -58,11 +59,15 @@ type handshakeReader struct {
 
 type notificationsProtocol struct {
 	protocolID         protocol.ID
+	fallbackIDs        []protocol.ID
 	getHandshake       HandshakeGetter
 	handshakeDecoder   HandshakeDecoder
 	handshakeValidator HandshakeValidator
 	peersData          *peersData
 	maxSize            uint64
+
+	sendQueuesMu sync.Mutex
+	sendQueues   map[peer.ID]*sendQueue
 }
 
 func newNotificationsProtocol(protocolID protocol.ID, handshakeGetter HandshakeGetter,
@@ -74,9 +79,20 @@ func newNotificationsProtocol(protocolID protocol.ID, handshakeGetter HandshakeG
 		handshakeDecoder:   handshakeDecoder,
 		peersData:          newPeersData(),
 		maxSize:            maxSize,
+		sendQueues:         make(map[peer.ID]*sendQueue),
 	}
 }
 
+// protocolIDs returns the protocol id followed by the fallback protocol ids.
+func (n *notificationsProtocol) protocolIDs() []protocol.ID {
+	return append([]protocol.ID{n.protocolID}, n.fallbackIDs...)
+}
+
+// hasProtocolID returns true if the id given is the protocol id or one of the fallback protocol ids.
+func (n *notificationsProtocol) hasProtocolID(id protocol.ID) bool {
+	return slices.Contains(n.protocolIDs(), id)
+}
+
 type handshakeData struct {
 	received  bool
 	validated bool
@@ -197,7 +213,14 @@ func (s *Service) handleHandshake(info *notificationsProtocol, stream network.St
 	// we do not send any other data over this stream, we would need to open a new outbound stream.
 	hsData := info.peersData.getInboundHandshakeData(peer)
 	if hsData != nil {
-		return fmt.Errorf("%w: for peer id %s", errInboundHanshakeExists, peer)
+		if hsData.stream == nil || hsData.stream == stream {
+			return fmt.Errorf("%w: for peer id %s", errInboundHanshakeExists, peer)
+		}
+
+		// the peer opened a new substream, for example after failing to write to the previous one,
+		// so the previous one will never be written to again.
+		logger.Debugf("peer %s replaced its inbound stream using protocol %s", peer, info.protocolID)
+		_ = hsData.stream.Reset()
 	}
 
 	logger.Tracef("receiver: validating handshake using protocol %s", info.protocolID)
@@ -205,7 +228,13 @@ func (s *Service) handleHandshake(info *notificationsProtocol, stream network.St
 	hsData = newHandshakeData(true, false, stream)
 	info.peersData.setInboundHandshakeData(peer, hsData)
 
-	err := info.handshakeValidator(peer, hs)
+	err := s.checkForkID(peer, stream.Protocol())
+	if err != nil {
+		return fmt.Errorf("%w from peer %s using protocol %s: %s",
+			errCannotValidateHandshake, peer, info.protocolID, err)
+	}
+
+	err = info.handshakeValidator(peer, hs)
 	if err != nil {
 		return fmt.Errorf("%w from peer %s using protocol %s: %s",
 			errCannotValidateHandshake, peer, info.protocolID, err)
@@ -251,7 +280,7 @@ func (s *Service) sendData(peer peer.ID, hs Handshake, info *notificationsProtoc
 		return
 	}
 
-	support, err := s.host.supportsProtocol(peer, info.protocolID)
+	support, err := s.host.supportsProtocol(peer, info.protocolIDs()...)
 	if err != nil {
 		logger.Errorf("could not check if protocol %s is supported by peer %s: %s", info.protocolID, peer, err)
 		return
@@ -284,10 +313,9 @@ func (s *Service) sendData(peer peer.ID, hs Handshake, info *notificationsProtoc
 	if err := s.host.writeToStream(stream, msg); err != nil {
 		logger.Errorf("failed to send message to peer %s: %s", peer, err)
 
-		// the stream was closed or reset, close it on our end and delete it from our peer's data
-		if errors.Is(err, io.EOF) || errors.Is(err, network.ErrReset) {
-			closeOutboundStream(info, peer, stream)
-		}
+		// the stream was closed or reset by the peer, close it on our end and delete it from our
+		// peer's data so that the next message opens a new stream with a new handshake.
+		closeOutboundStream(info, peer, stream)
 		return
 	} else if s.host.messageCache != nil {
 		if _, err := s.host.messageCache.put(peer, msg); err != nil {
@@ -342,7 +370,7 @@ func (s *Service) sendHandshake(peer peer.ID, hs Handshake, info *notificationsP
 
 	logger.Tracef("sending outbound handshake to peer %s on protocol %s, message: %s",
 		peer, info.protocolID, hs)
-	stream, err := s.host.send(peer, info.protocolID, hs)
+	stream, err := s.host.send(peer, info.protocolID, hs, info.fallbackIDs...)
 	if err != nil {
 		logger.Tracef("failed to send handshake to peer %s: %s", peer, err)
 		// don't need to close the stream here, as it's nil!
@@ -381,7 +409,11 @@ func (s *Service) sendHandshake(peer peer.ID, hs Handshake, info *notificationsP
 		logger.Tracef("failed to close stream for reading: %s", err)
 	}
 
-	if err = info.handshakeValidator(peer, resp); err != nil {
+	err = s.checkForkID(peer, stream.Protocol())
+	if err == nil {
+		err = info.handshakeValidator(peer, resp)
+	}
+	if err != nil {
 		logger.Tracef("failed to validate handshake from peer %s using protocol %s: %s", peer, info.protocolID, err)
 		hsData.validated = false
 		hsData.stream = nil
@@ -399,7 +431,7 @@ func (s *Service) sendHandshake(peer peer.ID, hs Handshake, info *notificationsP
 	return hsData.stream, nil
 }
 
-// broadcastExcluding sends a message to each connected peer except the given peer,
+// broadcastExcluding queues a message for sending to each connected peer except the given peer,
 // and peers that have previously sent us the message or who we have already sent the message to.
 // used for notifications sub-protocols to gossip a message
 func (s *Service) broadcastExcluding(info *notificationsProtocol, excluding peer.ID, msg NotificationsMessage) {
@@ -419,7 +451,7 @@ func (s *Service) broadcastExcluding(info *notificationsProtocol, excluding peer
 
 		info.peersData.setMutex(peer)
 
-		go s.sendData(peer, hs, info, msg)
+		s.queueNotification(peer, hs, info, msg)
 	}
 }
 
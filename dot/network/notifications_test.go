@@ -223,6 +223,24 @@ func TestCreateNotificationsMessageHandler_BlockAnnounceHandshake(t *testing.T)
 	require.NotNil(t, data)
 	require.True(t, data.received)
 	require.True(t, data.validated)
+
+	// a second handshake on the same stream is rejected
+	err = handler(stream, testHandshake)
+	require.ErrorIs(t, err, errInboundHanshakeExists)
+
+	// a handshake on a new stream replaces the previous stream
+	newStream, err := s.host.p2pHost.NewStream(s.ctx, b.host.id(), s.host.protocolID+blockAnnounceID)
+	require.NoError(t, err)
+
+	err = handler(newStream, testHandshake)
+	require.NoError(t, err)
+	data = info.peersData.getInboundHandshakeData(testPeerID)
+	require.NotNil(t, data)
+	require.Equal(t, newStream, data.stream)
+
+	// cleaning up the previous stream keeps the handshake data of the new one
+	info.peersData.deleteInboundStreamHandshakeData(testPeerID, stream)
+	require.NotNil(t, info.peersData.getInboundHandshakeData(testPeerID))
 }
 
 func Test_HandshakeTimeout(t *testing.T) {
@@ -313,6 +331,64 @@ func Test_HandshakeTimeout(t *testing.T) {
 	require.Len(t, connAToB[0].GetStreams(), 0)
 }
 
+func Test_sendData_closesFailedOutboundStream(t *testing.T) {
+	t.Parallel()
+
+	configA := &Config{
+		BasePath:    t.TempDir(),
+		Port:        availablePort(t),
+		NoBootstrap: true,
+		NoMDNS:      true,
+	}
+
+	nodeA := createTestService(t, configA)
+	nodeA.noGossip = true
+	nodeA.host.messageCache = nil
+
+	configB := &Config{
+		BasePath:    t.TempDir(),
+		Port:        availablePort(t),
+		RandSeed:    2,
+		NoBootstrap: true,
+		NoMDNS:      true,
+	}
+
+	nodeB := createTestService(t, configB)
+	nodeB.noGossip = true
+
+	addrInfoB := addrInfo(nodeB.host)
+	err := nodeA.host.connect(addrInfoB)
+	if failedToDial(err) {
+		time.Sleep(TestBackoffTimeout)
+		err = nodeA.host.connect(addrInfoB)
+	}
+	require.NoError(t, err)
+
+	info := nodeA.notificationsProtocols[blockAnnounceMsgType]
+	stream, err := nodeA.host.p2pHost.NewStream(nodeA.ctx, nodeB.host.id(), info.protocolID)
+	require.NoError(t, err)
+
+	// writing to a stream closed for writing fails with neither io.EOF nor a reset error.
+	err = stream.CloseWrite()
+	require.NoError(t, err)
+
+	info.peersData.setMutex(nodeB.host.id())
+	info.peersData.setOutboundHandshakeData(nodeB.host.id(), &handshakeData{
+		received:  true,
+		validated: true,
+		stream:    stream,
+	})
+
+	announceMessage := &BlockAnnounceMessage{
+		Number: 1,
+		Digest: types.NewDigest(),
+	}
+	nodeA.sendData(nodeB.host.id(), &BlockAnnounceHandshake{}, info, announceMessage)
+
+	// the next message opens a new stream with a new handshake.
+	require.Nil(t, info.peersData.getOutboundHandshakeData(nodeB.host.id()))
+}
+
 func TestCreateNotificationsMessageHandler_HandleTransaction(t *testing.T) {
 	t.Parallel()
 
@@ -6,6 +6,7 @@
 import (
 	"sync"
 
+	libp2pnetwork "github.com/libp2p/go-libp2p/core/network"
 	"github.com/libp2p/go-libp2p/core/peer"
 )
 
@@ -62,6 +63,18 @@ func (p *peersData) deleteInboundHandshakeData(peerID peer.ID) {
 	delete(p.inbound, peerID)
 }
 
+// deleteInboundStreamHandshakeData deletes the inbound handshake data of the peer
+// only if it belongs to the given stream, since the peer may have replaced it already.
+func (p *peersData) deleteInboundStreamHandshakeData(peerID peer.ID, stream libp2pnetwork.Stream) {
+	p.inboundMu.Lock()
+	defer p.inboundMu.Unlock()
+	data, has := p.inbound[peerID]
+	if !has || data.stream != stream {
+		return
+	}
+	delete(p.inbound, peerID)
+}
+
 func (p *peersData) countInboundStreams() (count int64) {
 	p.inboundMu.RLock()
 	defer p.inboundMu.RUnlock()
@@ -26,6 +26,7 @@ type RequestResponseProtocol struct {
 	requestTimeout  time.Duration
 	maxResponseSize uint64
 	protocolID      protocol.ID
+	fallbackIDs     []protocol.ID
 	responseBufMu   sync.Mutex
 	responseBuf     []byte
 }
@@ -37,7 +38,8 @@ func (rrp *RequestResponseProtocol) Do(to peer.ID, req, res messages.P2PMessage)
 	ctx, cancel := context.WithTimeout(rrp.ctx, rrp.requestTimeout)
 	defer cancel()
 
-	stream, err := rrp.host.p2pHost.NewStream(ctx, to, rrp.protocolID)
+	protocolIDs := append([]protocol.ID{rrp.protocolID}, rrp.fallbackIDs...)
+	stream, err := rrp.host.p2pHost.NewStream(ctx, to, protocolIDs...)
 	if err != nil {
 		return err
 	}
@@ -53,7 +55,12 @@ func (rrp *RequestResponseProtocol) Do(to peer.ID, req, res messages.P2PMessage)
 		return err
 	}
 
-	return rrp.receiveResponse(stream, res)
+	if err = rrp.receiveResponse(stream, res); err != nil {
+		return err
+	}
+
+	rrp.host.goodPeers.useful(to)
+	return nil
 }
 
 func (rrp *RequestResponseProtocol) receiveResponse(stream libp2pnetwork.Stream, msg messages.P2PMessage) error {
@@ -70,6 +77,8 @@ func (rrp *RequestResponseProtocol) receiveResponse(stream libp2pnetwork.Stream,
 		return fmt.Errorf("read stream error: %w", err)
 	}
 
+	rrp.host.bandwidth.logReceived(stream.Protocol(), stream.Conn().RemotePeer(), uint64(n))
+
 	if n == 0 {
 		return ErrReceivedEmptyMessage
 	}
@@ -0,0 +1,68 @@
+// Copyright 2024 ChainSafe Systems (ON)
+// SPDX-License-Identifier: LGPL-3.0-only
+
+package network
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/ChainSafe/gossamer/dot/network/messages"
+	"github.com/ChainSafe/gossamer/dot/types"
+	"github.com/ChainSafe/gossamer/lib/common"
+	libp2pnetwork "github.com/libp2p/go-libp2p/core/network"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/libp2p/go-libp2p/core/protocol"
+	"github.com/libp2p/go-libp2p/core/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+// remotePeerConn is a connection only knowing its remote peer.
+type remotePeerConn struct {
+	libp2pnetwork.Conn
+	remotePeer peer.ID
+}
+
+func (c remotePeerConn) RemotePeer() peer.ID { return c.remotePeer }
+
+func Test_RequestResponseProtocol_receiveResponse_bandwidth(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+
+	const protocolID protocol.ID = "/dot/sync/2"
+	remotePeer := test.RandPeerIDFatal(t)
+
+	response := &messages.BlockResponseMessage{
+		BlockData: []*types.BlockData{{Hash: common.Hash{1}}},
+	}
+	encodedResponse, err := response.Encode()
+	require.NoError(t, err)
+	reader := bytes.NewReader(append(Uint64ToLEB128(uint64(len(encodedResponse))), encodedResponse...))
+
+	stream := NewMockStream(ctrl)
+	stream.EXPECT().Read(gomock.Any()).DoAndReturn(reader.Read).AnyTimes()
+	stream.EXPECT().Protocol().Return(protocolID)
+	stream.EXPECT().Conn().Return(remotePeerConn{remotePeer: remotePeer})
+
+	bandwidth := newBandwidthTracker()
+	bandwidth.setPeerRole(remotePeer, common.FullNodeRole)
+	rrp := &RequestResponseProtocol{
+		host:            &host{bandwidth: bandwidth},
+		maxResponseSize: 1024,
+		responseBuf:     make([]byte, 1024),
+	}
+
+	received := new(messages.BlockResponseMessage)
+	err = rrp.receiveResponse(stream, received)
+	require.NoError(t, err)
+
+	expected := []common.ProtocolBandwidth{{
+		Protocol:   "sync",
+		Role:       "full",
+		BytesIn:    uint64(len(encodedResponse)),
+		MessagesIn: 1,
+	}}
+	assert.Equal(t, expected, bandwidth.totals())
+}
@@ -0,0 +1,230 @@
+// Copyright 2024 ChainSafe Systems (ON)
+// SPDX-License-Identifier: LGPL-3.0-only
+
+package network
+
+import (
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/ChainSafe/gossamer/dot/peerset"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+const (
+	// sendQueueSize is the maximum number of notifications queued for a peer on a protocol.
+	sendQueueSize = 256
+	// sendQueueNotificationTTL is the duration after which a queued notification
+	// is stale and dropped instead of being sent.
+	sendQueueNotificationTTL = 30 * time.Second
+	// maxSendQueueOverflows is the number of consecutive queue overflows after which
+	// the peer is considered too slow and is disconnected.
+	maxSendQueueOverflows = 64
+)
+
+const (
+	droppedReasonOverflow = "overflow"
+	droppedReasonStale    = "stale"
+)
+
+var (
+	sendQueueDepthGauge = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: "gossamer_network_notifications",
+		Name:      "send_queue_depth",
+		Help:      "number of notifications queued for sending to peers",
+	}, []string{"protocol"})
+	sendQueueDroppedCounter = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "gossamer_network_notifications",
+		Name:      "send_queue_dropped_total",
+		Help:      "total number of queued notifications dropped before being sent",
+	}, []string{"protocol", "reason"})
+)
+
+type queuedNotification struct {
+	handshake Handshake
+	msg       NotificationsMessage
+	queuedAt  time.Time
+}
+
+// sendQueue is the bounded queue of the notifications to send to a peer on a protocol.
+type sendQueue struct {
+	notifications chan *queuedNotification
+	done          chan struct{}
+	closeOnce     sync.Once
+	// overflows is the number of consecutive pushes which found the queue full.
+	overflows atomic.Uint32
+}
+
+func newSendQueue(size int) *sendQueue {
+	return &sendQueue{
+		notifications: make(chan *queuedNotification, size),
+		done:          make(chan struct{}),
+	}
+}
+
+// push queues the notification. If the queue is full, the oldest notification is dropped
+// to make room for it. It returns the number of notifications dropped and the number of
+// consecutive overflows of the queue.
+func (q *sendQueue) push(notification *queuedNotification) (dropped int, overflows uint32) {
+	select {
+	case q.notifications <- notification:
+		q.overflows.Store(0)
+		return 0, 0
+	default:
+	}
+
+	overflows = q.overflows.Add(1)
+
+	select {
+	case <-q.notifications:
+		dropped++
+	default:
+	}
+
+	select {
+	case q.notifications <- notification:
+	default:
+		dropped++
+	}
+
+	return dropped, overflows
+}
+
+func (q *sendQueue) close() {
+	q.closeOnce.Do(func() {
+		close(q.done)
+	})
+}
+
+// pushNotification queues the notification in the send queue of the peer, creating the queue
+// if needed, and updates the queue depth gauge. The notifications are only pushed while the
+// queue is in the send queues, so none is left behind once the queue is deleted and drained.
+// It returns the queue, true if it was created by the call, the number of notifications dropped
+// and the number of consecutive overflows of the queue.
+func (n *notificationsProtocol) pushNotification(peerID peer.ID, notification *queuedNotification) (
+	queue *sendQueue, created bool, dropped int, overflows uint32) {
+	n.sendQueuesMu.Lock()
+	defer n.sendQueuesMu.Unlock()
+
+	if n.sendQueues == nil {
+		n.sendQueues = make(map[peer.ID]*sendQueue)
+	}
+
+	queue, has := n.sendQueues[peerID]
+	if !has {
+		queue = newSendQueue(sendQueueSize)
+		n.sendQueues[peerID] = queue
+		created = true
+	}
+
+	dropped, overflows = queue.push(notification)
+	sendQueueDepthGauge.WithLabelValues(string(n.protocolID)).Add(float64(1 - dropped))
+	return queue, created, dropped, overflows
+}
+
+// deleteSendQueue closes and removes the send queue of the peer.
+func (n *notificationsProtocol) deleteSendQueue(peerID peer.ID) {
+	n.sendQueuesMu.Lock()
+	defer n.sendQueuesMu.Unlock()
+
+	queue, has := n.sendQueues[peerID]
+	if !has {
+		return
+	}
+
+	queue.close()
+	delete(n.sendQueues, peerID)
+}
+
+// removeSendQueue closes the send queue given and removes it if it is still the send queue
+// of the peer, so that nothing is pushed to it anymore.
+func (n *notificationsProtocol) removeSendQueue(peerID peer.ID, queue *sendQueue) {
+	n.sendQueuesMu.Lock()
+	defer n.sendQueuesMu.Unlock()
+
+	queue.close()
+	if n.sendQueues[peerID] == queue {
+		delete(n.sendQueues, peerID)
+	}
+}
+
+// queueNotification queues the notification for sending to the peer, so a slow peer does not
+// delay the notifications sent to the other peers. Peers overflowing their queue for too long
+// are disconnected.
+func (s *Service) queueNotification(peerID peer.ID, hs Handshake, info *notificationsProtocol,
+	msg NotificationsMessage) {
+	queue, created, dropped, overflows := info.pushNotification(peerID, &queuedNotification{
+		handshake: hs,
+		msg:       msg,
+		queuedAt:  time.Now(),
+	})
+	if created {
+		go s.processSendQueue(peerID, info, queue)
+	}
+
+	if dropped > 0 {
+		sendQueueDroppedCounter.WithLabelValues(string(info.protocolID), droppedReasonOverflow).
+			Add(float64(dropped))
+		logger.Debugf("dropped %d notifications for peer %s on protocol %s: send queue full",
+			dropped, peerID, info.protocolID)
+	}
+
+	if overflows < maxSendQueueOverflows {
+		return
+	}
+
+	logger.Infof("disconnecting peer %s: too slow to receive notifications on protocol %s",
+		peerID, info.protocolID)
+	info.deleteSendQueue(peerID)
+	s.host.cm.peerSetHandler.ReportPeer(peerset.ReputationChange{
+		Value:  peerset.SlowPeerValue,
+		Reason: peerset.SlowPeerReason,
+	}, peerID)
+
+	err := s.host.closePeer(peerID)
+	if err != nil {
+		logger.Warnf("failed to close connection with slow peer %s: %s", peerID, err)
+	}
+}
+
+// processSendQueue sends the queued notifications to the peer in order, dropping the stale ones,
+// until the queue is closed or the service stops.
+func (s *Service) processSendQueue(peerID peer.ID, info *notificationsProtocol, queue *sendQueue) {
+	protocolLabel := string(info.protocolID)
+	depthGauge := sendQueueDepthGauge.WithLabelValues(protocolLabel)
+
+	defer func() {
+		// no notification is pushed once the queue is removed,
+		// so the notifications left in the queue can be discarded.
+		info.removeSendQueue(peerID, queue)
+		for {
+			select {
+			case <-queue.notifications:
+				depthGauge.Dec()
+			default:
+				return
+			}
+		}
+	}()
+
+	for {
+		select {
+		case <-s.ctx.Done():
+			return
+		case <-queue.done:
+			return
+		case notification := <-queue.notifications:
+			depthGauge.Dec()
+
+			if time.Since(notification.queuedAt) > sendQueueNotificationTTL {
+				sendQueueDroppedCounter.WithLabelValues(protocolLabel, droppedReasonStale).Inc()
+				continue
+			}
+
+			s.sendData(peerID, notification.handshake, info, notification.msg)
+		}
+	}
+}
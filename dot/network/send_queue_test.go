@@ -0,0 +1,88 @@
+// Copyright 2024 ChainSafe Systems (ON)
+// SPDX-License-Identifier: LGPL-3.0-only
+
+package network
+
+import (
+	"testing"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_sendQueue_push(t *testing.T) {
+	t.Parallel()
+
+	queue := newSendQueue(2)
+	first := &queuedNotification{msg: &BlockAnnounceMessage{Number: 1}}
+	second := &queuedNotification{msg: &BlockAnnounceMessage{Number: 2}}
+	third := &queuedNotification{msg: &BlockAnnounceMessage{Number: 3}}
+	fourth := &queuedNotification{msg: &BlockAnnounceMessage{Number: 4}}
+
+	dropped, overflows := queue.push(first)
+	assert.Zero(t, dropped)
+	assert.Zero(t, overflows)
+
+	dropped, overflows = queue.push(second)
+	assert.Zero(t, dropped)
+	assert.Zero(t, overflows)
+
+	// the queue is full, the oldest notification is dropped.
+	dropped, overflows = queue.push(third)
+	assert.Equal(t, 1, dropped)
+	assert.Equal(t, uint32(1), overflows)
+
+	dropped, overflows = queue.push(fourth)
+	assert.Equal(t, 1, dropped)
+	assert.Equal(t, uint32(2), overflows)
+
+	assert.Equal(t, third, <-queue.notifications)
+
+	// a push which does not overflow resets the consecutive overflows.
+	dropped, overflows = queue.push(first)
+	assert.Zero(t, dropped)
+	assert.Zero(t, overflows)
+
+	assert.Equal(t, fourth, <-queue.notifications)
+	assert.Equal(t, first, <-queue.notifications)
+}
+
+func Test_notificationsProtocol_pushNotification(t *testing.T) {
+	t.Parallel()
+
+	const peerID = peer.ID("peer")
+	info := &notificationsProtocol{protocolID: "/test/push-notification"}
+	notification := &queuedNotification{msg: &BlockAnnounceMessage{Number: 1}}
+	depthGauge := sendQueueDepthGauge.WithLabelValues(string(info.protocolID))
+
+	queue, created, dropped, overflows := info.pushNotification(peerID, notification)
+	require.True(t, created)
+	assert.Zero(t, dropped)
+	assert.Zero(t, overflows)
+	assert.Equal(t, float64(1), testutil.ToFloat64(depthGauge))
+
+	sameQueue, created, _, _ := info.pushNotification(peerID, notification)
+	assert.False(t, created)
+	assert.Same(t, queue, sameQueue)
+	assert.Equal(t, float64(2), testutil.ToFloat64(depthGauge))
+
+	info.deleteSendQueue(peerID)
+	select {
+	case <-queue.done:
+	default:
+		t.Fatal("send queue is not closed")
+	}
+
+	// the notification is pushed to a new queue, not to the deleted one.
+	newQueue, created, _, _ := info.pushNotification(peerID, notification)
+	assert.True(t, created)
+	assert.NotSame(t, queue, newQueue)
+	assert.Len(t, queue.notifications, 2)
+
+	// removing an older queue of the peer does not remove its current queue.
+	info.removeSendQueue(peerID, queue)
+	_, created, _, _ = info.pushNotification(peerID, notification)
+	assert.False(t, created)
+}
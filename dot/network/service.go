@@ -132,6 +132,10 @@ type Service struct {
 	transactionHandler TransactionHandler
 	warpSyncProvider   WarpSyncProvider
 
+	authorityKeystore    AuthorityKeystore
+	authoritySetProvider AuthoritySetProvider
+	authorityDiscovery   *authorityDiscovery
+
 	// Configuration options
 	noBootstrap bool
 	noDiscover  bool
@@ -245,6 +249,14 @@ func (s *Service) SetTransactionHandler(handler TransactionHandler) {
 	s.transactionHandler = handler
 }
 
+// SetAuthorityDiscovery enables the authority discovery, publishing our addresses in the DHT
+// under the keys of the keystore given and resolving the addresses of the authority set given.
+// It must be called before the service is started.
+func (s *Service) SetAuthorityDiscovery(ks AuthorityKeystore, authorities AuthoritySetProvider) {
+	s.authorityKeystore = ks
+	s.authoritySetProvider = authorities
+}
+
 // Start starts the network service
 func (s *Service) Start() error {
 	if s.syncer == nil {
@@ -259,15 +271,14 @@ func (s *Service) Start() error {
 		s.ctx, s.cancel = context.WithCancel(context.Background())
 	}
 
-	genesisHashProtocolId := protocol.ID(s.cfg.BlockState.GenesisHash().String())
-
-	s.host.registerStreamHandler(s.host.protocolID+SyncID, s.handleSyncStream)
-	s.host.registerStreamHandler(s.host.protocolID+lightID, s.handleLightStream)
-	s.host.registerStreamHandler(genesisHashProtocolId+WarpSyncID, s.handleWarpSyncStream)
+	s.registerStreamHandlers(SyncID, s.handleSyncStream)
+	s.registerStreamHandlers(lightID, s.handleLightStream)
+	s.registerStreamHandlers(WarpSyncID, s.handleWarpSyncStream)
 
 	// register block announce protocol
+	blockAnnounceProtocolID, blockAnnounceFallbackIDs := s.protocolIDs(blockAnnounceID)
 	err := s.RegisterNotificationsProtocol(
-		s.host.protocolID+blockAnnounceID,
+		blockAnnounceProtocolID,
 		blockAnnounceMsgType,
 		s.getBlockAnnounceHandshake,
 		decodeBlockAnnounceHandshake,
@@ -276,6 +287,7 @@ func (s *Service) Start() error {
 		s.handleBlockAnnounceMessage,
 		nil,
 		maxBlockAnnounceNotificationSize,
+		blockAnnounceFallbackIDs...,
 	)
 	if err != nil {
 		logger.Warnf("failed to register notifications protocol with block announce id %s: %s",
@@ -286,8 +298,9 @@ func (s *Service) Start() error {
 	txnBatchHandler := s.createBatchMessageHandler(txnBatch)
 
 	// register transactions protocol
+	transactionsProtocolID, transactionsFallbackIDs := s.protocolIDs(transactionsID)
 	err = s.RegisterNotificationsProtocol(
-		s.host.protocolID+transactionsID,
+		transactionsProtocolID,
 		transactionMsgType,
 		s.getTransactionHandshake,
 		decodeTransactionHandshake,
@@ -296,6 +309,7 @@ func (s *Service) Start() error {
 		s.handleTransactionMessage,
 		txnBatchHandler,
 		maxTransactionsNotificationSize,
+		transactionsFallbackIDs...,
 	)
 	if err != nil {
 		logger.Warnf("failed to register notifications protocol with transaction id %s: %s", transactionsID, err)
@@ -319,7 +333,9 @@ func (s *Service) Start() error {
 			prtl.peersData.deleteMutex(peerID)
 			prtl.peersData.deleteInboundHandshakeData(peerID)
 			prtl.peersData.deleteOutboundHandshakeData(peerID)
+			prtl.deleteSendQueue(peerID)
 		}
+		s.host.bandwidth.deletePeerRole(peerID)
 	}
 
 	// log listening addresses to console
@@ -338,10 +354,20 @@ func (s *Service) Start() error {
 	// TODO: this is basically a hack that is used only in unit tests to disable kademilia dht.
 	// Should be replaced with a mock instead.
 	if !s.noDiscover {
+		if s.authorityKeystore != nil && s.authoritySetProvider != nil {
+			s.authorityDiscovery = newAuthorityDiscovery(s.ctx, s.host, s.authorityKeystore,
+				s.authoritySetProvider, s.host.cm.peerSetHandler)
+		}
+
 		go func() {
-			err = s.host.discovery.start()
+			err := s.host.discovery.start()
 			if err != nil {
 				logger.Errorf("failed to begin DHT discovery: %s", err)
+				return
+			}
+
+			if s.authorityDiscovery != nil {
+				s.authorityDiscovery.start(s.host.discovery.dht, s.host.discovery.authorityRecords)
 			}
 		}()
 	}
@@ -356,6 +382,7 @@ func (s *Service) Start() error {
 	}
 
 	go s.logPeerCount()
+	go s.persistGoodPeers()
 	go s.publishNetworkTelemetry(s.closeCh)
 	go s.sentBlockIntervalTelemetry()
 	s.streamManager.start()
@@ -425,6 +452,25 @@ func (s *Service) logPeerCount() {
 	}
 }
 
+// persistGoodPeers persists the good peers cache periodically, so that it is not lost
+// if the node is not stopped gracefully.
+func (s *Service) persistGoodPeers() {
+	ticker := time.NewTicker(goodPeersPersistInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			err := s.host.goodPeers.persistIfChanged()
+			if err != nil {
+				logger.Warnf("failed to persist good peers: %s", err)
+			}
+		case <-s.ctx.Done():
+			return
+		}
+	}
+}
+
 func (s *Service) publishNetworkTelemetry(done <-chan struct{}) {
 	ticker := time.NewTicker(s.telemetryInterval)
 	defer ticker.Stop()
@@ -436,7 +482,9 @@ func (s *Service) publishNetworkTelemetry(done <-chan struct{}) {
 
 		case <-ticker.C:
 			o := s.host.bwc.GetBandwidthTotals()
-			s.telemetry.SendMessage(telemetry.NewBandwidth(o.RateIn, o.RateOut, s.host.peerCount()))
+			bandwidth := telemetry.NewBandwidth(o.RateIn, o.RateOut, s.host.peerCount())
+			bandwidth.ProtocolBandwidth = s.host.bandwidth.totals()
+			s.telemetry.SendMessage(bandwidth)
 		}
 	}
 }
@@ -508,8 +556,64 @@ func (s *Service) Stop() error {
 	return nil
 }
 
+// protocolPrefix returns the prefix of the protocol ids, made of the genesis hash followed
+// by the fork id if it is set.
+func (s *Service) protocolPrefix() string {
+	prefix := "/" + strings.TrimPrefix(s.cfg.BlockState.GenesisHash().String(), "0x")
+	if s.cfg.ForkID != "" {
+		prefix += "/" + s.cfg.ForkID
+	}
+	return prefix
+}
+
+// checkForkID returns an error if a fork id is set and the protocol id negotiated with a peer
+// does not contain it, which is the case of the legacy protocol ids and of the protocol ids of
+// the other forks of the chain. The peers on other forks share our genesis hash, so the fork id
+// is the only way to tell them apart during the handshake.
+func (s *Service) checkForkID(peerID peer.ID, pid protocol.ID) error {
+	if s.cfg.ForkID == "" || strings.HasPrefix(string(pid), s.protocolPrefix()+"/") {
+		return nil
+	}
+
+	s.host.cm.peerSetHandler.ReportPeer(peerset.ReputationChange{
+		Value:  peerset.ForkIDMismatch,
+		Reason: peerset.ForkIDMismatchReason,
+	}, peerID)
+	return fmt.Errorf("%w: peer %s uses protocol %s", errForkIDMismatch, peerID, pid)
+}
+
+// protocolIDs returns the id of the sub-protocol given, prefixed with the genesis hash and the fork id
+// if it is set, followed by its legacy id prefixed with the protocol id, as fallback.
+func (s *Service) protocolIDs(subprotocol string) (id protocol.ID, fallbackIDs []protocol.ID) {
+	id = protocol.ID(s.protocolPrefix() + subprotocol)
+	fallbackIDs = []protocol.ID{s.host.protocolID + protocol.ID(subprotocol)}
+	return id, fallbackIDs
+}
+
+// requestResponseProtocolIDs returns the ids of the request-response sub-protocol given, without
+// fallback ids if a fork id is set. The requests have no handshake on which to check the fork id
+// of the peer, so the legacy id would let the peers on the other forks of the chain send us requests
+// and answer ours.
+func (s *Service) requestResponseProtocolIDs(subprotocol string) (id protocol.ID, fallbackIDs []protocol.ID) {
+	id, fallbackIDs = s.protocolIDs(subprotocol)
+	if s.cfg.ForkID != "" {
+		return id, nil
+	}
+	return id, fallbackIDs
+}
+
+// registerStreamHandlers registers the handler for the request-response sub-protocol given under
+// its ids.
+func (s *Service) registerStreamHandlers(subprotocol string, handler func(libp2pnetwork.Stream)) {
+	id, fallbackIDs := s.requestResponseProtocolIDs(subprotocol)
+	for _, protocolID := range append([]protocol.ID{id}, fallbackIDs...) {
+		s.host.registerStreamHandler(protocolID, handler)
+	}
+}
+
 // RegisterNotificationsProtocol registers a protocol with the network service with the given handler
 // messageID is a user-defined message ID for the message passed over this protocol.
+// The fallback protocol ids are negotiated with the peers that do not support the protocol id.
 func (s *Service) RegisterNotificationsProtocol(
 	protocolID protocol.ID,
 	messageID MessageType,
@@ -520,6 +624,7 @@ func (s *Service) RegisterNotificationsProtocol(
 	messageHandler NotificationsMessageHandler,
 	batchHandler NotificationsMessageBatchHandler,
 	maxSize uint64,
+	fallbackProtocolIDs ...protocol.ID,
 ) error {
 	s.notificationsMu.Lock()
 	defer s.notificationsMu.Unlock()
@@ -529,16 +634,19 @@ func (s *Service) RegisterNotificationsProtocol(
 	}
 
 	np := newNotificationsProtocol(protocolID, handshakeGetter, handshakeDecoder, handshakeValidator, maxSize)
+	np.fallbackIDs = fallbackProtocolIDs
 	s.notificationsProtocols[messageID] = np
 	decoder := createDecoder(np, handshakeDecoder, messageDecoder)
 	handlerWithValidate := s.createNotificationsMessageHandler(np, messageHandler, batchHandler)
 
-	s.host.registerStreamHandler(protocolID, func(stream libp2pnetwork.Stream) {
-		logger.Tracef("received stream using sub-protocol %s", protocolID)
-		s.readStream(stream, decoder, handlerWithValidate, maxSize)
-	})
+	for _, id := range np.protocolIDs() {
+		s.host.registerStreamHandler(id, func(stream libp2pnetwork.Stream) {
+			logger.Tracef("received stream using sub-protocol %s", stream.Protocol())
+			s.readStream(stream, decoder, handlerWithValidate, maxSize)
+		})
+	}
 
-	logger.Infof("registered notifications sub-protocol %s", protocolID)
+	logger.Infof("registered notifications sub-protocol %s with fallbacks %v", protocolID, fallbackProtocolIDs)
 	return nil
 }
 
@@ -612,7 +720,7 @@ func (s *Service) SendMessage(to peer.ID, msg NotificationsMessage) error {
 			return err
 		}
 
-		s.sendData(to, hs, prtl, msg)
+		s.queueNotification(to, hs, prtl, msg)
 		return nil
 	}
 
@@ -622,13 +730,14 @@ func (s *Service) SendMessage(to peer.ID, msg NotificationsMessage) error {
 func (s *Service) GetRequestResponseProtocol(subprotocol string, requestTimeout time.Duration,
 	maxResponseSize uint64) *RequestResponseProtocol {
 
-	protocolID := s.host.protocolID + protocol.ID(subprotocol)
+	protocolID, fallbackIDs := s.requestResponseProtocolIDs(subprotocol)
 	return &RequestResponseProtocol{
 		ctx:             s.ctx,
 		host:            s.host,
 		requestTimeout:  requestTimeout,
 		maxResponseSize: maxResponseSize,
 		protocolID:      protocolID,
+		fallbackIDs:     fallbackIDs,
 		responseBuf:     make([]byte, maxResponseSize),
 		responseBufMu:   sync.Mutex{},
 	}
@@ -648,6 +757,7 @@ func (s *Service) NetworkState() common.NetworkState {
 	return common.NetworkState{
 		PeerID:     s.host.id().String(),
 		Multiaddrs: s.host.multiaddrs(),
+		Bandwidth:  s.host.bandwidth.totals(),
 	}
 }
 
@@ -696,6 +806,58 @@ func (s *Service) RemoveReservedPeers(addrs ...string) error {
 	return s.host.removeReservedPeers(addrs...)
 }
 
+// ReservedPeers returns the ids of the reserved peers
+func (s *Service) ReservedPeers() []string {
+	reserved := <-s.host.cm.peerSetHandler.ReservedPeers()
+	ids := make([]string, len(reserved))
+	for i, peerID := range reserved {
+		ids[i] = peerID.String()
+	}
+	return ids
+}
+
+// SetReservedOnly switches the reserved-only mode, in which the node only
+// connects to and accepts connections from its reserved peers.
+func (s *Service) SetReservedOnly(reservedOnly bool) {
+	const setID = 0
+	s.host.cm.peerSetHandler.SetReservedOnly(setID, reservedOnly)
+}
+
+// BanPeer bans the peer for the duration given, or for the configured ban duration if it is zero.
+func (s *Service) BanPeer(peerID string, duration time.Duration) error {
+	id, err := peer.Decode(peerID)
+	if err != nil {
+		return fmt.Errorf("decoding peer id: %w", err)
+	}
+
+	s.host.cm.peerSetHandler.BanPeer(duration, id)
+	return nil
+}
+
+// UnbanPeer lifts the ban of the peer.
+func (s *Service) UnbanPeer(peerID string) error {
+	id, err := peer.Decode(peerID)
+	if err != nil {
+		return fmt.Errorf("decoding peer id: %w", err)
+	}
+
+	s.host.cm.peerSetHandler.UnbanPeer(id)
+	return nil
+}
+
+// BannedPeers returns the peers currently banned.
+func (s *Service) BannedPeers() []common.BannedPeerInfo {
+	banned := <-s.host.cm.peerSetHandler.BannedPeers()
+	infos := make([]common.BannedPeerInfo, len(banned))
+	for i, bannedPeer := range banned {
+		infos[i] = common.BannedPeerInfo{
+			PeerID: bannedPeer.PeerID.String(),
+			Until:  bannedPeer.Until,
+		}
+	}
+	return infos
+}
+
 // NodeRoles Returns the roles the node is running as.
 func (s *Service) NodeRoles() common.NetworkRole {
 	return s.cfg.Roles
@@ -749,7 +911,7 @@ func (s *Service) processMessage(msg peerset.Message) {
 			var err error
 			ctx, cancel := context.WithTimeout(s.host.discovery.ctx, findPeerQueryTimeout)
 			defer cancel()
-			addrInfo, err = s.host.discovery.dht.FindPeer(ctx, peerID)
+			addrInfo, err = s.host.discovery.findPeer(ctx, peerID)
 			if err != nil {
 				logger.Warnf("failed to find peer id %s: %s", peerID, err)
 				return
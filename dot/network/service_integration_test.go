@@ -70,7 +70,8 @@ func TestBroadcastMessages(t *testing.T) {
 	nodeB := createTestService(t, configB)
 	nodeB.noGossip = true
 	handler := newTestStreamHandler(testBlockAnnounceHandshakeDecoder)
-	nodeB.host.registerStreamHandler(nodeB.host.protocolID+blockAnnounceID, handler.handleStream)
+	blockAnnounceProtocolID, _ := nodeB.protocolIDs(blockAnnounceID)
+	nodeB.host.registerStreamHandler(blockAnnounceProtocolID, handler.handleStream)
 
 	addrInfoB := addrInfo(nodeB.host)
 	err := nodeA.host.connect(addrInfoB)
@@ -208,7 +209,7 @@ func TestService_Health(t *testing.T) {
 	require.Equal(t, false, h.IsSyncing)
 }
 
-func TestInMemoryPeerStore(t *testing.T) {
+func TestPersistentPeerStore(t *testing.T) {
 	t.Parallel()
 
 	nodes := createServiceHelper(t, 2)
@@ -229,9 +230,9 @@ func TestInMemoryPeerStore(t *testing.T) {
 	err = nodeA.Stop()
 	require.NoError(t, err)
 
-	// Should be empty since peerstore is kept in memory
+	// Should not be empty since peerstore is persisted in the datastore
 	nodeAA := createTestService(t, nodeA.cfg)
-	require.Empty(t, nodeAA.host.p2pHost.Peerstore().PeerInfo(nodeB.host.id()).Addrs)
+	require.NotEmpty(t, nodeAA.host.p2pHost.Peerstore().PeerInfo(nodeB.host.id()).Addrs)
 }
 
 func TestHandleConn(t *testing.T) {
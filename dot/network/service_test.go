@@ -0,0 +1,181 @@
+// Copyright 2024 ChainSafe Systems (ON)
+// SPDX-License-Identifier: LGPL-3.0-only
+
+package network
+
+import (
+	"testing"
+
+	"github.com/ChainSafe/gossamer/dot/peerset"
+	"github.com/ChainSafe/gossamer/lib/common"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/libp2p/go-libp2p/core/protocol"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func Test_Service_protocolIDs(t *testing.T) {
+	t.Parallel()
+
+	genesisHash := common.Hash{0xaa, 0xbb}
+
+	testCases := map[string]struct {
+		forkID      string
+		id          protocol.ID
+		fallbackIDs []protocol.ID
+	}{
+		"without_fork_id": {
+			id:          protocol.ID("/" + genesisHash.String()[2:] + "/block-announces/1"),
+			fallbackIDs: []protocol.ID{"/dot/block-announces/1"},
+		},
+		"with_fork_id": {
+			forkID:      "fork",
+			id:          protocol.ID("/" + genesisHash.String()[2:] + "/fork/block-announces/1"),
+			fallbackIDs: []protocol.ID{"/dot/block-announces/1"},
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+
+			blockState := NewMockBlockState(ctrl)
+			blockState.EXPECT().GenesisHash().Return(genesisHash)
+
+			s := &Service{
+				cfg: &Config{
+					BlockState: blockState,
+					ForkID:     testCase.forkID,
+				},
+				host: &host{protocolID: "/dot"},
+			}
+
+			id, fallbackIDs := s.protocolIDs(blockAnnounceID)
+			assert.Equal(t, testCase.id, id)
+			assert.Equal(t, testCase.fallbackIDs, fallbackIDs)
+		})
+	}
+}
+
+func Test_Service_requestResponseProtocolIDs(t *testing.T) {
+	t.Parallel()
+
+	genesisHash := common.Hash{0xaa, 0xbb}
+
+	testCases := map[string]struct {
+		forkID      string
+		id          protocol.ID
+		fallbackIDs []protocol.ID
+	}{
+		"without_fork_id": {
+			id:          protocol.ID("/" + genesisHash.String()[2:] + "/sync/2"),
+			fallbackIDs: []protocol.ID{"/dot/sync/2"},
+		},
+		"with_fork_id": {
+			forkID: "fork",
+			id:     protocol.ID("/" + genesisHash.String()[2:] + "/fork/sync/2"),
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+
+			blockState := NewMockBlockState(ctrl)
+			blockState.EXPECT().GenesisHash().Return(genesisHash)
+
+			s := &Service{
+				cfg: &Config{
+					BlockState: blockState,
+					ForkID:     testCase.forkID,
+				},
+				host: &host{protocolID: "/dot"},
+			}
+
+			id, fallbackIDs := s.requestResponseProtocolIDs(SyncID)
+			assert.Equal(t, testCase.id, id)
+			assert.Equal(t, testCase.fallbackIDs, fallbackIDs)
+		})
+	}
+}
+
+// reportRecorder is a peer set handler recording the reputation changes reported.
+type reportRecorder struct {
+	PeerSetHandler
+	reports []peerset.ReputationChange
+}
+
+func (r *reportRecorder) ReportPeer(rep peerset.ReputationChange, _ ...peer.ID) {
+	r.reports = append(r.reports, rep)
+}
+
+func Test_Service_checkForkID(t *testing.T) {
+	t.Parallel()
+
+	genesisHash := common.Hash{0xaa, 0xbb}
+	genesisPrefix := "/" + genesisHash.String()[2:]
+
+	testCases := map[string]struct {
+		forkID     string
+		pid        protocol.ID
+		errWrapped error
+	}{
+		"without_fork_id": {
+			pid: "/dot/block-announces/1",
+		},
+		"fork_id_matching": {
+			forkID: "fork",
+			pid:    protocol.ID(genesisPrefix + "/fork/block-announces/1"),
+		},
+		"legacy_protocol_id": {
+			forkID:     "fork",
+			pid:        "/dot/block-announces/1",
+			errWrapped: errForkIDMismatch,
+		},
+		"genesis_protocol_id_without_fork_id": {
+			forkID:     "fork",
+			pid:        protocol.ID(genesisPrefix + "/block-announces/1"),
+			errWrapped: errForkIDMismatch,
+		},
+		"other_fork_id": {
+			forkID:     "fork",
+			pid:        protocol.ID(genesisPrefix + "/forked/block-announces/1"),
+			errWrapped: errForkIDMismatch,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+
+			blockState := NewMockBlockState(ctrl)
+			blockState.EXPECT().GenesisHash().Return(genesisHash).AnyTimes()
+
+			recorder := &reportRecorder{}
+			s := &Service{
+				cfg: &Config{
+					BlockState: blockState,
+					ForkID:     testCase.forkID,
+				},
+				host: &host{cm: &ConnManager{peerSetHandler: recorder}},
+			}
+
+			err := s.checkForkID(peer.ID("peer"), testCase.pid)
+			assert.ErrorIs(t, err, testCase.errWrapped)
+			if testCase.errWrapped == nil {
+				assert.Empty(t, recorder.reports)
+				return
+			}
+			assert.Equal(t, []peerset.ReputationChange{{
+				Value:  peerset.ForkIDMismatch,
+				Reason: peerset.ForkIDMismatchReason,
+			}}, recorder.reports)
+		})
+	}
+}
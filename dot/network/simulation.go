@@ -0,0 +1,35 @@
+// Copyright 2024 ChainSafe Systems (ON)
+// SPDX-License-Identifier: LGPL-3.0-only
+
+package network
+
+import (
+	"errors"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/libp2p/go-libp2p/core/protocol"
+	mocknet "github.com/libp2p/go-libp2p/p2p/net/mock"
+)
+
+var errNoMocknet = errors.New("simulation has no mock network")
+
+// SimulationConfig configures the simulated network a network service runs on.
+type SimulationConfig struct {
+	// Mocknet is the mock network the libp2p host is created on, instead of
+	// listening on the real transports.
+	Mocknet mocknet.Mocknet
+	// MessageFilter, if set, is called before a message is written to a peer and the
+	// message is dropped if it returns false, to simulate message loss.
+	MessageFilter func(to peer.ID, pid protocol.ID) bool
+}
+
+// NewSimulatedService creates a network service running on the simulated network given.
+// It is only meant to be used by simulation tests, such as the ones of the netsim package.
+func NewSimulatedService(cfg *Config, simulation SimulationConfig) (*Service, error) {
+	if simulation.Mocknet == nil {
+		return nil, errNoMocknet
+	}
+
+	cfg.simulation = &simulation
+	return NewService(cfg)
+}
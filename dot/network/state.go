@@ -5,6 +5,7 @@
 
 import (
 	"context"
+	"time"
 
 	"github.com/libp2p/go-libp2p/core/peer"
 
@@ -12,6 +13,7 @@
 	"github.com/ChainSafe/gossamer/dot/peerset"
 	"github.com/ChainSafe/gossamer/dot/types"
 	"github.com/ChainSafe/gossamer/lib/common"
+	"github.com/ChainSafe/gossamer/lib/keystore"
 )
 
 // BlockState interface for block state methods
@@ -21,6 +23,16 @@ type BlockState interface {
 	GetHighestFinalisedHeader() (*types.Header, error)
 }
 
+// AuthorityKeystore provides the authority discovery keys of the node
+type AuthorityKeystore interface {
+	Keypairs() []keystore.KeyPair
+}
+
+// AuthoritySetProvider provides the authority discovery keys of the current authority set
+type AuthoritySetProvider interface {
+	AuthorityDiscoveryAuthorities() ([]types.AuthorityID, error)
+}
+
 // Syncer is implemented by the syncing service
 type Syncer interface {
 	HandleBlockAnnounceHandshake(from peer.ID, msg *BlockAnnounceHandshake) error
@@ -53,6 +65,8 @@ type PeerSetHandler interface {
 	PeerAdd
 	PeerRemove
 	Peer
+	PeerBan
+	PeerReserved
 }
 
 // PeerAdd is the interface used by the PeerSetHandler to add peers in peerSet.
@@ -67,6 +81,20 @@ type PeerRemove interface {
 	RemoveReservedPeer(int, ...peer.ID)
 }
 
+// PeerBan is the interface used by the PeerSetHandler to ban peers and persist the bans.
+type PeerBan interface {
+	BanPeer(time.Duration, ...peer.ID)
+	UnbanPeer(...peer.ID)
+	BannedPeers() chan []peerset.BannedPeer
+	Persist() error
+}
+
+// PeerReserved is the interface used by the PeerSetHandler to manage the reserved peers.
+type PeerReserved interface {
+	SetReservedOnly(int, bool)
+	ReservedPeers() chan peer.IDSlice
+}
+
 // Peer is the interface used by the PeerSetHandler to get the peer data from peerSet.
 type Peer interface {
 	SortedPeers(idx int) chan peer.IDSlice
@@ -9,6 +9,7 @@
 	"time"
 
 	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/libp2p/go-libp2p/core/protocol"
 
 	"github.com/ChainSafe/gossamer/dot/types"
 	"github.com/ChainSafe/gossamer/lib/common"
@@ -88,7 +89,8 @@ func decodeTransactionHandshake(_ []byte) (Handshake, error) {
 }
 
 func (s *Service) startTxnBatchProcessing(txnBatchCh chan *batchMessage, slotDuration time.Duration) {
-	protocolID := s.host.protocolID + transactionsID
+	protocolID, fallbackIDs := s.protocolIDs(transactionsID)
+	protocolIDs := append([]protocol.ID{protocolID}, fallbackIDs...)
 	ticker := time.NewTicker(slotDuration)
 	defer ticker.Stop()
 
@@ -107,7 +109,7 @@ func (s *Service) startTxnBatchProcessing(txnBatchCh chan *batchMessage, slotDur
 					propagate, err := s.handleTransactionMessage(txnMsg.peer, txnMsg.msg)
 					if err != nil {
 						logger.Warnf("could not handle transaction message: %s", err)
-						s.host.closeProtocolStream(protocolID, txnMsg.peer)
+						s.host.closeProtocolStream(protocolIDs, txnMsg.peer)
 						continue
 					}
 
@@ -120,7 +122,7 @@ func (s *Service) startTxnBatchProcessing(txnBatchCh chan *batchMessage, slotDur
 
 					hasSeen, err := s.gossip.hasSeen(txnMsg.msg)
 					if err != nil {
-						s.host.closeProtocolStream(protocolID, txnMsg.peer)
+						s.host.closeProtocolStream(protocolIDs, txnMsg.peer)
 						logger.Debugf("could not check if message was seen before: %s", err)
 						continue
 					}
@@ -0,0 +1,129 @@
+// Copyright 2024 ChainSafe Systems (ON)
+// SPDX-License-Identifier: LGPL-3.0-only
+
+package network
+
+import (
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/libp2p/go-libp2p"
+	"github.com/libp2p/go-libp2p/p2p/transport/tcp"
+	"github.com/libp2p/go-libp2p/p2p/transport/websocket"
+	ma "github.com/multiformats/go-multiaddr"
+)
+
+var (
+	errNoListenAddress      = errors.New("no listen address")
+	errTLSCertificateNeeded = errors.New("secure websocket listen address requires a tls certificate and key")
+	errTLSKeyPairIncomplete = errors.New("tls certificate and key must be set together")
+)
+
+// listenAddresses parses the comma separated listen multiaddresses of the config. If none
+// is set, it defaults to a tcp listen address on all interfaces using the config port.
+func listenAddresses(cfg *Config) ([]ma.Multiaddr, error) {
+	if strings.TrimSpace(cfg.ListenAddress) == "" {
+		addr, err := ma.NewMultiaddr(fmt.Sprintf("/ip4/0.0.0.0/tcp/%d", cfg.Port))
+		if err != nil {
+			return nil, err
+		}
+		return []ma.Multiaddr{addr}, nil
+	}
+
+	var addrs []ma.Multiaddr
+	for _, listenAddress := range strings.Split(cfg.ListenAddress, ",") {
+		listenAddress = strings.TrimSpace(listenAddress)
+		if listenAddress == "" {
+			continue
+		}
+
+		addr, err := ma.NewMultiaddr(listenAddress)
+		if err != nil {
+			return nil, fmt.Errorf("parsing listen address %q: %w", listenAddress, err)
+		}
+		addrs = append(addrs, addr)
+	}
+
+	if len(addrs) == 0 {
+		return nil, errNoListenAddress
+	}
+	return addrs, nil
+}
+
+// isSecureWebSocket returns true if the multiaddress is a secure websocket
+// address, either in its /wss or its /tls/ws form.
+func isSecureWebSocket(addr ma.Multiaddr) bool {
+	isSecure := false
+	ma.ForEach(addr, func(c ma.Component) bool {
+		switch c.Protocol().Code {
+		case ma.P_WSS, ma.P_TLS:
+			isSecure = true
+			return false
+		}
+		return true
+	})
+	return isSecure
+}
+
+// webSocketTLSConfig loads the tls certificate and key used to serve secure websocket
+// connections. It returns a nil config if no certificate is configured, in which case
+// no secure websocket listen address can be used.
+func webSocketTLSConfig(cfg *Config, addrs []ma.Multiaddr) (*tls.Config, error) {
+	if (cfg.TLSCert == "") != (cfg.TLSKey == "") {
+		return nil, errTLSKeyPairIncomplete
+	}
+
+	if cfg.TLSCert == "" {
+		for _, addr := range addrs {
+			if isSecureWebSocket(addr) {
+				return nil, fmt.Errorf("%w: %s", errTLSCertificateNeeded, addr)
+			}
+		}
+		return nil, nil //nolint:nilnil
+	}
+
+	certificate, err := tls.LoadX509KeyPair(cfg.TLSCert, cfg.TLSKey)
+	if err != nil {
+		return nil, fmt.Errorf("loading tls certificate: %w", err)
+	}
+
+	return &tls.Config{
+		Certificates: []tls.Certificate{certificate},
+		MinVersion:   tls.VersionTLS12,
+	}, nil
+}
+
+// transportsOption returns the tcp and websocket transports, the websocket transport
+// serving secure websocket connections if a tls config is given.
+func transportsOption(tlsConfig *tls.Config) libp2p.Option {
+	var webSocketOptions []interface{}
+	if tlsConfig != nil {
+		webSocketOptions = append(webSocketOptions, websocket.WithTLSConfig(tlsConfig))
+	}
+
+	return libp2p.ChainOptions(
+		libp2p.Transport(tcp.NewTCPTransport),
+		libp2p.Transport(websocket.New, webSocketOptions...),
+	)
+}
+
+// externalAddresses returns the listen addresses with their host component replaced by the
+// public host component given, such as /ip4/1.2.3.4 or /dns/example.com, so they can be
+// advertised through identify and the DHT.
+func externalAddresses(listenAddrs []ma.Multiaddr, publicHost ma.Multiaddr) []ma.Multiaddr {
+	if publicHost == nil {
+		return nil
+	}
+
+	externalAddrs := make([]ma.Multiaddr, 0, len(listenAddrs))
+	for _, addr := range listenAddrs {
+		_, rest := ma.SplitFirst(addr)
+		if rest == nil {
+			continue
+		}
+		externalAddrs = append(externalAddrs, publicHost.Encapsulate(rest))
+	}
+	return externalAddrs
+}
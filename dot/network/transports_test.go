@@ -0,0 +1,172 @@
+// Copyright 2024 ChainSafe Systems (ON)
+// SPDX-License-Identifier: LGPL-3.0-only
+
+package network
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	ma "github.com/multiformats/go-multiaddr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_listenAddresses(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		cfg        *Config
+		addrs      []ma.Multiaddr
+		errWrapped error
+	}{
+		"default_tcp_address": {
+			cfg: &Config{Port: 7001},
+			addrs: []ma.Multiaddr{
+				ma.StringCast("/ip4/0.0.0.0/tcp/7001"),
+			},
+		},
+		"tcp_ws_and_wss_addresses": {
+			cfg: &Config{
+				Port:          7001,
+				ListenAddress: "/ip4/0.0.0.0/tcp/7001, /ip4/0.0.0.0/tcp/7002/ws,/ip4/0.0.0.0/tcp/7003/wss",
+			},
+			addrs: []ma.Multiaddr{
+				ma.StringCast("/ip4/0.0.0.0/tcp/7001"),
+				ma.StringCast("/ip4/0.0.0.0/tcp/7002/ws"),
+				ma.StringCast("/ip4/0.0.0.0/tcp/7003/wss"),
+			},
+		},
+		"only_separators": {
+			cfg:        &Config{ListenAddress: " , "},
+			errWrapped: errNoListenAddress,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			addrs, err := listenAddresses(testCase.cfg)
+			assert.ErrorIs(t, err, testCase.errWrapped)
+			assert.Equal(t, testCase.addrs, addrs)
+		})
+	}
+}
+
+func Test_isSecureWebSocket(t *testing.T) {
+	t.Parallel()
+
+	assert.False(t, isSecureWebSocket(ma.StringCast("/ip4/0.0.0.0/tcp/7001")))
+	assert.False(t, isSecureWebSocket(ma.StringCast("/ip4/0.0.0.0/tcp/7001/ws")))
+	assert.True(t, isSecureWebSocket(ma.StringCast("/ip4/0.0.0.0/tcp/7001/wss")))
+	assert.True(t, isSecureWebSocket(ma.StringCast("/ip4/0.0.0.0/tcp/7001/tls/ws")))
+}
+
+func Test_externalAddresses(t *testing.T) {
+	t.Parallel()
+
+	listenAddrs := []ma.Multiaddr{
+		ma.StringCast("/ip4/0.0.0.0/tcp/7001"),
+		ma.StringCast("/ip4/0.0.0.0/tcp/7002/ws"),
+		ma.StringCast("/ip4/0.0.0.0/tcp/7003/wss"),
+	}
+
+	assert.Nil(t, externalAddresses(listenAddrs, nil))
+
+	expected := []ma.Multiaddr{
+		ma.StringCast("/dns/alice/tcp/7001"),
+		ma.StringCast("/dns/alice/tcp/7002/ws"),
+		ma.StringCast("/dns/alice/tcp/7003/wss"),
+	}
+	assert.Equal(t, expected, externalAddresses(listenAddrs, ma.StringCast("/dns/alice")))
+}
+
+func writeTestCertificate(t *testing.T, dir string) (certPath, keyPath string) {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "localhost"},
+		NotBefore:    time.Now(),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	certDER, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+
+	certPath = filepath.Join(dir, "cert.pem")
+	err = os.WriteFile(certPath, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: certDER}), 0o600)
+	require.NoError(t, err)
+
+	keyPath = filepath.Join(dir, "key.pem")
+	err = os.WriteFile(keyPath, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}), 0o600)
+	require.NoError(t, err)
+
+	return certPath, keyPath
+}
+
+func Test_webSocketTLSConfig(t *testing.T) {
+	t.Parallel()
+
+	certPath, keyPath := writeTestCertificate(t, t.TempDir())
+	wssAddrs := []ma.Multiaddr{ma.StringCast("/ip4/0.0.0.0/tcp/7003/wss")}
+
+	testCases := map[string]struct {
+		cfg        *Config
+		addrs      []ma.Multiaddr
+		withTLS    bool
+		errWrapped error
+	}{
+		"no_certificate_without_wss": {
+			cfg:   &Config{},
+			addrs: []ma.Multiaddr{ma.StringCast("/ip4/0.0.0.0/tcp/7002/ws")},
+		},
+		"no_certificate_with_wss": {
+			cfg:        &Config{},
+			addrs:      wssAddrs,
+			errWrapped: errTLSCertificateNeeded,
+		},
+		"certificate_without_key": {
+			cfg:        &Config{TLSCert: certPath},
+			addrs:      wssAddrs,
+			errWrapped: errTLSKeyPairIncomplete,
+		},
+		"certificate_and_key": {
+			cfg:     &Config{TLSCert: certPath, TLSKey: keyPath},
+			addrs:   wssAddrs,
+			withTLS: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			tlsConfig, err := webSocketTLSConfig(testCase.cfg, testCase.addrs)
+			assert.ErrorIs(t, err, testCase.errWrapped)
+			if testCase.withTLS {
+				require.NotNil(t, tlsConfig)
+				assert.Len(t, tlsConfig.Certificates, 1)
+			} else {
+				assert.Nil(t, tlsConfig)
+			}
+		})
+	}
+}
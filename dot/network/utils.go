@@ -23,7 +23,9 @@
 	// maxBlockRequestSize              uint64 = 1024 * 1024      // 1mb
 	MaxBlockResponseSize uint64 = 1024 * 1024 * 16 // 16mb
 	// MaxGrandpaNotificationSize is maximum size for a grandpa notification message.
-	MaxGrandpaNotificationSize       uint64 = 1024 * 1024      // 1mb
+	MaxGrandpaNotificationSize uint64 = 1024 * 1024 // 1mb
+	// MaxBeefyNotificationSize is maximum size for a beefy notification message.
+	MaxBeefyNotificationSize         uint64 = 1024 * 1024      // 1mb
 	maxTransactionsNotificationSize  uint64 = 1024 * 1024 * 16 // 16mb
 	maxBlockAnnounceNotificationSize uint64 = 1024 * 1024      // 1mb
 
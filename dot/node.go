@@ -28,7 +28,9 @@
 	"github.com/ChainSafe/gossamer/internal/database"
 	"github.com/ChainSafe/gossamer/internal/log"
 	"github.com/ChainSafe/gossamer/internal/metrics"
+	"github.com/ChainSafe/gossamer/lib/aura"
 	"github.com/ChainSafe/gossamer/lib/babe"
+	"github.com/ChainSafe/gossamer/lib/beefy"
 	"github.com/ChainSafe/gossamer/lib/common"
 	"github.com/ChainSafe/gossamer/lib/genesis"
 	"github.com/ChainSafe/gossamer/lib/grandpa"
@@ -57,16 +59,22 @@ type nodeBuilderIface interface {
 	loadRuntime(config *cfg.Config, ns *runtime.NodeStorage, stateSrvc *state.Service, ks *keystore.GlobalKeystore,
 		net *network.Service) error
 	createBlockVerifier(st *state.Service) *babe.VerificationManager
+	createAuraVerifier(st *state.Service) (*aura.Verifier, error)
 	createDigestHandler(st *state.Service) (*digest.Handler, error)
 	createCoreService(config *cfg.Config, ks *keystore.GlobalKeystore, st *state.Service, net *network.Service,
 	) (*core.Service, error)
 	createGRANDPAService(config *cfg.Config, st *state.Service, ks KeyStore,
 		net *network.Service, telemetryMailer Telemetry) (*grandpa.Service, error)
+	isBEEFYEnabled(st *state.Service) (bool, error)
+	createBEEFYService(config *cfg.Config, st *state.Service, ks KeyStore,
+		net *network.Service) (*beefy.Service, error)
 	newSyncService(config *cfg.Config, st *state.Service, finalityGadget dotsync.FinalityGadget,
-		verifier *babe.VerificationManager, cs *core.Service, net *network.Service,
-		telemetryMailer Telemetry) (network.Syncer, error)
+		beefyImporter dotsync.BeefyJustificationImporter, verifier dotsync.BabeVerifier, cs *core.Service,
+		net *network.Service, telemetryMailer Telemetry) (network.Syncer, error)
 	createBABEService(config *cfg.Config, st *state.Service, ks KeyStore, cs *core.Service,
 		telemetryMailer Telemetry) (service *babe.Service, err error)
+	createAuraService(config *cfg.Config, st *state.Service, ks KeyStore, cs *core.Service,
+		telemetryMailer Telemetry) (service *aura.Service, err error)
 	createSystemService(cfg *types.SystemInfo, stateSrvc *state.Service) (*system.Service, error)
 	createRPCService(params rpcServiceSettings) (*rpc.HTTPServer, error)
 }
@@ -353,7 +361,17 @@ func newNode(config *cfg.Config,
 		return nil, err
 	}
 
-	ver := builder.createBlockVerifier(stateSrvc)
+	isAura := gd.ConsensusEngine == genesis.AuraConsensusEngine
+
+	var ver dotsync.BabeVerifier
+	if isAura {
+		ver, err = builder.createAuraVerifier(stateSrvc)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create aura verifier: %w", err)
+		}
+	} else {
+		ver = builder.createBlockVerifier(stateSrvc)
+	}
 
 	dh, err := builder.createDigestHandler(stateSrvc)
 	if err != nil {
@@ -373,7 +391,28 @@ func newNode(config *cfg.Config,
 	}
 	nodeSrvcs = append(nodeSrvcs, fg)
 
-	syncer, err := builder.newSyncService(config, stateSrvc, fg, ver, coreSrvc, networkSrvc, telemetryMailer)
+	beefyEnabled, err := builder.isBEEFYEnabled(stateSrvc)
+	if err != nil {
+		return nil, fmt.Errorf("checking if beefy is enabled: %w", err)
+	}
+
+	var (
+		beefySrvc     *beefy.Service
+		beefyImporter dotsync.BeefyJustificationImporter
+	)
+	if beefyEnabled {
+		beefySrvc, err = builder.createBEEFYService(config, stateSrvc, ks.Beef, networkSrvc)
+		if err != nil {
+			return nil, err
+		}
+		nodeSrvcs = append(nodeSrvcs, beefySrvc)
+		beefyImporter = beefySrvc
+	} else {
+		logger.Debug("beefy service disabled, the runtime does not expose the beefy api")
+	}
+
+	syncer, err := builder.newSyncService(config, stateSrvc, fg, beefyImporter, ver, coreSrvc, networkSrvc,
+		telemetryMailer)
 	if err != nil {
 		return nil, err
 	}
@@ -381,17 +420,31 @@ func newNode(config *cfg.Config,
 	if networkSrvc != nil {
 		networkSrvc.SetSyncer(syncer)
 		networkSrvc.SetTransactionHandler(coreSrvc)
+		if config.Core.Role == common.AuthorityRole {
+			networkSrvc.SetAuthorityDiscovery(ks.Audi, coreSrvc)
+		}
 	}
 	nodeSrvcs = append(nodeSrvcs, syncer.(service))
 
-	bp, err := builder.createBABEService(config, stateSrvc, ks.Babe, coreSrvc, telemetryMailer)
-	if err != nil {
-		return nil, err
+	var bp BlockProducer
+	if isAura {
+		auraSrvc, err := builder.createAuraService(config, stateSrvc, ks.Aura, coreSrvc, telemetryMailer)
+		if err != nil {
+			return nil, err
+		}
+		nodeSrvcs = append(nodeSrvcs, auraSrvc)
+		bp = auraSrvc
+	} else {
+		babeSrvc, err := builder.createBABEService(config, stateSrvc, ks.Babe, coreSrvc, telemetryMailer)
+		if err != nil {
+			return nil, err
+		}
+		nodeSrvcs = append(nodeSrvcs, babeSrvc)
+		bp = babeSrvc
 	}
-	nodeSrvcs = append(nodeSrvcs, bp)
 
 	// check if rpc service is enabled
-	if enabled := config.RPC.IsRPCEnabled() || config.RPC.IsWSEnabled(); enabled {
+	if enabled := config.RPC.IsRPCEnabled() || config.RPC.IsWSEnabled() || config.RPC.IsIPCEnabled(); enabled {
 		var rpcSrvc *rpc.HTTPServer
 		cRPCParams := rpcServiceSettings{
 			config:        config,
@@ -402,6 +455,7 @@ func newNode(config *cfg.Config,
 			blockProducer: bp,
 			system:        sysSrvc,
 			blockFinality: fg,
+			beefy:         beefySrvc,
 			syncer:        syncer.(rpc.SyncAPI),
 		}
 		rpcSrvc, err = builder.createRPCService(cRPCParams)
@@ -0,0 +1,196 @@
+// Copyright 2024 ChainSafe Systems (ON)
+// SPDX-License-Identifier: LGPL-3.0-only
+
+package peerset
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+)
+
+const (
+	// defaultBanDuration is the duration of a manual ban if no duration
+	// is given and no ban duration is configured.
+	defaultBanDuration = time.Hour
+	// persistInterval is the interval at which reputations and bans are persisted.
+	persistInterval = time.Minute
+)
+
+// BannedPeer is a peer banned from the peerSet until its ban expires.
+type BannedPeer struct {
+	PeerID peer.ID
+	Until  time.Time
+}
+
+// isBanned returns true if the peer is banned, removing its ban if it expired.
+func (ps *PeerSet) isBanned(peerID peer.ID) bool {
+	ps.bansLock.Lock()
+	defer ps.bansLock.Unlock()
+
+	until, ok := ps.bans[peerID]
+	if !ok {
+		return false
+	}
+
+	if time.Now().After(until) {
+		delete(ps.bans, peerID)
+		return false
+	}
+	return true
+}
+
+// banPeers bans the peers for the duration given, dropping their connections and removing
+// them from all sets. If the duration is zero, the configured ban duration is used.
+func (ps *PeerSet) banPeers(duration time.Duration, peers ...peer.ID) error {
+	if duration == 0 {
+		duration = ps.banDuration
+	}
+	if duration == 0 {
+		duration = defaultBanDuration
+	}
+	until := time.Now().Add(duration)
+
+	ps.bansLock.Lock()
+	for _, pid := range peers {
+		ps.bans[pid] = until
+	}
+	ps.bansLock.Unlock()
+
+	for _, pid := range peers {
+		logger.Infof("banning peer %s until %s", pid, until.Format(time.RFC3339))
+
+		for setID := 0; setID < ps.peerState.getSetLength(); setID++ {
+			switch ps.peerState.peerStatus(setID, pid) {
+			case connectedPeer:
+				err := ps.peerState.disconnect(setID, pid)
+				if err != nil {
+					return fmt.Errorf("cannot disconnect: %w", err)
+				}
+
+				ps.resultMsgCh <- Message{
+					Status: Drop,
+					setID:  uint64(setID), //nolint:gosec
+					PeerID: pid,
+				}
+				fallthrough
+			case notConnectedPeer:
+				err := ps.peerState.forgetPeer(setID, pid)
+				if err != nil {
+					return fmt.Errorf("cannot forget peer: %w", err)
+				}
+			}
+		}
+	}
+
+	return ps.persist()
+}
+
+// unbanPeers lifts the bans of the peers, resetting their reputation
+// if it is below the banned threshold value.
+func (ps *PeerSet) unbanPeers(peers ...peer.ID) error {
+	ps.bansLock.Lock()
+	for _, pid := range peers {
+		delete(ps.bans, pid)
+	}
+	ps.bansLock.Unlock()
+
+	ps.peerState.Lock()
+	for _, pid := range peers {
+		node, has := ps.peerState.nodes[pid]
+		if has && node.reputation < BannedThresholdValue {
+			node.reputation = 0
+		}
+	}
+	ps.peerState.Unlock()
+
+	return ps.persist()
+}
+
+// bannedPeers returns the peers currently banned sorted by ban expiry.
+func (ps *PeerSet) bannedPeers() []BannedPeer {
+	ps.bansLock.RLock()
+	defer ps.bansLock.RUnlock()
+
+	now := time.Now()
+	banned := make([]BannedPeer, 0, len(ps.bans))
+	for pid, until := range ps.bans {
+		if now.After(until) {
+			continue
+		}
+		banned = append(banned, BannedPeer{PeerID: pid, Until: until})
+	}
+
+	sort.Slice(banned, func(i, j int) bool {
+		return banned[i].Until.Before(banned[j].Until)
+	})
+	return banned
+}
+
+// load restores the reputations and the bans from the store. The peers with a
+// persisted reputation are known but not member of any set until discovered again.
+func (ps *PeerSet) load() error {
+	if ps.store == nil {
+		return nil
+	}
+
+	reputations, bans, err := ps.store.load()
+	if err != nil {
+		return err
+	}
+
+	ps.peerState.Lock()
+	for pid, reputation := range reputations {
+		n := newNode(len(ps.peerState.sets))
+		n.reputation = reputation
+		ps.peerState.nodes[pid] = n
+	}
+	ps.peerState.Unlock()
+
+	now := time.Now()
+	ps.bansLock.Lock()
+	for pid, until := range bans {
+		if now.After(until) {
+			continue
+		}
+		ps.bans[pid] = until
+	}
+	ps.bansLock.Unlock()
+
+	logger.Debugf("loaded %d peer reputations and %d bans", len(reputations), len(ps.bans))
+	return nil
+}
+
+// persist saves the non zero reputations and the active bans in the store.
+func (ps *PeerSet) persist() error {
+	if ps.store == nil {
+		return nil
+	}
+
+	ps.peerState.RLock()
+	reputations := make(map[peer.ID]Reputation, len(ps.peerState.nodes))
+	for pid, node := range ps.peerState.nodes {
+		if node.reputation != 0 {
+			reputations[pid] = node.reputation
+		}
+	}
+	ps.peerState.RUnlock()
+
+	now := time.Now()
+	ps.bansLock.RLock()
+	bans := make(map[peer.ID]time.Time, len(ps.bans))
+	for pid, until := range ps.bans {
+		if now.Before(until) {
+			bans[pid] = until
+		}
+	}
+	ps.bansLock.RUnlock()
+
+	err := ps.store.save(reputations, bans)
+	if err != nil {
+		return fmt.Errorf("persisting reputations and bans: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,138 @@
+// Copyright 2024 ChainSafe Systems (ON)
+// SPDX-License-Identifier: LGPL-3.0-only
+
+package peerset
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ipfs/go-datastore"
+	dssync "github.com/ipfs/go-datastore/sync"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/libp2p/go-libp2p/core/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBanUnbanPeer(t *testing.T) {
+	const testSetID = 0
+
+	t.Parallel()
+
+	handler := newTestPeerSet(t, 25, 25, nil, nil, false)
+	ps := handler.peerSet
+
+	ps.peerState.insertPeer(testSetID, peer1)
+	err := ps.peerState.tryAcceptIncoming(testSetID, peer1)
+	require.NoError(t, err)
+
+	handler.BanPeer(time.Minute, peer1)
+	checkMessageStatus(t, <-ps.resultMsgCh, Drop)
+	require.Equal(t, unknownPeer, ps.peerState.peerStatus(testSetID, peer1))
+
+	banned := <-handler.BannedPeers()
+	require.Len(t, banned, 1)
+	assert.Equal(t, peer1, banned[0].PeerID)
+
+	// banned peers are rejected even with a good reputation.
+	handler.Incoming(testSetID, peer1)
+	checkMessageStatus(t, <-ps.resultMsgCh, Reject)
+
+	handler.UnbanPeer(peer1)
+	assert.Empty(t, <-handler.BannedPeers())
+
+	handler.Incoming(testSetID, peer1)
+	checkMessageStatus(t, <-ps.resultMsgCh, Accept)
+}
+
+func TestReportPeerBanDuration(t *testing.T) {
+	const testSetID = 0
+
+	t.Parallel()
+
+	handler, err := NewPeerSetHandler(&ConfigSet{
+		Set: []*config{{
+			maxInPeers:        25,
+			maxOutPeers:       25,
+			periodicAllocTime: allocTimeDuration,
+		}},
+		BanDuration: time.Hour,
+	})
+	require.NoError(t, err)
+	handler.Start(context.Background())
+	ps := handler.peerSet
+
+	ps.peerState.insertPeer(testSetID, peer1)
+	err = ps.peerState.tryAcceptIncoming(testSetID, peer1)
+	require.NoError(t, err)
+
+	handler.ReportPeer(newReputationChange(BannedThresholdValue-1, ""), peer1)
+	checkMessageStatus(t, <-ps.resultMsgCh, Drop)
+
+	banned := <-handler.BannedPeers()
+	require.Len(t, banned, 1)
+
+	// the ban outlives the reputation decay.
+	ps.peerState.Lock()
+	ps.peerState.nodes[peer1].reputation = 0
+	ps.peerState.Unlock()
+
+	handler.Incoming(testSetID, peer1)
+	checkMessageStatus(t, <-ps.resultMsgCh, Reject)
+}
+
+func TestPersistAndLoad(t *testing.T) {
+	t.Parallel()
+
+	store := NewStore(dssync.MutexWrap(datastore.NewMapDatastore()))
+	reputedPeer := test.RandPeerIDFatal(t)
+	bannedPeer := test.RandPeerIDFatal(t)
+	expiredPeer := test.RandPeerIDFatal(t)
+
+	cfg := &ConfigSet{
+		Set: []*config{{
+			maxInPeers:        25,
+			maxOutPeers:       25,
+			periodicAllocTime: allocTimeDuration,
+		}},
+		Store: store,
+	}
+
+	ps, err := newPeerSet(cfg)
+	require.NoError(t, err)
+
+	ps.peerState.insertPeer(0, reputedPeer)
+	_, err = ps.peerState.addReputation(reputedPeer, newReputationChange(-100, ""))
+	require.NoError(t, err)
+	ps.bans[bannedPeer] = time.Now().Add(time.Hour)
+	ps.bans[expiredPeer] = time.Now().Add(-time.Second)
+
+	err = ps.persist()
+	require.NoError(t, err)
+
+	reloaded, err := newPeerSet(cfg)
+	require.NoError(t, err)
+
+	node, err := reloaded.peerState.getNode(reputedPeer)
+	require.NoError(t, err)
+	assert.Equal(t, Reputation(-100), node.reputation)
+	assert.Equal(t, unknownPeer, reloaded.peerState.peerStatus(0, reputedPeer))
+
+	assert.True(t, reloaded.isBanned(bannedPeer))
+	assert.False(t, reloaded.isBanned(expiredPeer))
+	assert.Equal(t, []peer.ID{bannedPeer}, bannedPeerIDs(reloaded.bannedPeers()))
+
+	// a discovered peer with a persisted reputation becomes a member of the set.
+	reloaded.peerState.insertPeer(0, reputedPeer)
+	assert.Equal(t, notConnectedPeer, reloaded.peerState.peerStatus(0, reputedPeer))
+}
+
+func bannedPeerIDs(banned []BannedPeer) []peer.ID {
+	ids := make([]peer.ID, len(banned))
+	for i, bannedPeer := range banned {
+		ids[i] = bannedPeer.PeerID
+	}
+	return ids
+}
@@ -73,6 +73,16 @@
 	// GenesisMismatchReason used when a peer has a different genesis
 	GenesisMismatchReason = "Genesis mismatch"
 
+	// ForkIDMismatch is used when peer may follow another fork of the chain
+	ForkIDMismatch Reputation = math.MinInt32
+	// ForkIDMismatchReason used when a peer may follow another fork of the chain
+	ForkIDMismatchReason = "Fork ID mismatch"
+
+	// SlowPeerValue is used when a peer is too slow to receive the notifications we send.
+	SlowPeerValue Reputation = -(1 << 12)
+	// SlowPeerReason is used when a peer is too slow to receive the notifications we send.
+	SlowPeerReason = "Slow peer"
+
 	// SameBlockSyncRequest used when a peer send us more than the max number of the same request.
 	SameBlockSyncRequest       Reputation = math.MinInt32
 	SameBlockSyncRequestReason            = "same block sync request"
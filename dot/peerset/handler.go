@@ -5,6 +5,7 @@
 
 import (
 	"context"
+	"time"
 
 	"github.com/libp2p/go-libp2p/core/peer"
 )
@@ -57,6 +58,27 @@ func (h *Handler) SetReservedPeer(setID int, peers ...peer.ID) {
 	}
 }
 
+// SetReservedOnly switches the reserved-only mode, in which only the reserved
+// peers are accepted and connected to.
+func (h *Handler) SetReservedOnly(setID int, reservedOnly bool) {
+	h.actionQueue <- action{
+		actionCall:   setReservedOnly,
+		setID:        setID,
+		reservedOnly: reservedOnly,
+	}
+}
+
+// ReservedPeers return chan for the reserved peers of the peerSet.
+func (h *Handler) ReservedPeers() chan peer.IDSlice {
+	resultPeersCh := make(chan peer.IDSlice, 1)
+	h.actionQueue <- action{
+		actionCall:    reservedPeers,
+		resultPeersCh: resultPeersCh,
+	}
+
+	return resultPeersCh
+}
+
 // AddPeer adds peer to peerSet.
 func (h *Handler) AddPeer(setID int, peers ...peer.ID) {
 	h.actionQueue <- action{
@@ -120,6 +142,40 @@ func (h *Handler) PeerReputation(peerID peer.ID) (Reputation, error) {
 	return n.reputation, nil
 }
 
+// BanPeer bans the peers for the duration given, or for the configured
+// ban duration if the duration is zero.
+func (h *Handler) BanPeer(duration time.Duration, peers ...peer.ID) {
+	h.actionQueue <- action{
+		actionCall:  banPeer,
+		banDuration: duration,
+		peers:       peers,
+	}
+}
+
+// UnbanPeer lifts the ban of the peers.
+func (h *Handler) UnbanPeer(peers ...peer.ID) {
+	h.actionQueue <- action{
+		actionCall: unbanPeer,
+		peers:      peers,
+	}
+}
+
+// BannedPeers return chan for the peers currently banned.
+func (h *Handler) BannedPeers() chan []BannedPeer {
+	resultBansCh := make(chan []BannedPeer, 1)
+	h.actionQueue <- action{
+		actionCall:   bannedPeers,
+		resultBansCh: resultBansCh,
+	}
+
+	return resultBansCh
+}
+
+// Persist saves the reputations and the bans in the peerSet store.
+func (h *Handler) Persist() error {
+	return h.peerSet.persist()
+}
+
 // Start starts peerSet processing
 func (h *Handler) Start(ctx context.Context) {
 	ctx, cancel := context.WithCancel(ctx)
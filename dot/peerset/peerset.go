@@ -8,6 +8,7 @@
 	"errors"
 	"fmt"
 	"math"
+	"sort"
 	"strings"
 	"sync"
 	"time"
@@ -41,7 +42,7 @@
 	removeReservedPeer
 	// setReservedPeers is for setting peerList in peerSet reserved peers
 	setReservedPeers
-	// setReservedOnly is for setting peerList in peerSet reserved peers only
+	// setReservedOnly is for switching the reserved-only mode of the peerSet
 	setReservedOnly
 	// reportPeer is for reporting peers if it misbehaves
 	reportPeer
@@ -55,6 +56,14 @@
 	sortedPeers
 	// disconnect peer
 	disconnect
+	// banPeer is for banning peers for a duration
+	banPeer
+	// unbanPeer is for lifting the ban of peers
+	unbanPeer
+	// bannedPeers is for the list of banned peers
+	bannedPeers
+	// reservedPeers is for the list of reserved peers
+	reservedPeers
 )
 
 func (a ActionReceiver) String() string {
@@ -79,6 +88,14 @@ func (a ActionReceiver) String() string {
 		return "sortedPeers"
 	case disconnect:
 		return "disconnect"
+	case banPeer:
+		return "banPeer"
+	case unbanPeer:
+		return "unbanPeer"
+	case bannedPeers:
+		return "bannedPeers"
+	case reservedPeers:
+		return "reservedPeers"
 	default:
 		return "invalid action"
 	}
@@ -90,7 +107,10 @@ type action struct {
 	setID         int
 	reputation    ReputationChange
 	peers         peer.IDSlice
+	banDuration   time.Duration
+	reservedOnly  bool
 	resultPeersCh chan peer.IDSlice
+	resultBansCh  chan []BannedPeer
 }
 
 func (a action) String() string {
@@ -175,8 +195,7 @@ type PeerSet struct {
 
 	reservedLock sync.RWMutex
 	reservedNode map[peer.ID]struct{}
-	// TODO: this will be useful for reserved only mode
-	// this is for future purpose if reserved-only flag is enabled (#1888).
+	// isReservedOnly is true if only the reserved peers are accepted and connected to.
 	isReservedOnly bool
 
 	// resultMsgCh is read by network.Service.
@@ -190,6 +209,15 @@ type PeerSet struct {
 	nextPeriodicAllocSlots time.Duration
 	// chan for receiving action request.
 	actionQueue <-chan action
+
+	// store persists the reputations and the bans, it is nil if persistence is disabled.
+	store *Store
+	// banDuration is the duration of the ban applied to a peer when its reputation
+	// falls below BannedThresholdValue, and the default duration of manual bans.
+	banDuration time.Duration
+	bansLock    sync.RWMutex
+	// bans maps the banned peers to their ban expiry time.
+	bans map[peer.ID]time.Time
 }
 
 // config is configuration of a single set.
@@ -199,8 +227,7 @@ type config struct {
 	// maximum number of slot occupying nodes for outgoing connections.
 	maxOutPeers uint32
 
-	// TODO Use in future for reserved only peers
-	// if true, we only accept reservedNodes (#1888).
+	// if true, we only accept reservedNodes.
 	reservedOnly bool
 
 	// time duration for a peerSet to periodically call allocSlots.
@@ -210,6 +237,12 @@ type config struct {
 // ConfigSet set of peerSet config.
 type ConfigSet struct {
 	Set []*config
+	// Store persists the reputations and the bans across restarts if it is not nil.
+	Store *Store
+	// BanDuration is the duration of the ban applied to a peer when its reputation
+	// falls below BannedThresholdValue. If zero, the peer is only rejected until its
+	// reputation decays back above the threshold.
+	BanDuration time.Duration
 }
 
 // NewConfigSet creates a new config set for the peerSet
@@ -249,6 +282,14 @@ func newPeerSet(cfg *ConfigSet) (*PeerSet, error) {
 		created:                now,
 		latestTimeUpdate:       now,
 		nextPeriodicAllocSlots: cfgSet.periodicAllocTime,
+		store:                  cfg.Store,
+		banDuration:            cfg.BanDuration,
+		bans:                   make(map[peer.ID]time.Time),
+	}
+
+	err = ps.load()
+	if err != nil {
+		return nil, fmt.Errorf("loading peerset store: %w", err)
 	}
 
 	return ps, nil
@@ -345,6 +386,12 @@ func (ps *PeerSet) reportPeer(change ReputationChange, peers ...peer.ID) error {
 			return nil
 		}
 
+		if ps.banDuration > 0 {
+			ps.bansLock.Lock()
+			ps.bans[pid] = time.Now().Add(ps.banDuration)
+			ps.bansLock.Unlock()
+		}
+
 		setLen := ps.peerState.getSetLength()
 		for i := 0; i < setLen; i++ {
 			if ps.peerState.peerStatus(i, pid) != connectedPeer {
@@ -389,6 +436,11 @@ func (ps *PeerSet) allocSlots(setIdx int) error {
 			peerState.insertPeer(setIdx, reservePeer)
 		}
 
+		if ps.isBanned(reservePeer) {
+			logger.Debugf("reserved peer %s is banned", reservePeer)
+			continue
+		}
+
 		node, err := ps.peerState.getNode(reservePeer)
 		if err != nil {
 			return fmt.Errorf("cannot get node: %w", err)
@@ -493,10 +545,9 @@ func (ps *PeerSet) removeReservedPeers(setID int, peers ...peer.ID) error {
 
 		// nothing more to do if not in reservedOnly mode.
 		if !ps.isReservedOnly {
-			return nil
+			continue
 		}
 
-		// reservedOnly mode is not yet implemented for future this code will help.
 		// If however the peerSet is in reserved-only mode, then non-reserved node peers needs to be
 		// disconnected.
 		if ps.peerState.peerStatus(setID, peerID) == connectedPeer {
@@ -550,6 +601,54 @@ func (ps *PeerSet) setReservedPeer(setID int, peers ...peer.ID) error {
 	return nil
 }
 
+// setReservedOnly switches the reserved-only mode of the peerSet. When it is enabled,
+// the connected peers which are not reserved are dropped, and when it is disabled,
+// the outgoing slots are filled with the other known peers.
+func (ps *PeerSet) setReservedOnly(setID int, reservedOnly bool) error {
+	ps.reservedLock.Lock()
+	ps.isReservedOnly = reservedOnly
+	ps.reservedLock.Unlock()
+
+	if !reservedOnly {
+		return ps.allocSlots(setID)
+	}
+
+	for _, pid := range ps.peerState.sortedPeers(setID) {
+		ps.reservedLock.RLock()
+		_, reserved := ps.reservedNode[pid]
+		ps.reservedLock.RUnlock()
+		if reserved {
+			continue
+		}
+
+		err := ps.peerState.disconnect(setID, pid)
+		if err != nil {
+			return fmt.Errorf("cannot disconnect: %w", err)
+		}
+
+		ps.resultMsgCh <- Message{
+			Status: Drop,
+			setID:  uint64(setID), //nolint:gosec
+			PeerID: pid,
+		}
+	}
+
+	return nil
+}
+
+// reservedPeers returns the reserved peers, sorted by id.
+func (ps *PeerSet) reservedPeers() peer.IDSlice {
+	ps.reservedLock.RLock()
+	defer ps.reservedLock.RUnlock()
+
+	peers := make(peer.IDSlice, 0, len(ps.reservedNode))
+	for pid := range ps.reservedNode {
+		peers = append(peers, pid)
+	}
+	sort.Sort(peers)
+	return peers
+}
+
 // addPeer checks peer existence in peerSet and if it does not insert the peer in to peerstate with
 // default reputation and notConnected status. Afterwards runs allocSlots that checks availability of outgoing slots
 // and put notConnected peers in to them
@@ -559,6 +658,11 @@ func (ps *PeerSet) addPeer(setID int, peers peer.IDSlice) error {
 			return nil
 		}
 
+		if ps.isBanned(pid) {
+			logger.Debugf("not adding banned peer %s", pid)
+			continue
+		}
+
 		ps.peerState.insertPeer(setID, pid)
 		if err := ps.allocSlots(setID); err != nil {
 			return fmt.Errorf("could not allocate slots: %w", err)
@@ -647,7 +751,7 @@ func (ps *PeerSet) incoming(setID int, peers ...peer.ID) error {
 			PeerID: pid,
 		}
 
-		if nodeReputation < BannedThresholdValue {
+		if nodeReputation < BannedThresholdValue || ps.isBanned(pid) {
 			message.Status = Reject
 		} else {
 			err := state.tryAcceptIncoming(setID, pid)
@@ -732,9 +836,11 @@ func (ps *PeerSet) start(ctx context.Context, actionQueue chan action) {
 
 func (ps *PeerSet) listenActionAllocSlots(ctx context.Context) {
 	ticker := time.NewTicker(ps.nextPeriodicAllocSlots)
+	persistTicker := time.NewTicker(persistInterval)
 
 	defer func() {
 		ticker.Stop()
+		persistTicker.Stop()
 		close(ps.resultMsgCh)
 	}()
 
@@ -743,6 +849,10 @@ func (ps *PeerSet) listenActionAllocSlots(ctx context.Context) {
 		case <-ctx.Done():
 			logger.Debugf("peerset slot allocation exiting: %s", ctx.Err())
 			return
+		case <-persistTicker.C:
+			if err := ps.persist(); err != nil {
+				logger.Warnf("failed to persist peerset: %s", err)
+			}
 		case <-ticker.C:
 			for setID := 0; setID < ps.peerState.getSetLength(); setID++ {
 				if err := ps.allocSlots(setID); err != nil {
@@ -761,11 +871,9 @@ func (ps *PeerSet) listenActionAllocSlots(ctx context.Context) {
 			case removeReservedPeer:
 				err = ps.removeReservedPeers(act.setID, act.peers...)
 			case setReservedPeers:
-				// TODO: this is not used yet, might required to implement RPC Call for this.
 				err = ps.setReservedPeer(act.setID, act.peers...)
 			case setReservedOnly:
-				// TODO: not yet implemented (#1888)
-				err = fmt.Errorf("not implemented yet")
+				err = ps.setReservedOnly(act.setID, act.reservedOnly)
 			case reportPeer:
 				err = ps.reportPeer(act.reputation, act.peers...)
 			case addToPeerSet:
@@ -778,6 +886,14 @@ func (ps *PeerSet) listenActionAllocSlots(ctx context.Context) {
 				act.resultPeersCh <- ps.peerState.sortedPeers(act.setID)
 			case disconnect:
 				err = ps.disconnect(act.setID, UnknownDrop, act.peers...)
+			case banPeer:
+				err = ps.banPeers(act.banDuration, act.peers...)
+			case unbanPeer:
+				err = ps.unbanPeers(act.peers...)
+			case bannedPeers:
+				act.resultBansCh <- ps.bannedPeers()
+			case reservedPeers:
+				act.resultPeersCh <- ps.reservedPeers()
 			}
 
 			if err != nil {
@@ -314,6 +314,35 @@ func TestSetReservePeer(t *testing.T) {
 	}
 }
 
+func TestSetReservedOnly(t *testing.T) {
+	const testSetID = 0
+
+	t.Parallel()
+	handler := newTestPeerSet(t, 25, 25, []peer.ID{bootNode}, []peer.ID{reservedPeer}, false)
+
+	ps := handler.peerSet
+	require.Len(t, ps.resultMsgCh, 2)
+	for len(ps.resultMsgCh) != 0 {
+		checkMessageStatus(t, <-ps.resultMsgCh, Connect)
+	}
+
+	require.Equal(t, peer.IDSlice{reservedPeer}, <-handler.ReservedPeers())
+
+	// switching to reserved-only drops the peers which are not reserved.
+	handler.SetReservedOnly(testSetID, true)
+	msg := <-ps.resultMsgCh
+	require.Equal(t, Message{Status: Drop, setID: testSetID, PeerID: bootNode}, msg)
+	require.Equal(t, connectedPeer, ps.peerState.peerStatus(testSetID, reservedPeer))
+
+	handler.Incoming(testSetID, incomingPeer)
+	checkMessageStatus(t, <-ps.resultMsgCh, Reject)
+
+	// switching back fills the outgoing slots with the known peers.
+	handler.SetReservedOnly(testSetID, false)
+	msg = <-ps.resultMsgCh
+	require.Equal(t, Message{Status: Connect, setID: testSetID, PeerID: bootNode}, msg)
+}
+
 func getNodePeer(ps *PeersState, pid peer.ID) (node, bool) {
 	ps.RLock()
 	defer ps.RUnlock()
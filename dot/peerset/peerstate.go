@@ -241,7 +241,7 @@ func (ps *PeersState) addReputation(peerID peer.ID, change ReputationChange) (
 
 	node, has := ps.nodes[peerID]
 	if !has {
-		ps.insertPeer(0, peerID)
+		ps.insertPeerLocked(0, peerID)
 		node = ps.nodes[peerID]
 	}
 
@@ -378,11 +378,23 @@ func (ps *PeersState) insertPeer(set int, peerID peer.ID) {
 	ps.Lock()
 	defer ps.Unlock()
 
-	_, has := ps.nodes[peerID]
+	ps.insertPeerLocked(set, peerID)
+}
+
+// insertPeerLocked is insertPeer for callers already holding the lock.
+func (ps *PeersState) insertPeerLocked(set int, peerID peer.ID) {
+	n, has := ps.nodes[peerID]
 	if !has {
-		n := newNode(len(ps.sets))
+		n = newNode(len(ps.sets))
 		n.state[set] = notConnected
 		ps.nodes[peerID] = n
+		return
+	}
+
+	// the peer is known, for example from its persisted reputation, but not a member of the set.
+	if n.state[set] == notMember {
+		n.state[set] = notConnected
+		n.lastConnected[set] = time.Now()
 	}
 }
 
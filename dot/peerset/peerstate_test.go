@@ -197,3 +197,15 @@ func TestHighestNotConnectedPeer(t *testing.T) {
 
 	require.Equal(t, peer1, state.highestNotConnectedPeer(0))
 }
+
+func TestAddReputationUnknownPeer(t *testing.T) {
+	t.Parallel()
+
+	state := newTestPeerState(t, 1, 1)
+
+	// reporting a peer not known yet inserts it in the first set.
+	newReputation, err := state.addReputation(peer1, newReputationChange(-10, "test"))
+	require.NoError(t, err)
+	require.Equal(t, Reputation(-10), newReputation)
+	require.Equal(t, notConnectedPeer, state.peerStatus(0, peer1))
+}
@@ -0,0 +1,125 @@
+// Copyright 2024 ChainSafe Systems (ON)
+// SPDX-License-Identifier: LGPL-3.0-only
+
+package peerset
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"time"
+
+	"github.com/ipfs/go-datastore"
+	"github.com/ipfs/go-datastore/query"
+	"github.com/libp2p/go-libp2p/core/peer"
+)
+
+var (
+	reputationsPrefix = datastore.NewKey("/peerset/reputation")
+	bansPrefix        = datastore.NewKey("/peerset/ban")
+)
+
+// Store persists the peers reputations and bans in a datastore,
+// so they survive node restarts.
+type Store struct {
+	ds datastore.Batching
+}
+
+// NewStore creates a new peerset store using the datastore given.
+func NewStore(ds datastore.Batching) *Store {
+	return &Store{ds: ds}
+}
+
+// load returns the reputations and the ban expiry times persisted in the store.
+func (s *Store) load() (reputations map[peer.ID]Reputation, bans map[peer.ID]time.Time, err error) {
+	reputations = make(map[peer.ID]Reputation)
+	err = s.iterate(reputationsPrefix, func(peerID peer.ID, value []byte) error {
+		if len(value) != 4 {
+			return fmt.Errorf("invalid reputation length %d for peer %s", len(value), peerID)
+		}
+		reputations[peerID] = Reputation(int32(binary.LittleEndian.Uint32(value))) //nolint:gosec
+		return nil
+	})
+	if err != nil {
+		return nil, nil, fmt.Errorf("loading reputations: %w", err)
+	}
+
+	bans = make(map[peer.ID]time.Time)
+	err = s.iterate(bansPrefix, func(peerID peer.ID, value []byte) error {
+		if len(value) != 8 {
+			return fmt.Errorf("invalid ban expiry length %d for peer %s", len(value), peerID)
+		}
+		bans[peerID] = time.Unix(0, int64(binary.LittleEndian.Uint64(value))) //nolint:gosec
+		return nil
+	})
+	if err != nil {
+		return nil, nil, fmt.Errorf("loading bans: %w", err)
+	}
+
+	return reputations, bans, nil
+}
+
+func (s *Store) iterate(prefix datastore.Key, f func(peerID peer.ID, value []byte) error) error {
+	results, err := s.ds.Query(context.Background(), query.Query{Prefix: prefix.String()})
+	if err != nil {
+		return fmt.Errorf("querying datastore: %w", err)
+	}
+	defer results.Close()
+
+	for result := range results.Next() {
+		if result.Error != nil {
+			return fmt.Errorf("iterating datastore: %w", result.Error)
+		}
+
+		peerID, err := peer.Decode(datastore.RawKey(result.Key).BaseNamespace())
+		if err != nil {
+			return fmt.Errorf("decoding peer id: %w", err)
+		}
+
+		err = f(peerID, result.Value)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// save replaces the persisted reputations and bans with the ones given.
+func (s *Store) save(reputations map[peer.ID]Reputation, bans map[peer.ID]time.Time) error {
+	ctx := context.Background()
+	batch, err := s.ds.Batch(ctx)
+	if err != nil {
+		return fmt.Errorf("creating batch: %w", err)
+	}
+
+	for _, prefix := range []datastore.Key{reputationsPrefix, bansPrefix} {
+		err = s.iterate(prefix, func(peerID peer.ID, _ []byte) error {
+			return batch.Delete(ctx, prefix.ChildString(peerID.String()))
+		})
+		if err != nil {
+			return fmt.Errorf("deleting previous entries: %w", err)
+		}
+	}
+
+	for peerID, reputation := range reputations {
+		value := binary.LittleEndian.AppendUint32(nil, uint32(reputation)) //nolint:gosec
+		err = batch.Put(ctx, reputationsPrefix.ChildString(peerID.String()), value)
+		if err != nil {
+			return fmt.Errorf("putting reputation: %w", err)
+		}
+	}
+
+	for peerID, expiry := range bans {
+		value := binary.LittleEndian.AppendUint64(nil, uint64(expiry.UnixNano())) //nolint:gosec
+		err = batch.Put(ctx, bansPrefix.ChildString(peerID.String()), value)
+		if err != nil {
+			return fmt.Errorf("putting ban: %w", err)
+		}
+	}
+
+	err = batch.Commit(ctx)
+	if err != nil {
+		return fmt.Errorf("committing batch: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,167 @@
+// Copyright 2024 ChainSafe Systems (ON)
+// SPDX-License-Identifier: LGPL-3.0-only
+
+package rpc
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/ChainSafe/gossamer/lib/common"
+)
+
+// jwtSecretLength is the length in bytes of the JWT HMAC secret.
+const jwtSecretLength = 32
+
+// jwtLeeway is the clock drift tolerated when checking the JWT time claims.
+const jwtLeeway = 60 * time.Second
+
+var (
+	errMissingAuthorization  = errors.New("missing authorization header")
+	errInvalidAuthorization  = errors.New("invalid authorization header")
+	errUnauthorized          = errors.New("unauthorized")
+	errJWTMalformed          = errors.New("malformed jwt")
+	errJWTAlgorithm          = errors.New("unsupported jwt algorithm")
+	errJWTSignature          = errors.New("invalid jwt signature")
+	errJWTExpired            = errors.New("jwt expired")
+	errJWTIssuedInTheFuture  = errors.New("jwt issued in the future")
+	errJWTSecretLength       = errors.New("invalid jwt secret length")
+	errJWTSecretFileNotFound = errors.New("jwt secret file not found")
+)
+
+// ReadJWTSecret reads the hex encoded 32 bytes JWT secret from the file at the given path.
+func ReadJWTSecret(path string) (secret []byte, err error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, fmt.Errorf("%w: %s", errJWTSecretFileNotFound, path)
+		}
+		return nil, fmt.Errorf("reading jwt secret file: %w", err)
+	}
+
+	secretHex := strings.TrimSpace(string(data))
+	if !strings.HasPrefix(secretHex, "0x") {
+		secretHex = "0x" + secretHex
+	}
+
+	secret, err = common.HexToBytes(secretHex)
+	if err != nil {
+		return nil, fmt.Errorf("decoding jwt secret: %w", err)
+	}
+
+	if len(secret) != jwtSecretLength {
+		return nil, fmt.Errorf("%w: expected %d bytes, got %d",
+			errJWTSecretLength, jwtSecretLength, len(secret))
+	}
+
+	return secret, nil
+}
+
+func (h *HTTPServerConfig) authEnabled() bool {
+	return len(h.JWTSecret) > 0 || len(h.BearerTokens) > 0
+}
+
+// authenticate checks the request carries in its Authorization header either
+// a JWT signed with the configured secret or one of the configured bearer tokens.
+func (h *HTTPServerConfig) authenticate(r *http.Request) error {
+	header := r.Header.Get("Authorization")
+	if header == "" {
+		return errMissingAuthorization
+	}
+
+	const bearerPrefix = "Bearer "
+	if !strings.HasPrefix(header, bearerPrefix) {
+		return errInvalidAuthorization
+	}
+	token := strings.TrimPrefix(header, bearerPrefix)
+
+	for _, bearerToken := range h.BearerTokens {
+		if subtle.ConstantTimeCompare([]byte(token), []byte(bearerToken)) == 1 {
+			return nil
+		}
+	}
+
+	if len(h.JWTSecret) == 0 {
+		return errUnauthorized
+	}
+
+	err := verifyJWT(token, h.JWTSecret, time.Now())
+	if err != nil {
+		return fmt.Errorf("%w: %w", errUnauthorized, err)
+	}
+
+	return nil
+}
+
+type jwtHeader struct {
+	Alg string `json:"alg"`
+	Typ string `json:"typ"`
+}
+
+type jwtClaims struct {
+	IssuedAt  *int64 `json:"iat"`
+	ExpiresAt *int64 `json:"exp"`
+}
+
+// verifyJWT verifies the HS256 signature of the JWT given using the secret given,
+// as well as its optional issued at and expiration time claims.
+func verifyJWT(token string, secret []byte, now time.Time) error {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return fmt.Errorf("%w: expected 3 parts, got %d", errJWTMalformed, len(parts))
+	}
+
+	var header jwtHeader
+	err := decodeJWTPart(parts[0], &header)
+	if err != nil {
+		return fmt.Errorf("%w: header: %s", errJWTMalformed, err)
+	}
+
+	if header.Alg != "HS256" {
+		return fmt.Errorf("%w: %s", errJWTAlgorithm, header.Alg)
+	}
+
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return fmt.Errorf("%w: signature: %s", errJWTMalformed, err)
+	}
+
+	mac := hmac.New(sha256.New, secret)
+	_, _ = mac.Write([]byte(parts[0] + "." + parts[1]))
+	if !hmac.Equal(signature, mac.Sum(nil)) {
+		return errJWTSignature
+	}
+
+	var claims jwtClaims
+	err = decodeJWTPart(parts[1], &claims)
+	if err != nil {
+		return fmt.Errorf("%w: claims: %s", errJWTMalformed, err)
+	}
+
+	if claims.ExpiresAt != nil && now.After(time.Unix(*claims.ExpiresAt, 0).Add(jwtLeeway)) {
+		return errJWTExpired
+	}
+
+	if claims.IssuedAt != nil && time.Unix(*claims.IssuedAt, 0).After(now.Add(jwtLeeway)) {
+		return errJWTIssuedInTheFuture
+	}
+
+	return nil
+}
+
+func decodeJWTPart(part string, v any) error {
+	data, err := base64.RawURLEncoding.DecodeString(part)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, v)
+}
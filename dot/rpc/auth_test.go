@@ -0,0 +1,164 @@
+// Copyright 2024 ChainSafe Systems (ON)
+// SPDX-License-Identifier: LGPL-3.0-only
+
+package rpc
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestJWT(t *testing.T, header, claims string, secret []byte) string {
+	t.Helper()
+
+	unsigned := base64.RawURLEncoding.EncodeToString([]byte(header)) + "." +
+		base64.RawURLEncoding.EncodeToString([]byte(claims))
+	mac := hmac.New(sha256.New, secret)
+	_, err := mac.Write([]byte(unsigned))
+	require.NoError(t, err)
+	return unsigned + "." + base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+func Test_verifyJWT(t *testing.T) {
+	t.Parallel()
+
+	secret := []byte("0123456789abcdef0123456789abcdef")
+	now := time.Unix(1700000000, 0)
+	const hs256Header = `{"alg":"HS256","typ":"JWT"}`
+
+	testCases := map[string]struct {
+		token      string
+		errWrapped error
+		errMessage string
+	}{
+		"valid_without_claims": {
+			token: newTestJWT(t, hs256Header, `{}`, secret),
+		},
+		"valid_with_claims": {
+			token: newTestJWT(t, hs256Header, `{"iat":1700000000,"exp":1700000100}`, secret),
+		},
+		"malformed": {
+			token:      "a.b",
+			errWrapped: errJWTMalformed,
+			errMessage: "malformed jwt: expected 3 parts, got 2",
+		},
+		"unsupported_algorithm": {
+			token:      newTestJWT(t, `{"alg":"none"}`, `{}`, secret),
+			errWrapped: errJWTAlgorithm,
+			errMessage: "unsupported jwt algorithm: none",
+		},
+		"wrong_secret": {
+			token:      newTestJWT(t, hs256Header, `{}`, []byte("another secret")),
+			errWrapped: errJWTSignature,
+			errMessage: "invalid jwt signature",
+		},
+		"expired": {
+			token:      newTestJWT(t, hs256Header, `{"exp":1699999000}`, secret),
+			errWrapped: errJWTExpired,
+			errMessage: "jwt expired",
+		},
+		"issued_in_the_future": {
+			token:      newTestJWT(t, hs256Header, `{"iat":1700001000}`, secret),
+			errWrapped: errJWTIssuedInTheFuture,
+			errMessage: "jwt issued in the future",
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := verifyJWT(testCase.token, secret, now)
+			assert.ErrorIs(t, err, testCase.errWrapped)
+			if testCase.errWrapped != nil {
+				assert.EqualError(t, err, testCase.errMessage)
+			}
+		})
+	}
+}
+
+func Test_HTTPServerConfig_authenticate(t *testing.T) {
+	t.Parallel()
+
+	secret := []byte("0123456789abcdef0123456789abcdef")
+	cfg := &HTTPServerConfig{
+		JWTSecret:    secret,
+		BearerTokens: []string{"static-token"},
+	}
+
+	testCases := map[string]struct {
+		authorization string
+		errWrapped    error
+	}{
+		"missing_header": {
+			errWrapped: errMissingAuthorization,
+		},
+		"not_bearer": {
+			authorization: "Basic dXNlcjpwYXNz",
+			errWrapped:    errInvalidAuthorization,
+		},
+		"static_bearer_token": {
+			authorization: "Bearer static-token",
+		},
+		"jwt": {
+			authorization: "Bearer " + newTestJWT(t, `{"alg":"HS256"}`, `{}`, secret),
+		},
+		"unknown_token": {
+			authorization: "Bearer unknown-token",
+			errWrapped:    errUnauthorized,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			request, err := http.NewRequest(http.MethodPost, "http://localhost/", nil)
+			require.NoError(t, err)
+			if testCase.authorization != "" {
+				request.Header.Set("Authorization", testCase.authorization)
+			}
+
+			err = cfg.authenticate(request)
+			assert.ErrorIs(t, err, testCase.errWrapped)
+		})
+	}
+}
+
+func Test_ReadJWTSecret(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+
+	validPath := filepath.Join(dir, "valid")
+	err := os.WriteFile(validPath,
+		[]byte("0x0102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f20\n"), 0o600)
+	require.NoError(t, err)
+
+	secret, err := ReadJWTSecret(validPath)
+	require.NoError(t, err)
+	assert.Len(t, secret, jwtSecretLength)
+	assert.Equal(t, byte(1), secret[0])
+
+	shortPath := filepath.Join(dir, "short")
+	err = os.WriteFile(shortPath, []byte("0102"), 0o600)
+	require.NoError(t, err)
+
+	_, err = ReadJWTSecret(shortPath)
+	assert.ErrorIs(t, err, errJWTSecretLength)
+	assert.EqualError(t, err, "invalid jwt secret length: expected 32 bytes, got 2")
+
+	_, err = ReadJWTSecret(filepath.Join(dir, "missing"))
+	assert.ErrorIs(t, err, errJWTSecretFileNotFound)
+}
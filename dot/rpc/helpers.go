@@ -59,7 +59,21 @@ func rpcValidator(cfg *HTTPServerConfig, validate *validator.Validate) func(r *r
 			return err
 		}
 
+		// requests received on the IPC socket are only reachable by the node user,
+		// so they are allowed to call unsafe methods
+		if isIPCRequest(r.Request) {
+			return validate.Struct(v)
+		}
+
 		isUnsafe := modules.IsUnsafe(rpcmethod)
+		if isUnsafe && cfg.authEnabled() {
+			// authenticated requests can reach unsafe methods from any host
+			if err = cfg.authenticate(r.Request); err != nil {
+				return err
+			}
+			return validate.Struct(v)
+		}
+
 		if isUnsafe && !cfg.rpcUnsafeEnabled() {
 			return fmt.Errorf("unsafe rpc method %s cannot be reachable", rpcmethod)
 		}
@@ -7,6 +7,7 @@
 	"fmt"
 	"net"
 	"net/http"
+	"sync"
 	"time"
 
 	"github.com/ChainSafe/gossamer/dot/rpc/modules"
@@ -25,6 +26,8 @@ type HTTPServer struct {
 	logger       *log.Logger
 	rpcServer    *rpc.Server // Actual RPC call handler
 	serverConfig *HTTPServerConfig
+	ipcServer    *http.Server
+	wsConnsMu    sync.Mutex
 	wsConns      []*subscription.WSConn
 }
 
@@ -37,6 +40,8 @@ type HTTPServerConfig struct {
 	CoreAPI             CoreAPI
 	BlockProducerAPI    BlockProducerAPI
 	BlockFinalityAPI    BlockFinalityAPI
+	BabeAPI             BabeAPI
+	BeefyAPI            BeefyAPI
 	TransactionQueueAPI TransactionStateAPI
 	RPCAPI              API
 	SystemAPI           SystemAPI
@@ -51,6 +56,9 @@ type HTTPServerConfig struct {
 	WSExternal          bool
 	WSUnsafeExternal    bool
 	WSPort              uint32
+	IPCPath             string
+	JWTSecret           []byte
+	BearerTokens        []string
 	Modules             []string
 }
 
@@ -70,6 +78,16 @@ func (h *HTTPServerConfig) exposeRPC() bool {
 	return h.RPCExternal || h.RPCUnsafeExternal
 }
 
+func (h *HTTPServerConfig) exposeIPC() bool {
+	return h.IPCPath != ""
+}
+
+// ipcOnly returns true if the RPC server is only served over the IPC socket,
+// in which case no TCP port is listened on.
+func (h *HTTPServerConfig) ipcOnly() bool {
+	return h.exposeIPC() && !h.RPCUnsafe && !h.exposeRPC() && !h.exposeWS()
+}
+
 var logger *log.Logger
 
 // NewHTTPServer creates a new http server and registers an associated rpc server
@@ -103,6 +121,14 @@ func (h *HTTPServer) RegisterModules(mods []string) {
 			srvc = modules.NewChainModule(h.serverConfig.BlockAPI)
 		case "grandpa":
 			srvc = modules.NewGrandpaModule(h.serverConfig.BlockAPI, h.serverConfig.BlockFinalityAPI)
+		case "babe":
+			srvc = modules.NewBabeModule(h.serverConfig.BabeAPI)
+		case "beefy":
+			if h.serverConfig.BeefyAPI == nil {
+				h.logger.Debug("Not enabling rpc module beefy, beefy is disabled")
+				continue
+			}
+			srvc = modules.NewBeefyModule(h.serverConfig.BeefyAPI)
 		case "state":
 			srvc = modules.NewStateModule(h.serverConfig.NetworkAPI, h.serverConfig.StorageAPI,
 				h.serverConfig.CoreAPI, h.serverConfig.BlockAPI)
@@ -140,16 +166,27 @@ func (h *HTTPServer) Start() error {
 	h.rpcServer.RegisterCodec(NewDotUpCodec(), "application/json")
 	h.rpcServer.RegisterCodec(NewDotUpCodec(), "application/json;charset=UTF-8")
 
-	h.logger.Infof("Starting HTTP Server on host %s and port %d...", h.serverConfig.Host, h.serverConfig.RPCPort)
-	r := mux.NewRouter()
-	r.Handle("/", h.rpcServer)
-
 	validate := validator.New()
 	// Add custom validator for `common.Hash`
 	validate.RegisterCustomTypeFunc(common.HashValidator, common.Hash{})
 
 	h.rpcServer.RegisterValidateRequestFunc(rpcValidator(h.serverConfig, validate))
 
+	if h.serverConfig.exposeIPC() {
+		err := h.startIPC()
+		if err != nil {
+			return fmt.Errorf("starting ipc server: %w", err)
+		}
+	}
+
+	if h.serverConfig.ipcOnly() {
+		return nil
+	}
+
+	h.logger.Infof("Starting HTTP Server on host %s and port %d...", h.serverConfig.Host, h.serverConfig.RPCPort)
+	r := mux.NewRouter()
+	r.Handle("/", h.rpcServer)
+
 	go func() {
 		server := &http.Server{
 			Addr:              fmt.Sprintf(":%d", h.serverConfig.RPCPort),
@@ -189,7 +226,10 @@ func (h *HTTPServer) Start() error {
 
 // Stop stops the server
 func (h *HTTPServer) Stop() error {
-	if h.serverConfig.exposeWS() {
+	if h.serverConfig.exposeWS() || h.serverConfig.exposeIPC() {
+		h.wsConnsMu.Lock()
+		defer h.wsConnsMu.Unlock()
+
 		// close all channels and websocket connections
 		for _, conn := range h.wsConns {
 			for _, sub := range conn.Subscriptions {
@@ -198,6 +238,8 @@ func (h *HTTPServer) Stop() error {
 					h.serverConfig.StorageAPI.UnregisterStorageObserver(v)
 				case *subscription.BlockListener:
 					h.serverConfig.BlockAPI.FreeImportedBlockNotifierChannel(v.Channel)
+				case *subscription.ChildStorageListener:
+					h.serverConfig.BlockAPI.FreeImportedBlockNotifierChannel(v.Channel)
 				}
 			}
 
@@ -207,6 +249,11 @@ func (h *HTTPServer) Stop() error {
 			}
 		}
 	}
+
+	err := h.stopIPC()
+	if err != nil {
+		return fmt.Errorf("stopping ipc server: %w", err)
+	}
 	return nil
 }
 
@@ -241,11 +288,20 @@ func (h *HTTPServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	}
 	// create wsConn
 	wsc := NewWSConn(ws, h.serverConfig)
-	h.wsConns = append(h.wsConns, wsc)
+	// forward the credentials of the websocket connection to the RPC calls
+	// it makes, so unsafe methods are authenticated as for HTTP requests
+	wsc.Authorization = r.Header.Get("Authorization")
+	h.addWSConn(wsc)
 
 	go wsc.HandleConn()
 }
 
+func (h *HTTPServer) addWSConn(wsc *subscription.WSConn) {
+	h.wsConnsMu.Lock()
+	defer h.wsConnsMu.Unlock()
+	h.wsConns = append(h.wsConns, wsc)
+}
+
 // NewWSConn to create new WebSocket Connection struct
 func NewWSConn(conn *websocket.Conn, cfg *HTTPServerConfig) *subscription.WSConn {
 	c := &subscription.WSConn{
@@ -256,6 +312,7 @@ func NewWSConn(conn *websocket.Conn, cfg *HTTPServerConfig) *subscription.WSConn
 		BlockAPI:      cfg.BlockAPI,
 		CoreAPI:       cfg.CoreAPI,
 		TxStateAPI:    cfg.TransactionQueueAPI,
+		BeefyAPI:      cfg.BeefyAPI,
 		RPCHost:       fmt.Sprintf("http://%s:%d/", cfg.Host, cfg.RPCPort),
 		HTTP: &http.Client{
 			Timeout: time.Second * 30,
@@ -8,8 +8,11 @@
 	"errors"
 	"fmt"
 	"io"
+	"io/fs"
 	"net"
 	"net/http"
+	"os"
+	"path/filepath"
 	"testing"
 	"time"
 
@@ -41,7 +44,7 @@ func TestRegisterModules(t *testing.T) {
 	mods := []string{
 		"system", "author", "chain",
 		"state", "rpc", "grandpa",
-		"offchain", "childstate", "syncstate",
+		"babe", "beefy", "offchain", "childstate", "syncstate",
 	}
 
 	for _, modName := range mods {
@@ -49,7 +52,23 @@ func TestRegisterModules(t *testing.T) {
 	}
 
 	cfg := &HTTPServerConfig{
-		Modules: mods,
+		Modules:  mods,
+		RPCAPI:   rpcapiMocks,
+		BeefyAPI: mocks.NewMockBeefyAPI(ctrl),
+	}
+
+	NewHTTPServer(cfg)
+}
+
+func TestRegisterModules_BeefyDisabled(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	rpcapiMocks := NewMockAPI(ctrl)
+
+	// the beefy module is not registered without the beefy service.
+	rpcapiMocks.EXPECT().BuildMethodNames(gomock.Any(), "chain")
+
+	cfg := &HTTPServerConfig{
+		Modules: []string{"chain", "beefy"},
 		RPCAPI:  rpcapiMocks,
 	}
 
@@ -238,6 +257,108 @@ func TestRPCExternalEnable_UnsafeExternalNotEnabled(t *testing.T) {
 	require.Equal(t, expected, string(resBody))
 }
 
+func TestUnsafeRPCOverIPC(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	data := []byte(fmt.Sprintf(
+		`{"jsonrpc":"2.0","method":"%s","params":["%s"],"id":1}`,
+		"system_addReservedPeer",
+		"/ip4/198.51.100.19/tcp/30333/p2p/QmSk5HQbn6LhUwDiNMseVUjuRYhEtYj4aUZ6WfWoGURpdV"))
+
+	netmock := mocks.NewMockNetworkAPI(ctrl)
+	netmock.EXPECT().AddReservedPeers(gomock.Any()).Return(nil)
+
+	ipcPath := filepath.Join(t.TempDir(), "gossamer.ipc")
+	cfg := &HTTPServerConfig{
+		Modules:    []string{"system"},
+		RPCAPI:     NewService(),
+		NetworkAPI: netmock,
+		IPCPath:    ipcPath,
+	}
+
+	s := NewHTTPServer(cfg)
+	err := s.Start()
+	require.NoError(t, err)
+	defer s.Stop()
+
+	info, err := os.Stat(ipcPath)
+	require.NoError(t, err)
+	require.Equal(t, ipcSocketPermissions, info.Mode().Perm())
+
+	req, err := http.NewRequest(http.MethodPost, ipcRPCHost, bytes.NewReader(data))
+	require.NoError(t, err)
+	req.Header.Set("Content-Type", "application/json")
+
+	res, err := newIPCHTTPClient(ipcPath).Do(req)
+	require.NoError(t, err)
+	defer res.Body.Close()
+
+	resBody, err := io.ReadAll(res.Body)
+	require.NoError(t, err)
+
+	expected := `{"jsonrpc":"2.0","result":null,"id":1}` + "\n"
+	require.Equal(t, expected, string(resBody))
+}
+
+func TestUnsafeRPCWithBearerToken(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	data := fmt.Sprintf(
+		`{"jsonrpc":"2.0","method":"%s","params":["%s"],"id":1}`,
+		"system_addReservedPeer",
+		"/ip4/198.51.100.19/tcp/30333/p2p/QmSk5HQbn6LhUwDiNMseVUjuRYhEtYj4aUZ6WfWoGURpdV")
+
+	netmock := mocks.NewMockNetworkAPI(ctrl)
+	netmock.EXPECT().AddReservedPeers(gomock.Any()).Return(nil)
+
+	cfg := &HTTPServerConfig{
+		Modules:      []string{"system"},
+		RPCPort:      7881,
+		RPCAPI:       NewService(),
+		RPCExternal:  true,
+		NetworkAPI:   netmock,
+		BearerTokens: []string{"secret-token"},
+	}
+
+	s := NewHTTPServer(cfg)
+	err := s.Start()
+	require.NoError(t, err)
+
+	time.Sleep(time.Second)
+	defer s.Stop()
+
+	ip, err := externalIP()
+	require.NoError(t, err)
+	url := fmt.Sprintf("http://%s:%v/", ip, cfg.RPCPort)
+
+	// unsafe method without authorization should not be ok
+	_, resBody := PostRequest(t, url, bytes.NewBufferString(data))
+	expected := `{` +
+		`"jsonrpc":"2.0",` +
+		`"error":{` +
+		`"code":-32000,` +
+		`"message":"missing authorization header",` +
+		`"data":null` +
+		`},` +
+		`"id":1` +
+		`}` + "\n"
+	require.Equal(t, expected, string(resBody))
+
+	// unsafe method with the bearer token should be ok from an external address
+	req, err := http.NewRequest(http.MethodPost, url, bytes.NewBufferString(data))
+	require.NoError(t, err)
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "Bearer secret-token")
+
+	res, err := new(http.Client).Do(req)
+	require.NoError(t, err)
+	defer res.Body.Close()
+
+	resBody, err = io.ReadAll(res.Body)
+	require.NoError(t, err)
+	require.Equal(t, `{"jsonrpc":"2.0","result":null,"id":1}`+"\n", string(resBody))
+}
+
 func PostRequest(t *testing.T, url string, data io.Reader) (int, []byte) {
 	t.Helper()
 
@@ -384,3 +505,37 @@ func newCoreServiceTest(t *testing.T) *core.Service {
 
 	return s
 }
+
+func Test_listenIPC(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	ipcPath := filepath.Join(dir, "gossamer.ipc")
+
+	// a stale socket file left by a previous run is replaced.
+	err := os.WriteFile(ipcPath, nil, 0o644)
+	require.NoError(t, err)
+
+	listener, err := listenIPC(ipcPath)
+	require.NoError(t, err)
+
+	info, err := os.Stat(ipcPath)
+	require.NoError(t, err)
+	require.Equal(t, fs.ModeSocket, info.Mode().Type())
+	require.Equal(t, ipcSocketPermissions, info.Mode().Perm())
+
+	// the directory the socket was created in is removed.
+	entries, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	require.Equal(t, "gossamer.ipc", entries[0].Name())
+
+	conn, err := net.Dial("unix", ipcPath)
+	require.NoError(t, err)
+	require.NoError(t, conn.Close())
+
+	// the socket file is removed by stopIPC rather than by the listener.
+	require.NoError(t, listener.Close())
+	_, err = os.Stat(ipcPath)
+	require.NoError(t, err)
+}
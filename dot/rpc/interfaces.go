@@ -5,6 +5,7 @@
 
 import (
 	"encoding/json"
+	"time"
 
 	"github.com/ChainSafe/gossamer/dot/core"
 	"github.com/ChainSafe/gossamer/dot/state"
@@ -62,6 +63,11 @@ type NetworkAPI interface {
 	StartingBlock() int64
 	AddReservedPeers(addrs ...string) error
 	RemoveReservedPeers(addrs ...string) error
+	BanPeer(peerID string, duration time.Duration) error
+	UnbanPeer(peerID string) error
+	BannedPeers() []common.BannedPeerInfo
+	ReservedPeers() []string
+	SetReservedOnly(reservedOnly bool)
 }
 
 // BlockProducerAPI is the interface for BlockProducer methods
@@ -89,6 +95,9 @@ type CoreAPI interface {
 	GetMetadata(bhash *common.Hash) ([]byte, error)
 	DecodeSessionKeys(enc []byte) ([]byte, error)
 	GetReadProofAt(block common.Hash, keys [][]byte) (common.Hash, [][]byte, error)
+	GetChildReadProofAt(block common.Hash, childStorageKey []byte, keys [][]byte) (common.Hash, [][]byte, error)
+	DryRun(ext types.Extrinsic, bhash *common.Hash) ([]byte, error)
+	AccountNonce(accountID []byte, bhash *common.Hash) (uint64, error)
 }
 
 // API is the interface for methods related to RPC service
@@ -115,6 +124,18 @@ type BlockFinalityAPI interface {
 	PreCommits() []ed25519.PublicKeyBytes
 }
 
+// BabeAPI is the interface for the BABE equivocations detected
+type BabeAPI interface {
+	EquivocationProofs() ([]types.BabeEquivocationProof, error)
+}
+
+// BeefyAPI is the interface for the BEEFY finality methods
+type BeefyAPI interface {
+	BestBeefyBlockHash() (common.Hash, error)
+	GetJustificationsNotifierChannel() chan []byte
+	FreeJustificationsNotifierChannel(ch chan []byte)
+}
+
 // SyncStateAPI is the interface to interact with sync state.
 type SyncStateAPI interface {
 	GenSyncSpec(raw bool) (*genesis.Genesis, error)
@@ -0,0 +1,164 @@
+// Copyright 2024 ChainSafe Systems (ON)
+// SPDX-License-Identifier: LGPL-3.0-only
+
+package rpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/gorilla/websocket"
+)
+
+// ipcSocketPermissions restricts the IPC socket to the user running the node,
+// the filesystem permissions being the authorisation boundary of the IPC transport.
+const ipcSocketPermissions fs.FileMode = 0o600
+
+// ipcRPCHost is the host used by websocket connections over IPC to forward
+// RPC calls, the actual address being the unix socket dialed by the transport.
+const ipcRPCHost = "http://ipc/"
+
+type ipcContextKey struct{}
+
+// isIPCRequest returns true if the request was received on the IPC unix socket.
+func isIPCRequest(r *http.Request) bool {
+	if r == nil {
+		return false
+	}
+	ipc, _ := r.Context().Value(ipcContextKey{}).(bool)
+	return ipc
+}
+
+// listenIPC removes any stale socket file at the given path and listens on a new
+// unix socket at this path, only accessible by the user running the node. The socket
+// is created in a directory only accessible by the user running the node, and moved to
+// the given path once its permissions are restricted, so that it is never reachable by
+// other users.
+func listenIPC(path string) (listener net.Listener, err error) {
+	err = os.Remove(path)
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return nil, fmt.Errorf("removing stale ipc socket: %w", err)
+	}
+
+	dir, err := os.MkdirTemp(filepath.Dir(path), ".ipc-")
+	if err != nil {
+		return nil, fmt.Errorf("creating ipc socket directory: %w", err)
+	}
+	defer os.RemoveAll(dir)
+
+	// the temporary path is kept short since unix socket paths are limited in length.
+	tempPath := filepath.Join(dir, "s")
+	unixListener, err := net.ListenUnix("unix", &net.UnixAddr{Name: tempPath, Net: "unix"})
+	if err != nil {
+		return nil, fmt.Errorf("listening on ipc socket: %w", err)
+	}
+	// the socket file is moved, and removed by stopIPC.
+	unixListener.SetUnlinkOnClose(false)
+
+	err = os.Chmod(tempPath, ipcSocketPermissions)
+	if err != nil {
+		_ = unixListener.Close()
+		return nil, fmt.Errorf("setting ipc socket permissions: %w", err)
+	}
+
+	err = os.Rename(tempPath, path)
+	if err != nil {
+		_ = unixListener.Close()
+		return nil, fmt.Errorf("moving ipc socket: %w", err)
+	}
+
+	return unixListener, nil
+}
+
+// startIPC starts serving the JSON-RPC modules and the websocket subscriptions
+// on the unix socket at the configured IPC path.
+func (h *HTTPServer) startIPC() error {
+	listener, err := listenIPC(h.serverConfig.IPCPath)
+	if err != nil {
+		return err
+	}
+
+	h.logger.Infof("Starting IPC Server on unix socket %s...", h.serverConfig.IPCPath)
+
+	h.ipcServer = &http.Server{
+		ReadHeaderTimeout: 5 * time.Second,
+		Handler:           http.HandlerFunc(h.serveIPC),
+		ConnContext: func(ctx context.Context, _ net.Conn) context.Context {
+			return context.WithValue(ctx, ipcContextKey{}, true)
+		},
+	}
+
+	go func() {
+		err := h.ipcServer.Serve(listener)
+		if err != nil && !errors.Is(err, http.ErrServerClosed) {
+			h.logger.Errorf("ipc error: %s", err)
+		}
+	}()
+
+	return nil
+}
+
+// stopIPC closes the IPC server and removes its unix socket file.
+func (h *HTTPServer) stopIPC() error {
+	if h.ipcServer == nil {
+		return nil
+	}
+
+	err := h.ipcServer.Close()
+	if err != nil {
+		return fmt.Errorf("closing ipc server: %w", err)
+	}
+
+	err = os.Remove(h.serverConfig.IPCPath)
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return fmt.Errorf("removing ipc socket: %w", err)
+	}
+	return nil
+}
+
+// serveIPC handles both JSON-RPC requests and websocket connections received on the IPC socket.
+func (h *HTTPServer) serveIPC(w http.ResponseWriter, r *http.Request) {
+	if !websocket.IsWebSocketUpgrade(r) {
+		h.rpcServer.ServeHTTP(w, r)
+		return
+	}
+
+	upg := websocket.Upgrader{
+		// the unix socket permissions already restrict who can connect
+		CheckOrigin: func(r *http.Request) bool { return true },
+	}
+
+	ws, err := upg.Upgrade(w, r, nil)
+	if err != nil {
+		h.logger.Errorf("ipc websocket upgrade failed: %s", err)
+		return
+	}
+
+	wsc := NewWSConn(ws, h.serverConfig)
+	wsc.UnsafeEnabled = true
+	wsc.RPCHost = ipcRPCHost
+	wsc.HTTP = newIPCHTTPClient(h.serverConfig.IPCPath)
+	h.addWSConn(wsc)
+
+	go wsc.HandleConn()
+}
+
+// newIPCHTTPClient returns an HTTP client sending all its requests to the unix socket at the given path.
+func newIPCHTTPClient(path string) *http.Client {
+	return &http.Client{
+		Timeout: time.Second * 30,
+		Transport: &http.Transport{
+			DialContext: func(ctx context.Context, _, _ string) (net.Conn, error) {
+				var dialer net.Dialer
+				return dialer.DialContext(ctx, "unix", path)
+			},
+		},
+	}
+}
@@ -4,6 +4,8 @@
 package modules
 
 import (
+	"time"
+
 	"github.com/ChainSafe/gossamer/dot/core"
 	"github.com/ChainSafe/gossamer/dot/state"
 	"github.com/ChainSafe/gossamer/dot/types"
@@ -60,6 +62,11 @@ type NetworkAPI interface {
 	StartingBlock() int64
 	AddReservedPeers(addrs ...string) error
 	RemoveReservedPeers(addrs ...string) error
+	BanPeer(peerID string, duration time.Duration) error
+	UnbanPeer(peerID string) error
+	BannedPeers() []common.BannedPeerInfo
+	ReservedPeers() []string
+	SetReservedOnly(reservedOnly bool)
 }
 
 // BlockProducerAPI is the interface for BlockProducer methods
@@ -84,6 +91,9 @@ type CoreAPI interface {
 	GetMetadata(bhash *common.Hash) ([]byte, error)
 	DecodeSessionKeys(enc []byte) ([]byte, error)
 	GetReadProofAt(block common.Hash, keys [][]byte) (common.Hash, [][]byte, error)
+	GetChildReadProofAt(block common.Hash, childStorageKey []byte, keys [][]byte) (common.Hash, [][]byte, error)
+	DryRun(ext types.Extrinsic, bhash *common.Hash) ([]byte, error)
+	AccountNonce(accountID []byte, bhash *common.Hash) (uint64, error)
 }
 
 // RPCAPI is the interface for methods related to RPC service
@@ -109,6 +119,18 @@ type BlockFinalityAPI interface {
 	PreCommits() []ed25519.PublicKeyBytes
 }
 
+// BabeAPI is the interface for the BABE equivocations detected
+type BabeAPI interface {
+	EquivocationProofs() ([]types.BabeEquivocationProof, error)
+}
+
+// BeefyAPI is the interface for the BEEFY finality methods
+type BeefyAPI interface {
+	BestBeefyBlockHash() (common.Hash, error)
+	GetJustificationsNotifierChannel() chan []byte
+	FreeJustificationsNotifierChannel(ch chan []byte)
+}
+
 // RuntimeStorageAPI is the interface to interacts with the node storage
 type RuntimeStorageAPI interface {
 	SetLocal(k, v []byte) error
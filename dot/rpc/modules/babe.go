@@ -0,0 +1,60 @@
+// Copyright 2024 ChainSafe Systems (ON)
+// SPDX-License-Identifier: LGPL-3.0-only
+
+package modules
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/ChainSafe/gossamer/lib/common"
+)
+
+// BabeEquivocationResponse is the proof of a BABE equivocation, an authority having
+// authored two blocks in the same slot.
+type BabeEquivocationResponse struct {
+	Slot         uint64                   `json:"slot"`
+	Offender     string                   `json:"offender"`
+	FirstHeader  ChainBlockHeaderResponse `json:"firstHeader"`
+	SecondHeader ChainBlockHeaderResponse `json:"secondHeader"`
+}
+
+// BabeModule is an RPC module for the BABE block production.
+type BabeModule struct {
+	babeAPI BabeAPI
+}
+
+// NewBabeModule creates a new BABE rpc module.
+func NewBabeModule(babeAPI BabeAPI) *BabeModule {
+	return &BabeModule{
+		babeAPI: babeAPI,
+	}
+}
+
+// Equivocations returns the proofs of the equivocations detected in the slots stored
+// by the node, ordered by slot.
+func (bm *BabeModule) Equivocations(_ *http.Request, _ *EmptyRequest, res *[]BabeEquivocationResponse) error {
+	proofs, err := bm.babeAPI.EquivocationProofs()
+	if err != nil {
+		return err
+	}
+
+	equivocations := make([]BabeEquivocationResponse, len(proofs))
+	for i, proof := range proofs {
+		equivocations[i].Slot = proof.Slot
+		equivocations[i].Offender = common.BytesToHex(proof.Offender[:])
+
+		equivocations[i].FirstHeader, err = HeaderToJSON(proof.FirstHeader)
+		if err != nil {
+			return fmt.Errorf("converting first header: %w", err)
+		}
+
+		equivocations[i].SecondHeader, err = HeaderToJSON(proof.SecondHeader)
+		if err != nil {
+			return fmt.Errorf("converting second header: %w", err)
+		}
+	}
+
+	*res = equivocations
+	return nil
+}
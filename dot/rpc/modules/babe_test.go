@@ -0,0 +1,83 @@
+// Copyright 2024 ChainSafe Systems (ON)
+// SPDX-License-Identifier: LGPL-3.0-only
+
+package modules
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ChainSafe/gossamer/dot/rpc/modules/mocks"
+	"github.com/ChainSafe/gossamer/dot/types"
+	"github.com/ChainSafe/gossamer/lib/common"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestBabeModule_Equivocations(t *testing.T) {
+	t.Parallel()
+
+	errTest := errors.New("test error")
+
+	proof := types.BabeEquivocationProof{
+		Offender:     types.AuthorityID{1},
+		Slot:         10,
+		FirstHeader:  types.Header{Number: 1, ParentHash: common.Hash{1}},
+		SecondHeader: types.Header{Number: 1, ParentHash: common.Hash{2}},
+	}
+
+	expectedEquivocation := BabeEquivocationResponse{
+		Slot:     10,
+		Offender: "0x0100000000000000000000000000000000000000000000000000000000000000",
+		FirstHeader: ChainBlockHeaderResponse{
+			ParentHash:     "0x0100000000000000000000000000000000000000000000000000000000000000",
+			Number:         "0x01",
+			StateRoot:      "0x0000000000000000000000000000000000000000000000000000000000000000",
+			ExtrinsicsRoot: "0x0000000000000000000000000000000000000000000000000000000000000000",
+			Digest:         ChainBlockHeaderDigest{},
+		},
+		SecondHeader: ChainBlockHeaderResponse{
+			ParentHash:     "0x0200000000000000000000000000000000000000000000000000000000000000",
+			Number:         "0x01",
+			StateRoot:      "0x0000000000000000000000000000000000000000000000000000000000000000",
+			ExtrinsicsRoot: "0x0000000000000000000000000000000000000000000000000000000000000000",
+			Digest:         ChainBlockHeaderDigest{},
+		},
+	}
+
+	testCases := map[string]struct {
+		proofs []types.BabeEquivocationProof
+		err    error
+		expRes []BabeEquivocationResponse
+		expErr error
+	}{
+		"no_equivocation": {
+			expRes: []BabeEquivocationResponse{},
+		},
+		"equivocation": {
+			proofs: []types.BabeEquivocationProof{proof},
+			expRes: []BabeEquivocationResponse{expectedEquivocation},
+		},
+		"babe_error": {
+			err:    errTest,
+			expErr: errTest,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+
+			babeAPI := mocks.NewMockBabeAPI(ctrl)
+			babeAPI.EXPECT().EquivocationProofs().Return(testCase.proofs, testCase.err)
+
+			var res []BabeEquivocationResponse
+			err := NewBabeModule(babeAPI).Equivocations(nil, nil, &res)
+
+			assert.ErrorIs(t, err, testCase.expErr)
+			assert.Equal(t, testCase.expRes, res)
+		})
+	}
+}
@@ -0,0 +1,33 @@
+// Copyright 2024 ChainSafe Systems (ON)
+// SPDX-License-Identifier: LGPL-3.0-only
+
+package modules
+
+import (
+	"net/http"
+
+	"github.com/ChainSafe/gossamer/lib/common"
+)
+
+// BeefyModule is an RPC module for the BEEFY finality gadget.
+type BeefyModule struct {
+	beefyAPI BeefyAPI
+}
+
+// NewBeefyModule creates a new BEEFY rpc module.
+func NewBeefyModule(beefyAPI BeefyAPI) *BeefyModule {
+	return &BeefyModule{
+		beefyAPI: beefyAPI,
+	}
+}
+
+// GetFinalizedHead returns the hash of the latest block finalised by BEEFY
+func (bm *BeefyModule) GetFinalizedHead(_ *http.Request, _ *EmptyRequest, res *string) error {
+	hash, err := bm.beefyAPI.BestBeefyBlockHash()
+	if err != nil {
+		return err
+	}
+
+	*res = common.BytesToHex(hash[:])
+	return nil
+}
@@ -0,0 +1,53 @@
+// Copyright 2024 ChainSafe Systems (ON)
+// SPDX-License-Identifier: LGPL-3.0-only
+
+package modules
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ChainSafe/gossamer/dot/rpc/modules/mocks"
+	"github.com/ChainSafe/gossamer/lib/common"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestBeefyModule_GetFinalizedHead(t *testing.T) {
+	t.Parallel()
+
+	errTest := errors.New("test error")
+
+	testCases := map[string]struct {
+		hash   common.Hash
+		err    error
+		expRes string
+		expErr error
+	}{
+		"finalized_head": {
+			hash:   common.Hash{1},
+			expRes: "0x0100000000000000000000000000000000000000000000000000000000000000",
+		},
+		"beefy_error": {
+			err:    errTest,
+			expErr: errTest,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+
+			beefyAPI := mocks.NewMockBeefyAPI(ctrl)
+			beefyAPI.EXPECT().BestBeefyBlockHash().Return(testCase.hash, testCase.err)
+
+			var res string
+			err := NewBeefyModule(beefyAPI).GetFinalizedHead(nil, nil, &res)
+
+			assert.ErrorIs(t, err, testCase.expErr)
+			assert.Equal(t, testCase.expRes, res)
+		})
+	}
+}
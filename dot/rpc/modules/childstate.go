@@ -5,6 +5,7 @@
 
 import (
 	"net/http"
+	"strings"
 
 	"github.com/ChainSafe/gossamer/lib/common"
 )
@@ -16,6 +17,15 @@ type GetKeysRequest struct {
 	Hash   *common.Hash
 }
 
+// GetKeysPagedRequest represents the request to retrieve a page of the keys of a child storage
+type GetKeysPagedRequest struct {
+	ChildStorageKey string       `json:"childStorageKey"`
+	Prefix          string       `json:"prefix"`
+	Qty             uint32       `json:"qty"`
+	AfterKey        string       `json:"afterKey"`
+	Hash            *common.Hash `json:"block"`
+}
+
 // ChildStateStorageRequest holds json fields
 type ChildStateStorageRequest struct {
 	ChildStorageKey []byte       `json:"childStorageKey"`
@@ -23,6 +33,12 @@ type ChildStateStorageRequest struct {
 	Hash            *common.Hash `json:"block"`
 }
 
+// ChildStateStorageSubscribeRequest holds json fields
+type ChildStateStorageSubscribeRequest struct {
+	ChildStorageKey string   `json:"childStorageKey"`
+	Keys            []string `json:"keys"`
+}
+
 // GetStorageHash the request to get the entry child storage hash
 type GetStorageHash struct {
 	KeyChild []byte
@@ -81,6 +97,58 @@ func (cs *ChildStateModule) GetKeys(_ *http.Request, req *GetKeysRequest, res *[
 	return nil
 }
 
+// GetKeysPaged returns the keys of the specified child storage with the given prefix,
+// with pagination support.
+func (cs *ChildStateModule) GetKeysPaged(_ *http.Request, req *GetKeysPagedRequest, res *[]string) error {
+	var hash common.Hash
+
+	if req.Hash == nil {
+		hash = cs.blockAPI.BestBlockHash()
+	} else {
+		hash = *req.Hash
+	}
+
+	childStorageKey, err := common.HexToBytes(req.ChildStorageKey)
+	if err != nil {
+		return err
+	}
+
+	if req.Prefix == "" {
+		req.Prefix = "0x"
+	}
+	prefix, err := common.HexToBytes(req.Prefix)
+	if err != nil {
+		return err
+	}
+
+	stateRoot, err := cs.storageAPI.GetStateRootFromBlock(&hash)
+	if err != nil {
+		return err
+	}
+
+	trie, err := cs.storageAPI.GetStorageChild(stateRoot, childStorageKey)
+	if err != nil {
+		return err
+	}
+
+	hexKeys := make([]string, 0, req.Qty)
+	for _, k := range trie.GetKeysWithPrefix(prefix) {
+		if uint32(len(hexKeys)) >= req.Qty {
+			break
+		}
+
+		hexKey := common.BytesToHex(k)
+		// keys are sorted in lexicographical order, so we know that keys
+		// where strings.Compare = 1 are after the requested after key.
+		if strings.Compare(hexKey, req.AfterKey) == 1 {
+			hexKeys = append(hexKeys, hexKey)
+		}
+	}
+
+	*res = hexKeys
+	return nil
+}
+
 // GetStorageSize returns the size of a child storage entry.
 func (cs *ChildStateModule) GetStorageSize(_ *http.Request, req *GetChildStorageRequest, res *uint64) error {
 	var hash common.Hash
@@ -166,3 +234,12 @@ func (cs *ChildStateModule) GetStorage(
 
 	return nil
 }
+
+// SubscribeStorage Child storage subscription. It creates a message for each block which
+// changes the specified keys of the child storage.
+// This endpoint communicates over the Websocket protocol, but this func should remain here so it's
+// added to rpc_methods list
+func (*ChildStateModule) SubscribeStorage(
+	_ *http.Request, _ *ChildStateStorageSubscribeRequest, _ *StorageChangeSetResponse) error {
+	return nil
+}
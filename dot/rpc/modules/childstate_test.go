@@ -12,6 +12,7 @@
 	"github.com/ChainSafe/gossamer/lib/common"
 	rtstorage "github.com/ChainSafe/gossamer/lib/runtime/storage"
 	"github.com/ChainSafe/gossamer/pkg/trie"
+	"github.com/ChainSafe/gossamer/pkg/trie/inmemory"
 	"go.uber.org/mock/gomock"
 
 	"github.com/stretchr/testify/assert"
@@ -156,6 +157,95 @@ type args struct {
 	}
 }
 
+func TestChildStateModule_GetKeysPaged(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	childTrie := inmemory.NewEmptyTrie()
+	for _, key := range []string{":child_first", ":child_second", ":another_child"} {
+		err := childTrie.Put([]byte(key), []byte("value"))
+		require.NoError(t, err)
+	}
+
+	hash := common.MustHexToHash("0x3aa96b0149b6ca3688878bdbd19464448624136398e3ce45b9e755d3ab61355a")
+	stateRoot := common.Hash{1}
+	childStorageKey := []byte(":child_storage_key")
+
+	mockBlockAPI := apimocks.NewMockBlockAPI(ctrl)
+	mockBlockAPI.EXPECT().BestBlockHash().Return(hash)
+
+	mockStorageAPI := apimocks.NewMockStorageAPI(ctrl)
+	mockStorageAPI.EXPECT().GetStateRootFromBlock(&hash).Return(&stateRoot, nil).Times(3)
+	mockStorageAPI.EXPECT().GetStorageChild(&stateRoot, childStorageKey).Return(childTrie, nil).Times(3)
+
+	mockErrorStorageAPI := apimocks.NewMockStorageAPI(ctrl)
+	mockErrorStorageAPI.EXPECT().GetStateRootFromBlock(&hash).Return(nil, errors.New("GetStateRootFromBlock error"))
+
+	tests := map[string]struct {
+		storageAPI StorageAPI
+		req        *GetKeysPagedRequest
+		expErr     error
+		exp        []string
+	}{
+		"nil_hash_first_page": {
+			storageAPI: mockStorageAPI,
+			req: &GetKeysPagedRequest{
+				ChildStorageKey: common.BytesToHex(childStorageKey),
+				Prefix:          common.BytesToHex([]byte(":child")),
+				Qty:             1,
+			},
+			exp: []string{common.BytesToHex([]byte(":child_first"))},
+		},
+		"after_key": {
+			storageAPI: mockStorageAPI,
+			req: &GetKeysPagedRequest{
+				ChildStorageKey: common.BytesToHex(childStorageKey),
+				Prefix:          common.BytesToHex([]byte(":child")),
+				Qty:             10,
+				AfterKey:        common.BytesToHex([]byte(":child_first")),
+				Hash:            &hash,
+			},
+			exp: []string{common.BytesToHex([]byte(":child_second"))},
+		},
+		"empty_prefix": {
+			storageAPI: mockStorageAPI,
+			req: &GetKeysPagedRequest{
+				ChildStorageKey: common.BytesToHex(childStorageKey),
+				Qty:             2,
+				Hash:            &hash,
+			},
+			exp: []string{
+				common.BytesToHex([]byte(":another_child")),
+				common.BytesToHex([]byte(":child_first")),
+			},
+		},
+		"GetStateRootFromBlock_error": {
+			storageAPI: mockErrorStorageAPI,
+			req: &GetKeysPagedRequest{
+				ChildStorageKey: common.BytesToHex(childStorageKey),
+				Qty:             1,
+				Hash:            &hash,
+			},
+			expErr: errors.New("GetStateRootFromBlock error"),
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			cs := &ChildStateModule{
+				storageAPI: tt.storageAPI,
+				blockAPI:   mockBlockAPI,
+			}
+			var res []string
+			err := cs.GetKeysPaged(nil, tt.req, &res)
+			if tt.expErr != nil {
+				assert.EqualError(t, err, tt.expErr.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.exp, res)
+		})
+	}
+}
+
 func TestChildStateModule_GetStorageSize(t *testing.T) {
 	ctrl := gomock.NewController(t)
 
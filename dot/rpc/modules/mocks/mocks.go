@@ -1,9 +1,9 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: github.com/ChainSafe/gossamer/dot/rpc/modules (interfaces: StorageAPI,BlockAPI,NetworkAPI,BlockProducerAPI,TransactionStateAPI,CoreAPI,SystemAPI,BlockFinalityAPI,RuntimeStorageAPI,SyncStateAPI)
+// Source: github.com/ChainSafe/gossamer/dot/rpc/modules (interfaces: StorageAPI,BlockAPI,NetworkAPI,BlockProducerAPI,TransactionStateAPI,CoreAPI,SystemAPI,BlockFinalityAPI,BabeAPI,BeefyAPI,RuntimeStorageAPI,SyncStateAPI)
 //
 // Generated by this command:
 //
-//	mockgen -destination=mocks/mocks.go -package mocks . StorageAPI,BlockAPI,NetworkAPI,BlockProducerAPI,TransactionStateAPI,CoreAPI,SystemAPI,BlockFinalityAPI,RuntimeStorageAPI,SyncStateAPI
+//	mockgen -destination=mocks/mocks.go -package mocks . StorageAPI,BlockAPI,NetworkAPI,BlockProducerAPI,TransactionStateAPI,CoreAPI,SystemAPI,BlockFinalityAPI,BabeAPI,BeefyAPI,RuntimeStorageAPI,SyncStateAPI
 //
 
 // Package mocks is a generated GoMock package.
@@ -11,6 +11,7 @@
 
 import (
 	reflect "reflect"
+	time "time"
 
 	core "github.com/ChainSafe/gossamer/dot/core"
 	state "github.com/ChainSafe/gossamer/dot/state"
@@ -470,6 +471,34 @@ func (mr *MockNetworkAPIMockRecorder) AddReservedPeers(arg0 ...any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddReservedPeers", reflect.TypeOf((*MockNetworkAPI)(nil).AddReservedPeers), arg0...)
 }
 
+// BanPeer mocks base method.
+func (m *MockNetworkAPI) BanPeer(arg0 string, arg1 time.Duration) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BanPeer", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// BanPeer indicates an expected call of BanPeer.
+func (mr *MockNetworkAPIMockRecorder) BanPeer(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BanPeer", reflect.TypeOf((*MockNetworkAPI)(nil).BanPeer), arg0, arg1)
+}
+
+// BannedPeers mocks base method.
+func (m *MockNetworkAPI) BannedPeers() []common.BannedPeerInfo {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BannedPeers")
+	ret0, _ := ret[0].([]common.BannedPeerInfo)
+	return ret0
+}
+
+// BannedPeers indicates an expected call of BannedPeers.
+func (mr *MockNetworkAPIMockRecorder) BannedPeers() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BannedPeers", reflect.TypeOf((*MockNetworkAPI)(nil).BannedPeers))
+}
+
 // Health mocks base method.
 func (m *MockNetworkAPI) Health() common.Health {
 	m.ctrl.T.Helper()
@@ -544,6 +573,32 @@ func (mr *MockNetworkAPIMockRecorder) RemoveReservedPeers(arg0 ...any) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveReservedPeers", reflect.TypeOf((*MockNetworkAPI)(nil).RemoveReservedPeers), arg0...)
 }
 
+// ReservedPeers mocks base method.
+func (m *MockNetworkAPI) ReservedPeers() []string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReservedPeers")
+	ret0, _ := ret[0].([]string)
+	return ret0
+}
+
+// ReservedPeers indicates an expected call of ReservedPeers.
+func (mr *MockNetworkAPIMockRecorder) ReservedPeers() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReservedPeers", reflect.TypeOf((*MockNetworkAPI)(nil).ReservedPeers))
+}
+
+// SetReservedOnly mocks base method.
+func (m *MockNetworkAPI) SetReservedOnly(arg0 bool) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetReservedOnly", arg0)
+}
+
+// SetReservedOnly indicates an expected call of SetReservedOnly.
+func (mr *MockNetworkAPIMockRecorder) SetReservedOnly(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetReservedOnly", reflect.TypeOf((*MockNetworkAPI)(nil).SetReservedOnly), arg0)
+}
+
 // Start mocks base method.
 func (m *MockNetworkAPI) Start() error {
 	m.ctrl.T.Helper()
@@ -586,6 +641,20 @@ func (mr *MockNetworkAPIMockRecorder) Stop() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Stop", reflect.TypeOf((*MockNetworkAPI)(nil).Stop))
 }
 
+// UnbanPeer mocks base method.
+func (m *MockNetworkAPI) UnbanPeer(arg0 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnbanPeer", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UnbanPeer indicates an expected call of UnbanPeer.
+func (mr *MockNetworkAPIMockRecorder) UnbanPeer(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnbanPeer", reflect.TypeOf((*MockNetworkAPI)(nil).UnbanPeer), arg0)
+}
+
 // MockBlockProducerAPI is a mock of BlockProducerAPI interface.
 type MockBlockProducerAPI struct {
 	ctrl     *gomock.Controller
@@ -725,6 +794,21 @@ func (m *MockCoreAPI) EXPECT() *MockCoreAPIMockRecorder {
 	return m.recorder
 }
 
+// AccountNonce mocks base method.
+func (m *MockCoreAPI) AccountNonce(arg0 []byte, arg1 *common.Hash) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AccountNonce", arg0, arg1)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AccountNonce indicates an expected call of AccountNonce.
+func (mr *MockCoreAPIMockRecorder) AccountNonce(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AccountNonce", reflect.TypeOf((*MockCoreAPI)(nil).AccountNonce), arg0, arg1)
+}
+
 // DecodeSessionKeys mocks base method.
 func (m *MockCoreAPI) DecodeSessionKeys(arg0 []byte) ([]byte, error) {
 	m.ctrl.T.Helper()
@@ -740,6 +824,37 @@ func (mr *MockCoreAPIMockRecorder) DecodeSessionKeys(arg0 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DecodeSessionKeys", reflect.TypeOf((*MockCoreAPI)(nil).DecodeSessionKeys), arg0)
 }
 
+// DryRun mocks base method.
+func (m *MockCoreAPI) DryRun(arg0 types.Extrinsic, arg1 *common.Hash) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DryRun", arg0, arg1)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DryRun indicates an expected call of DryRun.
+func (mr *MockCoreAPIMockRecorder) DryRun(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DryRun", reflect.TypeOf((*MockCoreAPI)(nil).DryRun), arg0, arg1)
+}
+
+// GetChildReadProofAt mocks base method.
+func (m *MockCoreAPI) GetChildReadProofAt(arg0 common.Hash, arg1 []byte, arg2 [][]byte) (common.Hash, [][]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetChildReadProofAt", arg0, arg1, arg2)
+	ret0, _ := ret[0].(common.Hash)
+	ret1, _ := ret[1].([][]byte)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetChildReadProofAt indicates an expected call of GetChildReadProofAt.
+func (mr *MockCoreAPIMockRecorder) GetChildReadProofAt(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetChildReadProofAt", reflect.TypeOf((*MockCoreAPI)(nil).GetChildReadProofAt), arg0, arg1, arg2)
+}
+
 // GetMetadata mocks base method.
 func (m *MockCoreAPI) GetMetadata(arg0 *common.Hash) ([]byte, error) {
 	m.ctrl.T.Helper()
@@ -1015,6 +1130,108 @@ func (mr *MockBlockFinalityAPIMockRecorder) PreVotes() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PreVotes", reflect.TypeOf((*MockBlockFinalityAPI)(nil).PreVotes))
 }
 
+// MockBabeAPI is a mock of BabeAPI interface.
+type MockBabeAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockBabeAPIMockRecorder
+}
+
+// MockBabeAPIMockRecorder is the mock recorder for MockBabeAPI.
+type MockBabeAPIMockRecorder struct {
+	mock *MockBabeAPI
+}
+
+// NewMockBabeAPI creates a new mock instance.
+func NewMockBabeAPI(ctrl *gomock.Controller) *MockBabeAPI {
+	mock := &MockBabeAPI{ctrl: ctrl}
+	mock.recorder = &MockBabeAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBabeAPI) EXPECT() *MockBabeAPIMockRecorder {
+	return m.recorder
+}
+
+// EquivocationProofs mocks base method.
+func (m *MockBabeAPI) EquivocationProofs() ([]types.BabeEquivocationProof, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EquivocationProofs")
+	ret0, _ := ret[0].([]types.BabeEquivocationProof)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EquivocationProofs indicates an expected call of EquivocationProofs.
+func (mr *MockBabeAPIMockRecorder) EquivocationProofs() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EquivocationProofs", reflect.TypeOf((*MockBabeAPI)(nil).EquivocationProofs))
+}
+
+// MockBeefyAPI is a mock of BeefyAPI interface.
+type MockBeefyAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockBeefyAPIMockRecorder
+}
+
+// MockBeefyAPIMockRecorder is the mock recorder for MockBeefyAPI.
+type MockBeefyAPIMockRecorder struct {
+	mock *MockBeefyAPI
+}
+
+// NewMockBeefyAPI creates a new mock instance.
+func NewMockBeefyAPI(ctrl *gomock.Controller) *MockBeefyAPI {
+	mock := &MockBeefyAPI{ctrl: ctrl}
+	mock.recorder = &MockBeefyAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBeefyAPI) EXPECT() *MockBeefyAPIMockRecorder {
+	return m.recorder
+}
+
+// BestBeefyBlockHash mocks base method.
+func (m *MockBeefyAPI) BestBeefyBlockHash() (common.Hash, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BestBeefyBlockHash")
+	ret0, _ := ret[0].(common.Hash)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BestBeefyBlockHash indicates an expected call of BestBeefyBlockHash.
+func (mr *MockBeefyAPIMockRecorder) BestBeefyBlockHash() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BestBeefyBlockHash", reflect.TypeOf((*MockBeefyAPI)(nil).BestBeefyBlockHash))
+}
+
+// FreeJustificationsNotifierChannel mocks base method.
+func (m *MockBeefyAPI) FreeJustificationsNotifierChannel(arg0 chan []byte) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "FreeJustificationsNotifierChannel", arg0)
+}
+
+// FreeJustificationsNotifierChannel indicates an expected call of FreeJustificationsNotifierChannel.
+func (mr *MockBeefyAPIMockRecorder) FreeJustificationsNotifierChannel(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FreeJustificationsNotifierChannel", reflect.TypeOf((*MockBeefyAPI)(nil).FreeJustificationsNotifierChannel), arg0)
+}
+
+// GetJustificationsNotifierChannel mocks base method.
+func (m *MockBeefyAPI) GetJustificationsNotifierChannel() chan []byte {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetJustificationsNotifierChannel")
+	ret0, _ := ret[0].(chan []byte)
+	return ret0
+}
+
+// GetJustificationsNotifierChannel indicates an expected call of GetJustificationsNotifierChannel.
+func (mr *MockBeefyAPIMockRecorder) GetJustificationsNotifierChannel() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetJustificationsNotifierChannel", reflect.TypeOf((*MockBeefyAPI)(nil).GetJustificationsNotifierChannel))
+}
+
 // MockRuntimeStorageAPI is a mock of RuntimeStorageAPI interface.
 type MockRuntimeStorageAPI struct {
 	ctrl     *gomock.Controller
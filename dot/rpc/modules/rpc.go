@@ -12,6 +12,10 @@
 	UnsafeMethods = []string{
 		"system_addReservedPeer",
 		"system_removeReservedPeer",
+		"system_banPeer",
+		"system_unbanPeer",
+		"system_setReservedOnly",
+		"system_dryRun",
 		"author_submitExtrinsic",
 		"author_removeExtrinsic",
 		"author_insertKey",
@@ -19,6 +23,7 @@
 		"state_getPairs",
 		"state_getKeysPaged",
 		"state_queryStorage",
+		"dev_control",
 	}
 
 	// AliasesMethods is a map that links the original methods to their aliases
@@ -26,6 +31,7 @@
 		"chain_getHead":          "chain_getBlockHash",
 		"account_nextIndex":      "system_accountNextIndex",
 		"chain_getFinalisedHead": "chain_getFinalizedHead",
+		"system_dryRunAt":        "system_dryRun",
 	}
 )
 
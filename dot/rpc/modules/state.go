@@ -20,6 +20,13 @@ type StateGetReadProofRequest struct {
 	Hash common.Hash
 }
 
+// StateGetChildReadProofRequest json fields
+type StateGetChildReadProofRequest struct {
+	ChildStorageKey string   `json:"childStorageKey"`
+	Keys            []string `json:"keys"`
+	Hash            common.Hash
+}
+
 // StateCallRequest holds json fields
 type StateCallRequest struct {
 	Method string       `json:"method"`
@@ -346,6 +353,43 @@ func (sm *StateModule) GetReadProof(
 	return nil
 }
 
+// GetChildReadProof returns the proof to the received storage keys of the child trie
+// stored at the child storage key given
+func (sm *StateModule) GetChildReadProof(
+	_ *http.Request, req *StateGetChildReadProofRequest, res *StateGetReadProofResponse) error {
+	childStorageKey, err := common.HexToBytes(req.ChildStorageKey)
+	if err != nil {
+		return err
+	}
+
+	keys := make([][]byte, len(req.Keys))
+	for i, hexKey := range req.Keys {
+		bKey, err := common.HexToBytes(hexKey)
+		if err != nil {
+			return err
+		}
+
+		keys[i] = bKey
+	}
+
+	block, proofs, err := sm.coreAPI.GetChildReadProofAt(req.Hash, childStorageKey, keys)
+	if err != nil {
+		return err
+	}
+
+	decProof := make([]string, len(proofs))
+	for i, p := range proofs {
+		decProof[i] = common.BytesToHex(p)
+	}
+
+	*res = StateGetReadProofResponse{
+		At:    block,
+		Proof: decProof,
+	}
+
+	return nil
+}
+
 // GetRuntimeVersion Get the runtime version at a given block.
 // If no block hash is provided, the latest version gets returned.
 func (sm *StateModule) GetRuntimeVersion(
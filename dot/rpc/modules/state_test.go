@@ -470,6 +470,75 @@ type args struct {
 	}
 }
 
+func TestStateModuleGetChildReadProof(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	hash := common.MustHexToHash("0x3aa96b0149b6ca3688878bdbd19464448624136398e3ce45b9e755d3ab61355a")
+	childStorageKey := []byte(":child_storage_key")
+	keys := []string{"0x1111", "0x2222"}
+	expKeys := [][]byte{{0x11, 0x11}, {0x22, 0x22}}
+
+	mockCoreAPI := mocks.NewMockCoreAPI(ctrl)
+	mockCoreAPI.EXPECT().GetChildReadProofAt(hash, childStorageKey, expKeys).
+		Return(hash, [][]byte{{1, 1, 1}, {2, 2, 2}}, nil)
+
+	mockCoreAPIErr := mocks.NewMockCoreAPI(ctrl)
+	mockCoreAPIErr.EXPECT().GetChildReadProofAt(hash, childStorageKey, expKeys).
+		Return(common.Hash{}, nil, errors.New("GetChildReadProofAt Error"))
+
+	tests := map[string]struct {
+		coreAPI CoreAPI
+		req     *StateGetChildReadProofRequest
+		expErr  error
+		exp     StateGetReadProofResponse
+	}{
+		"OK Case": {
+			coreAPI: mockCoreAPI,
+			req: &StateGetChildReadProofRequest{
+				ChildStorageKey: common.BytesToHex(childStorageKey),
+				Keys:            keys,
+				Hash:            hash,
+			},
+			exp: StateGetReadProofResponse{
+				At:    hash,
+				Proof: []string{"0x010101", "0x020202"},
+			},
+		},
+		"GetChildReadProofAt Error": {
+			coreAPI: mockCoreAPIErr,
+			req: &StateGetChildReadProofRequest{
+				ChildStorageKey: common.BytesToHex(childStorageKey),
+				Keys:            keys,
+				Hash:            hash,
+			},
+			expErr: errors.New("GetChildReadProofAt Error"),
+		},
+		"Invalid child storage key": {
+			req: &StateGetChildReadProofRequest{
+				ChildStorageKey: "not hex",
+				Keys:            keys,
+				Hash:            hash,
+			},
+			expErr: errors.New("could not byteify non 0x prefixed string: not hex"),
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			sm := &StateModule{
+				coreAPI: tt.coreAPI,
+			}
+			res := StateGetReadProofResponse{}
+			err := sm.GetChildReadProof(nil, tt.req, &res)
+			if tt.expErr != nil {
+				assert.EqualError(t, err, tt.expErr.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.exp, res)
+		})
+	}
+}
+
 func TestStateModuleGetRuntimeVersion(t *testing.T) {
 	ctrl := gomock.NewController(t)
 
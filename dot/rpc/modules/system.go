@@ -9,9 +9,12 @@
 	"math/big"
 	"net/http"
 	"strings"
+	"time"
 
+	"github.com/ChainSafe/gossamer/dot/types"
 	"github.com/ChainSafe/gossamer/lib/common"
 	"github.com/ChainSafe/gossamer/lib/crypto"
+	"github.com/ChainSafe/gossamer/lib/runtime"
 	"github.com/ChainSafe/gossamer/pkg/scale"
 	"github.com/btcsuite/btcutil/base58"
 	ctypes "github.com/centrifuge/go-substrate-rpc-client/v4/types"
@@ -42,6 +45,7 @@ type EmptyRequest struct{
 type NetworkStateString struct {
 	PeerID     string
 	Multiaddrs []string
+	Bandwidth  []common.ProtocolBandwidth `json:"bandwidth,omitempty"`
 }
 
 // SystemNetworkStateResponse struct to marshal json
@@ -60,6 +64,30 @@ type StringRequest struct {
 	String string
 }
 
+// DryRunRequest holds the fields of the system_dryRun request
+type DryRunRequest struct {
+	Extrinsic string       `json:"extrinsic"`
+	At        *common.Hash `json:"at"`
+}
+
+// BanPeerRequest holds the fields of the system_banPeer request
+type BanPeerRequest struct {
+	PeerID string `json:"peerId"`
+	// Duration is the ban duration in seconds, the configured ban duration is used if it is zero.
+	Duration uint64 `json:"duration"`
+}
+
+// ReservedOnlyRequest holds the fields of the system_setReservedOnly request
+type ReservedOnlyRequest struct {
+	ReservedOnly bool `json:"reservedOnly"`
+}
+
+// BannedPeerResponse holds a banned peer and the unix time in seconds at which its ban expires
+type BannedPeerResponse struct {
+	PeerID string `json:"peerId"`
+	Until  int64  `json:"until"`
+}
+
 // SyncStateResponse is the struct to return on the system_syncState rpc call
 type SyncStateResponse struct {
 	CurrentBlock  uint32 `json:"currentBlock"`
@@ -126,6 +154,7 @@ func (sm *SystemModule) NetworkState(r *http.Request, req *EmptyRequest, res *Sy
 	for _, v := range networkState.Multiaddrs {
 		res.NetworkState.Multiaddrs = append(res.NetworkState.Multiaddrs, v.String())
 	}
+	res.NetworkState.Bandwidth = networkState.Bandwidth
 	return nil
 }
 
@@ -192,7 +221,17 @@ func (sm *SystemModule) AccountNextIndex(r *http.Request, req *StringRequest, re
 		return nil
 	}
 
-	// no extrinsic signed by request found in pending transactions, so look in storage
+	// no extrinsic signed by request found in pending transactions, so ask the runtime
+	// if it exposes the AccountNonceApi, otherwise look in storage
+	accountNonce, err := sm.coreAPI.AccountNonce(addressPubKey, nil)
+	if err == nil {
+		*res = U64Response(accountNonce)
+		return nil
+	}
+	if !errors.Is(err, runtime.ErrAPINotFound) {
+		return err
+	}
+
 	// get metadata to build storage storageKey
 	rawMeta, err := sm.coreAPI.GetMetadata(nil)
 	if err != nil {
@@ -289,3 +328,65 @@ func (sm *SystemModule) RemoveReservedPeer(r *http.Request, req *StringRequest,
 
 	return sm.networkAPI.RemoveReservedPeers(req.String)
 }
+
+// ReservedPeers returns the ids of the reserved peers.
+func (sm *SystemModule) ReservedPeers(r *http.Request, req *EmptyRequest, res *[]string) error {
+	*res = sm.networkAPI.ReservedPeers()
+	return nil
+}
+
+// SetReservedOnly switches the reserved-only mode of the node: when enabled, the peers which
+// are not reserved are disconnected and only the reserved peers are connected to.
+func (sm *SystemModule) SetReservedOnly(r *http.Request, req *ReservedOnlyRequest, res *[]byte) error {
+	sm.networkAPI.SetReservedOnly(req.ReservedOnly)
+	return nil
+}
+
+// BanPeer bans a peer, dropping its connections and rejecting them until the ban expires.
+func (sm *SystemModule) BanPeer(r *http.Request, req *BanPeerRequest, res *[]byte) error {
+	if strings.TrimSpace(req.PeerID) == "" {
+		return errors.New("cannot ban an empty peer")
+	}
+
+	duration := time.Duration(req.Duration) * time.Second //nolint:gosec
+	return sm.networkAPI.BanPeer(req.PeerID, duration)
+}
+
+// UnbanPeer lifts the ban of a peer. The string should encode only the PeerId
+func (sm *SystemModule) UnbanPeer(r *http.Request, req *StringRequest, res *[]byte) error {
+	if strings.TrimSpace(req.String) == "" {
+		return errors.New("cannot unban an empty peer")
+	}
+
+	return sm.networkAPI.UnbanPeer(req.String)
+}
+
+// BannedPeers returns the peers currently banned and the expiry time of their ban.
+func (sm *SystemModule) BannedPeers(r *http.Request, req *EmptyRequest, res *[]BannedPeerResponse) error {
+	banned := sm.networkAPI.BannedPeers()
+	*res = make([]BannedPeerResponse, len(banned))
+	for i, bannedPeer := range banned {
+		(*res)[i] = BannedPeerResponse{
+			PeerID: bannedPeer.PeerID,
+			Until:  bannedPeer.Until.Unix(),
+		}
+	}
+	return nil
+}
+
+// DryRun applies the extrinsic on top of the state of the given block, or of the best block
+// if no block is given, without importing it and returns the hex encoded SCALE ApplyExtrinsicResult.
+func (sm *SystemModule) DryRun(r *http.Request, req *DryRunRequest, res *string) error {
+	extBytes, err := common.HexToBytes(req.Extrinsic)
+	if err != nil {
+		return err
+	}
+
+	applyExtrinsicResult, err := sm.coreAPI.DryRun(types.Extrinsic(extBytes), req.At)
+	if err != nil {
+		return err
+	}
+
+	*res = common.BytesToHex(applyExtrinsicResult)
+	return nil
+}
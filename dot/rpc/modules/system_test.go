@@ -5,13 +5,16 @@
 
 import (
 	"errors"
+	"fmt"
 	"net/http"
 	"testing"
+	"time"
 
 	"github.com/ChainSafe/gossamer/dot/rpc/modules/mocks"
 	testdata "github.com/ChainSafe/gossamer/dot/rpc/modules/test_data"
 	"github.com/ChainSafe/gossamer/dot/types"
 	"github.com/ChainSafe/gossamer/lib/common"
+	"github.com/ChainSafe/gossamer/lib/runtime"
 	"github.com/ChainSafe/gossamer/lib/transaction"
 	"github.com/multiformats/go-multiaddr"
 	"go.uber.org/mock/gomock"
@@ -137,6 +140,40 @@ func TestSystemModule_NetworkStateTest(t *testing.T) {
 	require.Equal(t, SystemNetworkStateResponse{}, networkStateRes)
 }
 
+func TestSystemModule_NetworkStateBandwidth(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	bandwidth := []common.ProtocolBandwidth{{
+		Protocol:    "block-announces",
+		Role:        "full",
+		BytesIn:     100,
+		BytesOut:    50,
+		MessagesIn:  2,
+		MessagesOut: 1,
+	}}
+
+	mockNetworkAPI := mocks.NewMockNetworkAPI(ctrl)
+	mockNetworkAPI.EXPECT().NetworkState().Return(common.NetworkState{
+		PeerID:    "alice",
+		Bandwidth: bandwidth,
+	})
+	sm := &SystemModule{
+		networkAPI: mockNetworkAPI,
+	}
+
+	var networkStateRes SystemNetworkStateResponse
+	err := sm.NetworkState(nil, &EmptyRequest{}, &networkStateRes)
+	require.NoError(t, err)
+
+	expected := SystemNetworkStateResponse{
+		NetworkState: NetworkStateString{
+			PeerID:    "alice",
+			Bandwidth: bandwidth,
+		},
+	}
+	require.Equal(t, expected, networkStateRes)
+}
+
 func TestSystemModule_PeersTest(t *testing.T) {
 	ctrl := gomock.NewController(t)
 
@@ -242,20 +279,36 @@ func TestSystemModule_AccountNextIndex(t *testing.T) {
 	}
 
 	mockTxStateAPI := mocks.NewMockTransactionStateAPI(ctrl)
-	mockTxStateAPI.EXPECT().Pending().Return(v).Times(5)
+	mockTxStateAPI.EXPECT().Pending().Return(v).Times(7)
+
+	accountID := common.MustHexToBytes("0xa7f9580382edb384b1b43cbcf3d1b1e7f1a1d232cf4139bd48eaafb9656da27d")
+	errNoAccountNonceAPI := fmt.Errorf("%w: %s", runtime.ErrAPINotFound, runtime.AccountNonceAPI)
 
 	mockCoreAPI := mocks.NewMockCoreAPI(ctrl)
+	mockCoreAPI.EXPECT().AccountNonce(gomock.Any(), (*common.Hash)(nil)).
+		Return(uint64(0), errNoAccountNonceAPI).Times(2)
 	mockCoreAPI.EXPECT().GetMetadata((*common.Hash)(nil)).
 		Return(common.MustHexToBytes(testdata.NewTestMetadata()), nil).Times(2)
 
 	mockCoreAPIErr := mocks.NewMockCoreAPI(ctrl)
+	mockCoreAPIErr.EXPECT().AccountNonce(gomock.Any(), (*common.Hash)(nil)).
+		Return(uint64(0), errNoAccountNonceAPI)
 	mockCoreAPIErr.EXPECT().GetMetadata((*common.Hash)(nil)).
 		Return(nil, errors.New("getMetadata error"))
 
 	// Magic number mismatch
 	mockCoreAPIMagicNumMismatch := mocks.NewMockCoreAPI(ctrl)
+	mockCoreAPIMagicNumMismatch.EXPECT().AccountNonce(gomock.Any(), (*common.Hash)(nil)).
+		Return(uint64(0), errNoAccountNonceAPI)
 	mockCoreAPIMagicNumMismatch.EXPECT().GetMetadata((*common.Hash)(nil)).Return(storageKeyHex, nil)
 
+	mockCoreAPIAccountNonce := mocks.NewMockCoreAPI(ctrl)
+	mockCoreAPIAccountNonce.EXPECT().AccountNonce(accountID, (*common.Hash)(nil)).Return(uint64(7), nil)
+
+	mockCoreAPIAccountNonceErr := mocks.NewMockCoreAPI(ctrl)
+	mockCoreAPIAccountNonceErr.EXPECT().AccountNonce(gomock.Any(), (*common.Hash)(nil)).
+		Return(uint64(0), errors.New("account nonce error"))
+
 	mockStorageAPI := mocks.NewMockStorageAPI(ctrl)
 	mockStorageAPI.EXPECT().GetStorage((*common.Hash)(nil), storageKeyHex).
 		Return(common.MustHexToBytes("0x0300000000000000000000000000000000000000000000000000000000000000000000"+
@@ -297,6 +350,22 @@ type args struct {
 			},
 			exp: U64Response(3),
 		},
+		{
+			name:      "account_nonce_runtime_api",
+			sysModule: NewSystemModule(nil, nil, mockCoreAPIAccountNonce, nil, mockTxStateAPI, nil, nil),
+			args: args{
+				req: &StringRequest{String: "5FrwvaEF5zXb26Fz9rcQpDWS57CtERHpNehXCPcNoHGKutQY"},
+			},
+			exp: U64Response(7),
+		},
+		{
+			name:      "account_nonce_runtime_api_error",
+			sysModule: NewSystemModule(nil, nil, mockCoreAPIAccountNonceErr, nil, mockTxStateAPI, nil, nil),
+			args: args{
+				req: &StringRequest{String: "5FrwvaEF5zXb26Fz9rcQpDWS57CtERHpNehXCPcNoHGKutQY"},
+			},
+			expErr: errors.New("account nonce error"),
+		},
 		{
 			name:      "GetMetadata Err",
 			sysModule: NewSystemModule(nil, nil, mockCoreAPIErr, mockStorageAPI, mockTxStateAPI, nil, nil),
@@ -644,3 +713,155 @@ type args struct {
 		})
 	}
 }
+
+func TestSystemModule_BanPeer(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	mockNetworkAPI := mocks.NewMockNetworkAPI(ctrl)
+	mockNetworkAPI.EXPECT().BanPeer("jimbo", 90*time.Second).Return(nil)
+
+	mockNetworkAPIErr := mocks.NewMockNetworkAPI(ctrl)
+	mockNetworkAPIErr.EXPECT().BanPeer("jimbo", time.Duration(0)).Return(errors.New("banPeer error"))
+
+	tests := []struct {
+		name      string
+		sysModule *SystemModule
+		req       *BanPeerRequest
+		expErr    error
+	}{
+		{
+			name:      "OK",
+			sysModule: NewSystemModule(mockNetworkAPI, nil, nil, nil, nil, nil, nil),
+			req:       &BanPeerRequest{PeerID: "jimbo", Duration: 90},
+		},
+		{
+			name:      "BanPeer Error",
+			sysModule: NewSystemModule(mockNetworkAPIErr, nil, nil, nil, nil, nil, nil),
+			req:       &BanPeerRequest{PeerID: "jimbo"},
+			expErr:    errors.New("banPeer error"),
+		},
+		{
+			name:      "Empty peer Error",
+			sysModule: NewSystemModule(mockNetworkAPI, nil, nil, nil, nil, nil, nil),
+			req:       &BanPeerRequest{},
+			expErr:    errors.New("cannot ban an empty peer"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res := []byte(nil)
+			err := tt.sysModule.BanPeer(nil, tt.req, &res)
+			if tt.expErr != nil {
+				assert.EqualError(t, err, tt.expErr.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestSystemModule_BannedPeers(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	until := time.Unix(1700000000, 0)
+	mockNetworkAPI := mocks.NewMockNetworkAPI(ctrl)
+	mockNetworkAPI.EXPECT().BannedPeers().Return([]common.BannedPeerInfo{
+		{PeerID: "jimbo", Until: until},
+	})
+
+	sm := NewSystemModule(mockNetworkAPI, nil, nil, nil, nil, nil, nil)
+
+	var res []BannedPeerResponse
+	err := sm.BannedPeers(nil, nil, &res)
+	require.NoError(t, err)
+	assert.Equal(t, []BannedPeerResponse{{PeerID: "jimbo", Until: 1700000000}}, res)
+}
+
+func TestSystemModule_ReservedPeers(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	mockNetworkAPI := mocks.NewMockNetworkAPI(ctrl)
+	mockNetworkAPI.EXPECT().ReservedPeers().Return([]string{"jimbo", "jimmy"})
+
+	sm := NewSystemModule(mockNetworkAPI, nil, nil, nil, nil, nil, nil)
+
+	var res []string
+	err := sm.ReservedPeers(nil, nil, &res)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"jimbo", "jimmy"}, res)
+}
+
+func TestSystemModule_SetReservedOnly(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	mockNetworkAPI := mocks.NewMockNetworkAPI(ctrl)
+	mockNetworkAPI.EXPECT().SetReservedOnly(true)
+
+	sm := NewSystemModule(mockNetworkAPI, nil, nil, nil, nil, nil, nil)
+
+	err := sm.SetReservedOnly(nil, &ReservedOnlyRequest{ReservedOnly: true}, nil)
+	require.NoError(t, err)
+}
+
+func TestSystemModule_DryRun(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	blockHash := common.Hash{1}
+	ext := types.Extrinsic{1, 2, 3}
+
+	mockCoreAPI := mocks.NewMockCoreAPI(ctrl)
+	mockCoreAPI.EXPECT().DryRun(ext, &blockHash).Return([]byte{0, 0}, nil)
+
+	mockCoreAPIErr := mocks.NewMockCoreAPI(ctrl)
+	mockCoreAPIErr.EXPECT().DryRun(ext, (*common.Hash)(nil)).Return(nil, errors.New("dry run error"))
+
+	type args struct {
+		r   *http.Request
+		req *DryRunRequest
+	}
+	tests := []struct {
+		name      string
+		sysModule *SystemModule
+		args      args
+		expErr    error
+		exp       string
+	}{
+		{
+			name:      "OK",
+			sysModule: NewSystemModule(nil, nil, mockCoreAPI, nil, nil, nil, nil),
+			args: args{
+				req: &DryRunRequest{Extrinsic: "0x010203", At: &blockHash},
+			},
+			exp: "0x0000",
+		},
+		{
+			name:      "DryRun Error",
+			sysModule: NewSystemModule(nil, nil, mockCoreAPIErr, nil, nil, nil, nil),
+			args: args{
+				req: &DryRunRequest{Extrinsic: "0x010203"},
+			},
+			expErr: errors.New("dry run error"),
+		},
+		{
+			name:      "Invalid extrinsic Error",
+			sysModule: NewSystemModule(nil, nil, mockCoreAPI, nil, nil, nil, nil),
+			args: args{
+				req: &DryRunRequest{Extrinsic: "010203"},
+			},
+			expErr: errors.New("could not byteify non 0x prefixed string: 010203"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sm := tt.sysModule
+			var res string
+			err := sm.DryRun(tt.args.r, tt.args.req, &res)
+			if tt.expErr != nil {
+				assert.EqualError(t, err, tt.expErr.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.exp, res)
+		})
+	}
+}
@@ -13,6 +13,7 @@
 
 // StorageAPI is the interface for the storage state
 type StorageAPI interface {
+	GetStorageFromChild(root *common.Hash, keyToChild, key []byte) ([]byte, error)
 	RegisterStorageObserver(observer state.Observer)
 	UnregisterStorageObserver(observer state.Observer)
 }
@@ -38,3 +39,9 @@ type CoreAPI interface {
 	GetRuntimeVersion(bhash *common.Hash) (runtime.Version, error)
 	HandleSubmittedExtrinsic(types.Extrinsic) error
 }
+
+// BeefyAPI is the interface to get and free BEEFY justifications notifier channels
+type BeefyAPI interface {
+	GetJustificationsNotifierChannel() chan []byte
+	FreeJustificationsNotifierChannel(ch chan []byte)
+}
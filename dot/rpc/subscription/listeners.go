@@ -16,16 +16,19 @@
 	"github.com/ChainSafe/gossamer/lib/common"
 	"github.com/ChainSafe/gossamer/lib/runtime"
 	"github.com/ChainSafe/gossamer/lib/transaction"
+	"github.com/ChainSafe/gossamer/pkg/trie"
 )
 
 const (
 	grandpaJustificationsMethod  = "grandpa_justifications"
+	beefyJustificationsMethod    = "beefy_justifications"
 	stateRuntimeVersionMethod    = "state_runtimeVersion"
 	authorExtrinsicUpdatesMethod = "author_extrinsicUpdate"
 	chainFinalizedHeadMethod     = "chain_finalizedHead"
 	chainNewHeadMethod           = "chain_newHead"
 	chainAllHeadMethod           = "chain_allHead"
 	stateStorageMethod           = "state_storage"
+	childStateStorageMethod      = "childstate_storage"
 )
 
 var (
@@ -102,6 +105,107 @@ func (s *StorageObserver) Stop() error {
 	return nil
 }
 
+// ChildStorageListener to handle listening for changes of child storage keys in imported blocks
+type ChildStorageListener struct {
+	Channel         chan *types.Block
+	wsconn          *WSConn
+	subID           uint32
+	childStorageKey []byte
+	// filter holds the last value sent for each hex encoded key of the child storage
+	filter        map[string][]byte
+	done          chan struct{}
+	cancel        chan struct{}
+	cancelTimeout time.Duration
+}
+
+// NewChildStorageListener constructor for creating ChildStorageListener
+func NewChildStorageListener(conn *WSConn, childStorageKey []byte, keys []string) *ChildStorageListener {
+	filter := make(map[string][]byte, len(keys))
+	for _, key := range keys {
+		filter[key] = nil
+	}
+
+	return &ChildStorageListener{
+		wsconn:          conn,
+		childStorageKey: childStorageKey,
+		filter:          filter,
+		cancel:          make(chan struct{}, 1),
+		cancelTimeout:   defaultCancelTimeout,
+		done:            make(chan struct{}, 1),
+	}
+}
+
+// Listen implementation of Listen interface to listen for child storage changes
+func (l *ChildStorageListener) Listen() {
+	go func() {
+		defer func() {
+			l.wsconn.BlockAPI.FreeImportedBlockNotifierChannel(l.Channel)
+			close(l.done)
+		}()
+
+		for {
+			select {
+			case <-l.cancel:
+				return
+			case block, ok := <-l.Channel:
+				if !ok {
+					return
+				}
+
+				if block == nil {
+					continue
+				}
+
+				changes, err := l.changes(block.Header.StateRoot)
+				if err != nil {
+					logger.Warnf("failed to get child storage changes: %s", err)
+					continue
+				}
+
+				if len(changes) == 0 {
+					continue
+				}
+
+				changeResult := ChangeResult{
+					Block:   block.Header.Hash().String(),
+					Changes: changes,
+				}
+				l.wsconn.safeSend(newSubscriptionResponse(childStateStorageMethod, l.subID, changeResult))
+			}
+		}
+	}()
+}
+
+// changes returns the child storage entries whose value differ from the last values sent
+// and updates the filter with the new values.
+func (l *ChildStorageListener) changes(stateRoot common.Hash) (changes []Change, err error) {
+	for hexKey, cachedValue := range l.filter {
+		key, err := common.HexToBytes(hexKey)
+		if err != nil {
+			return nil, fmt.Errorf("decoding key %s: %w", hexKey, err)
+		}
+
+		value, err := l.wsconn.StorageAPI.GetStorageFromChild(&stateRoot, l.childStorageKey, key)
+		if err != nil && !errors.Is(err, trie.ErrChildTrieDoesNotExist) {
+			return nil, fmt.Errorf("getting child storage value at key %s: %w", hexKey, err)
+		}
+
+		if reflect.DeepEqual(cachedValue, value) {
+			continue
+		}
+
+		l.filter[hexKey] = value
+		changes = append(changes, Change{hexKey, common.BytesToHex(value)})
+	}
+
+	return changes, nil
+}
+
+// Stop to cancel the running goroutines to this listener
+func (l *ChildStorageListener) Stop() error {
+	return cancelWithTimeout(l.cancel, l.done, l.cancelTimeout)
+}
+
 // BlockListener to handle listening for blocks importedChan
 type BlockListener struct {
 	Channel       chan *types.Block
@@ -481,6 +585,45 @@ func (g *GrandpaJustificationListener) Stop() error {
 	return cancelWithTimeout(g.cancel, g.done, g.cancelTimeout)
 }
 
+// BeefyJustificationListener struct has the justificationsCh and the context to stop the goroutines
+type BeefyJustificationListener struct {
+	cancel           chan struct{}
+	cancelTimeout    time.Duration
+	done             chan struct{}
+	wsconn           *WSConn
+	subID            uint32
+	justificationsCh chan []byte
+}
+
+// Listen will start goroutines that listen to the BEEFY justifications
+func (b *BeefyJustificationListener) Listen() {
+	go func() {
+		defer func() {
+			b.wsconn.BeefyAPI.FreeJustificationsNotifierChannel(b.justificationsCh)
+			close(b.done)
+		}()
+
+		for {
+			select {
+			case <-b.cancel:
+				return
+
+			case just, ok := <-b.justificationsCh:
+				if !ok {
+					return
+				}
+
+				b.wsconn.safeSend(newSubscriptionResponse(beefyJustificationsMethod, b.subID, common.BytesToHex(just)))
+			}
+		}
+	}()
+}
+
+// Stop will cancel all the goroutines that are executing
+func (b *BeefyJustificationListener) Stop() error {
+	return cancelWithTimeout(b.cancel, b.done, b.cancelTimeout)
+}
+
 func cancelWithTimeout(cancel, done chan struct{}, t time.Duration) error {
 	close(cancel)
 
@@ -294,6 +294,38 @@ func TestGrandpaJustification_Listen(t *testing.T) {
 	})
 }
 
+func TestBeefyJustification_Listen(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	wsconn, ws, cancel := setupWSConn(t)
+	defer cancel()
+
+	beefyAPIMock := mocks.NewMockBeefyAPI(ctrl)
+	beefyAPIMock.EXPECT().FreeJustificationsNotifierChannel(gomock.Any())
+	wsconn.BeefyAPI = beefyAPIMock
+
+	justificationsCh := make(chan []byte)
+	sub := BeefyJustificationListener{
+		subID:            10,
+		wsconn:           wsconn,
+		cancel:           make(chan struct{}, 1),
+		done:             make(chan struct{}, 1),
+		justificationsCh: justificationsCh,
+		cancelTimeout:    time.Second * 5,
+	}
+
+	sub.Listen()
+	justificationsCh <- []byte{1, 2, 3}
+
+	_, msg, err := ws.ReadMessage()
+	require.NoError(t, err)
+
+	expected := `{"jsonrpc":"2.0","method":"beefy_justifications","params":{"result":"0x010203","subscription":10}}` + "\n"
+	require.Equal(t, expected, string(msg))
+	require.NoError(t, sub.Stop())
+	wsconn.Wsconn.Close()
+}
+
 func TestRuntimeChannelListener_Listen(t *testing.T) {
 	ctrl := gomock.NewController(t)
 
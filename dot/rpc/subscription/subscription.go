@@ -19,6 +19,8 @@
 	stateSubscribeStorage          string = "state_subscribeStorage"
 	stateSubscribeRuntimeVersion   string = "state_subscribeRuntimeVersion"
 	grandpaSubscribeJustifications string = "grandpa_subscribeJustifications"
+	childStateSubscribeStorage     string = "childstate_subscribeStorage"
+	beefySubscribeJustifications   string = "beefy_subscribeJustifications"
 )
 
 type setupListener func(reqid float64, params interface{}) (Listener, error)
@@ -46,6 +48,10 @@ func (c *WSConn) getSetupListener(method string) setupListener {
 		return c.initRuntimeVersionListener
 	case grandpaSubscribeJustifications:
 		return c.initGrandpaJustificationListener
+	case childStateSubscribeStorage:
+		return c.initChildStorageListener
+	case beefySubscribeJustifications:
+		return c.initBeefyJustificationListener
 	default:
 		return nil
 	}
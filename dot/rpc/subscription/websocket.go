@@ -38,6 +38,7 @@ type httpclient interface {
 	errEmptyMethod             = errors.New("empty method")
 	errStorageNotSet           = errors.New("error StorageAPI not set")
 	errBlockAPINotSet          = errors.New("error BlockAPI not set")
+	errBeefyAPINotSet          = errors.New("error BeefyAPI not set")
 )
 
 var logger = log.NewFromGlobal(log.AddContext("pkg", "rpc/subscription"))
@@ -53,7 +54,9 @@ type WSConn struct {
 	BlockAPI      BlockAPI
 	CoreAPI       CoreAPI
 	TxStateAPI    TransactionStateAPI
+	BeefyAPI      BeefyAPI
 	RPCHost       string
+	Authorization string
 	HTTP          httpclient
 }
 
@@ -211,6 +214,67 @@ func (c *WSConn) initStorageChangeListener(reqID float64, params interface{}) (L
 	return stgobs, nil
 }
 
+func (c *WSConn) initChildStorageListener(reqID float64, params interface{}) (Listener, error) {
+	if c.StorageAPI == nil {
+		c.safeSendError(reqID, nil, errStorageNotSet.Error())
+		return nil, errStorageNotSet
+	}
+
+	if c.BlockAPI == nil {
+		c.safeSendError(reqID, nil, errBlockAPINotSet.Error())
+		return nil, errBlockAPINotSet
+	}
+
+	// expected params are: ["0x<child storage key>", ["0x<key>", ...]]
+	values, ok := params.([]interface{})
+	if !ok {
+		return nil, fmt.Errorf("%w: %T, expected type []interface{}", errUnexpectedType, params)
+	}
+
+	if len(values) != 2 {
+		return nil, fmt.Errorf("%w: expected 2 params, got: %d", errUnexpectedParamLen, len(values))
+	}
+
+	hexChildStorageKey, ok := values[0].(string)
+	if !ok {
+		return nil, fmt.Errorf("%w: %T, expected type string", errUnexpectedType, values[0])
+	}
+
+	childStorageKey, err := common.HexToBytes(hexChildStorageKey)
+	if err != nil {
+		return nil, err
+	}
+
+	interfaceKeys, ok := values[1].([]interface{})
+	if !ok {
+		return nil, fmt.Errorf("%w: %T, expected type []interface{}", errUnexpectedType, values[1])
+	}
+
+	keys := make([]string, len(interfaceKeys))
+	for i, interfaceKey := range interfaceKeys {
+		key, ok := interfaceKey.(string)
+		if !ok {
+			return nil, fmt.Errorf("%w: %T, expected type string", errUnexpectedType, interfaceKey)
+		}
+
+		keys[i] = key
+	}
+
+	csl := NewChildStorageListener(c, childStorageKey, keys)
+	csl.Channel = c.BlockAPI.GetImportedBlockNotifierChannel()
+
+	c.mu.Lock()
+
+	csl.subID = atomic.AddUint32(&c.qtyListeners, 1)
+	c.Subscriptions[csl.subID] = csl
+
+	c.mu.Unlock()
+
+	c.safeSend(NewSubscriptionResponseJSON(csl.subID, reqID))
+
+	return csl, nil
+}
+
 func (c *WSConn) initBlockListener(reqID float64, _ interface{}) (Listener, error) {
 	bl := NewBlockListener(c)
 
@@ -408,6 +472,33 @@ func (c *WSConn) initGrandpaJustificationListener(reqID float64, _ interface{})
 	return jl, nil
 }
 
+func (c *WSConn) initBeefyJustificationListener(reqID float64, _ interface{}) (Listener, error) {
+	if c.BeefyAPI == nil {
+		c.safeSendError(reqID, nil, errBeefyAPINotSet.Error())
+		return nil, errBeefyAPINotSet
+	}
+
+	jl := &BeefyJustificationListener{
+		cancel:        make(chan struct{}, 1),
+		done:          make(chan struct{}, 1),
+		wsconn:        c,
+		cancelTimeout: defaultCancelTimeout,
+	}
+
+	jl.justificationsCh = c.BeefyAPI.GetJustificationsNotifierChannel()
+
+	c.mu.Lock()
+
+	jl.subID = atomic.AddUint32(&c.qtyListeners, 1)
+	c.Subscriptions[jl.subID] = jl
+
+	c.mu.Unlock()
+
+	c.safeSend(NewSubscriptionResponseJSON(jl.subID, reqID))
+
+	return jl, nil
+}
+
 func (c *WSConn) safeSend(msg interface{}) {
 	c.mu.Lock()
 	defer c.mu.Unlock()
@@ -448,6 +539,9 @@ func (c *WSConn) prepareRequest(b []byte) (*http.Request, error) {
 	}
 
 	req.Header.Set("Content-Type", "application/json;")
+	if c.Authorization != "" {
+		req.Header.Set("Authorization", c.Authorization)
+	}
 	return req, nil
 }
 
@@ -24,10 +24,13 @@
 	"github.com/ChainSafe/gossamer/internal/log"
 	"github.com/ChainSafe/gossamer/internal/metrics"
 	"github.com/ChainSafe/gossamer/internal/pprof"
+	"github.com/ChainSafe/gossamer/lib/aura"
 	"github.com/ChainSafe/gossamer/lib/babe"
+	"github.com/ChainSafe/gossamer/lib/beefy"
 	"github.com/ChainSafe/gossamer/lib/common"
 	"github.com/ChainSafe/gossamer/lib/crypto"
 	"github.com/ChainSafe/gossamer/lib/crypto/ed25519"
+	"github.com/ChainSafe/gossamer/lib/crypto/secp256k1"
 	"github.com/ChainSafe/gossamer/lib/crypto/sr25519"
 	"github.com/ChainSafe/gossamer/lib/genesis"
 	"github.com/ChainSafe/gossamer/lib/grandpa"
@@ -56,6 +59,7 @@ type rpcServiceSettings struct {
 	blockProducer BlockProducer
 	system        *system.Service
 	blockFinality *grandpa.Service
+	beefy         *beefy.Service
 	syncer        rpc.SyncAPI
 }
 
@@ -93,9 +97,18 @@ func (nodeBuilder) createStateService(config *cfg.Config) (*state.Service, error
 	}
 	defer genesisRuntime.Stop()
 
-	babeCfg, err := genesisRuntime.BabeConfiguration()
-	if err != nil {
-		return nil, fmt.Errorf("getting babe configuration: %w", err)
+	var babeCfg *types.BabeConfiguration
+	if gen.IsAura() {
+		auraCfg, err := genesisRuntime.AuraConfiguration()
+		if err != nil {
+			return nil, fmt.Errorf("getting aura configuration: %w", err)
+		}
+		babeCfg = auraCfg.BabeConfiguration()
+	} else {
+		babeCfg, err = genesisRuntime.BabeConfiguration()
+		if err != nil {
+			return nil, fmt.Errorf("getting babe configuration: %w", err)
+		}
 	}
 
 	stateLogLevel, err := log.ParseLevel(config.Log.State)
@@ -108,6 +121,7 @@ func (nodeBuilder) createStateService(config *cfg.Config) (*state.Service, error
 		LogLevel:          stateLogLevel,
 		Metrics:           metrics.NewIntervalConfig(config.PrometheusExternal),
 		GenesisBABEConfig: babeCfg,
+		SlotCapacity:      uint64(config.Core.BabeEquivocationSlots),
 	}
 
 	stateSrvc := state.NewService(stateConfig)
@@ -272,6 +286,10 @@ func (nodeBuilder) createBABEServiceWithBuilder(config *cfg.Config, st *state.Se
 		bcfg.Keypair = kps[0].(*sr25519.Keypair)
 	}
 
+	if config.Core.BabeBackoffAuthoring {
+		bcfg.BackoffAuthoring = babe.NewBackoffAuthoringOnFinalisedHeadLagging()
+	}
+
 	bs, err := newBabeService.NewServiceIFace(bcfg)
 	if err != nil {
 		logger.Errorf("failed to initialise BABE service: %s", err)
@@ -280,6 +298,53 @@ func (nodeBuilder) createBABEServiceWithBuilder(config *cfg.Config, st *state.Se
 	return bs, nil
 }
 
+func (nodeBuilder) createAuraService(config *cfg.Config, st *state.Service, ks KeyStore,
+	cs *core.Service, telemetryMailer Telemetry) (service *aura.Service, err error) {
+	logger.Info("creating Aura service" +
+		asAuthority(config.Core.AuraAuthority) + "...")
+
+	if ks.Name() != keystore.AuraName || ks.Type() != crypto.Sr25519Type {
+		return nil, ErrInvalidKeystoreType
+	}
+
+	kps := ks.Keypairs()
+	logger.Infof("keystore with keys %v", kps)
+	if len(kps) == 0 && config.Core.AuraAuthority {
+		return nil, ErrNoKeysProvided
+	}
+
+	slotDuration, err := st.Epoch.GetSlotDuration()
+	if err != nil {
+		return nil, fmt.Errorf("getting slot duration: %w", err)
+	}
+
+	auraLogLevel, err := log.ParseLevel(config.Log.Aura)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse aura log level: %w", err)
+	}
+	acfg := &aura.ServiceConfig{
+		LogLvl:             auraLogLevel,
+		BlockState:         st.Block,
+		StorageState:       st.Storage,
+		TransactionState:   st.Transaction,
+		BlockImportHandler: cs,
+		SlotDuration:       slotDuration,
+		Authority:          config.Core.AuraAuthority,
+		Telemetry:          telemetryMailer,
+	}
+
+	if config.Core.AuraAuthority {
+		acfg.Keypair = kps[0].(*sr25519.Keypair)
+	}
+
+	as, err := aura.NewService(acfg)
+	if err != nil {
+		logger.Errorf("failed to initialise Aura service: %s", err)
+		return nil, err
+	}
+	return as, nil
+}
+
 // Core Service
 
 // createCoreService creates the core service from the provided core configuration
@@ -334,6 +399,24 @@ func (nodeBuilder) createCoreService(config *cfg.Config, ks *keystore.GlobalKeys
 // createNetworkService creates a network service from the command configuration and genesis data
 func (nodeBuilder) createNetworkService(config *cfg.Config, stateSrvc *state.Service,
 	telemetryMailer Telemetry) (*network.Service, error) {
+	networkConfig, err := newNetworkConfig(config, stateSrvc, telemetryMailer)
+	if err != nil {
+		return nil, err
+	}
+
+	networkSrvc, err := network.NewService(networkConfig)
+	if err != nil {
+		logger.Errorf("failed to create network service: %s", err)
+		return nil, err
+	}
+
+	return networkSrvc, nil
+}
+
+// newNetworkConfig returns the network service configuration from the command configuration
+// and genesis data
+func newNetworkConfig(config *cfg.Config, stateSrvc *state.Service,
+	telemetryMailer Telemetry) (*network.Config, error) {
 	logger.Debugf(
 		"creating network service with role %d, port %d, bootnodes %s, protocol ID %s, nobootstrap=%t and noMDNS=%t...",
 		config.Core.Role, config.Network.Port, strings.Join(config.Network.Bootnodes, ","), config.Network.ProtocolID,
@@ -354,7 +437,7 @@ func (nodeBuilder) createNetworkService(config *cfg.Config, stateSrvc *state.Ser
 	)
 
 	// network service configuation
-	networkConfig := network.Config{
+	networkConfig := &network.Config{
 		LogLvl:            networkLogLevel,
 		BlockState:        stateSrvc.Block,
 		BasePath:          config.BasePath,
@@ -362,12 +445,15 @@ func (nodeBuilder) createNetworkService(config *cfg.Config, stateSrvc *state.Ser
 		Port:              config.Network.Port,
 		Bootnodes:         config.Network.Bootnodes,
 		ProtocolID:        config.Network.ProtocolID,
+		ForkID:            config.Network.ForkID,
 		NoBootstrap:       config.Network.NoBootstrap,
 		NoMDNS:            config.Network.NoMDNS,
 		MinPeers:          config.Network.MinPeers,
 		MaxPeers:          config.Network.MaxPeers,
 		PersistentPeers:   config.Network.PersistentPeers,
+		ReservedOnly:      config.Network.ReservedOnly,
 		DiscoveryInterval: config.Network.DiscoveryInterval,
+		BanDuration:       config.Network.BanDuration,
 		SlotDuration:      slotDuration,
 		PublicIP:          config.Network.PublicIP,
 		Telemetry:         telemetryMailer,
@@ -375,16 +461,12 @@ func (nodeBuilder) createNetworkService(config *cfg.Config, stateSrvc *state.Ser
 		Metrics:           metrics.NewIntervalConfig(config.PrometheusExternal),
 		NodeKey:           config.Network.NodeKey,
 		ListenAddress:     config.Network.ListenAddress,
+		TLSCert:           config.Network.TLSCert,
+		TLSKey:            config.Network.TLSKey,
 		WarpSyncProvider:  warpSyncProvider,
 	}
 
-	networkSrvc, err := network.NewService(&networkConfig)
-	if err != nil {
-		logger.Errorf("failed to create network service: %s", err)
-		return nil, err
-	}
-
-	return networkSrvc, nil
+	return networkConfig, nil
 }
 
 // RPC Service
@@ -416,6 +498,14 @@ func (nodeBuilder) createRPCService(params rpcServiceSettings) (*rpc.HTTPServer,
 	if err != nil {
 		return nil, fmt.Errorf("failed to parse rpc log level: %w", err)
 	}
+	var jwtSecret []byte
+	if params.config.RPC.JWTSecretPath != "" {
+		jwtSecret, err = rpc.ReadJWTSecret(params.config.RPC.JWTSecretPath)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read jwt secret: %w", err)
+		}
+	}
+
 	rpcConfig := &rpc.HTTPServerConfig{
 		LogLvl:              rpcLogLevel,
 		BlockAPI:            params.state.Block,
@@ -425,6 +515,7 @@ func (nodeBuilder) createRPCService(params rpcServiceSettings) (*rpc.HTTPServer,
 		NodeStorage:         params.nodeStorage,
 		BlockProducerAPI:    params.blockProducer,
 		BlockFinalityAPI:    params.blockFinality,
+		BabeAPI:             params.state.Slot,
 		TransactionQueueAPI: params.state.Transaction,
 		RPCAPI:              rpcService,
 		SyncStateAPI:        syncStateSrvc,
@@ -438,9 +529,17 @@ func (nodeBuilder) createRPCService(params rpcServiceSettings) (*rpc.HTTPServer,
 		WSExternal:          params.config.RPC.WSExternal,
 		WSUnsafeExternal:    params.config.RPC.UnsafeWSExternal,
 		WSPort:              params.config.RPC.WSPort,
+		IPCPath:             params.config.RPC.IPCPath,
+		JWTSecret:           jwtSecret,
+		BearerTokens:        params.config.RPC.BearerTokens,
 		Modules:             params.config.RPC.Modules,
 	}
 
+	// the beefy service is not created for the chains not running BEEFY.
+	if params.beefy != nil {
+		rpcConfig.BeefyAPI = params.beefy
+	}
+
 	return rpc.NewHTTPServer(rpcConfig), nil
 }
 
@@ -484,14 +583,23 @@ func (nodeBuilder) createGRANDPAService(config *cfg.Config, st *state.Service, k
 		return nil, fmt.Errorf("failed to parse grandpa log level: %w", err)
 	}
 	gsCfg := &grandpa.Config{
-		LogLvl:       grandpaLogLevel,
-		BlockState:   st.Block,
-		GrandpaState: st.Grandpa,
-		Voters:       voters,
-		Authority:    config.Core.GrandpaAuthority,
-		Network:      net,
-		Interval:     config.Core.GrandpaInterval,
-		Telemetry:    telemetryMailer,
+		LogLvl:        grandpaLogLevel,
+		BlockState:    st.Block,
+		GrandpaState:  st.Grandpa,
+		Voters:        voters,
+		Authority:     config.Core.GrandpaAuthority,
+		Network:       net,
+		ForkID:        config.Network.ForkID,
+		Interval:      config.Core.GrandpaInterval,
+		Telemetry:     telemetryMailer,
+		FinalityVoter: config.Core.GrandpaVoter,
+	}
+
+	if config.Core.GrandpaBeforeBestBlockBy > 0 {
+		gsCfg.VotingRules = append(gsCfg.VotingRules, grandpa.BeforeBestBlockBy(config.Core.GrandpaBeforeBestBlockBy))
+	}
+	if config.Core.GrandpaThreeQuarters {
+		gsCfg.VotingRules = append(gsCfg.VotingRules, grandpa.ThreeQuartersOfTheUnfinalizedChain{})
 	}
 
 	if config.Core.GrandpaAuthority {
@@ -501,13 +609,68 @@ func (nodeBuilder) createGRANDPAService(config *cfg.Config, st *state.Service, k
 	return grandpa.NewService(gsCfg)
 }
 
+// isBEEFYEnabled returns true if the runtime of the best block exposes the BEEFY runtime API,
+// the BEEFY service being only created for the chains running BEEFY.
+func (nodeBuilder) isBEEFYEnabled(st *state.Service) (bool, error) {
+	rt, err := st.Block.GetRuntime(st.Block.BestBlockHash())
+	if err != nil {
+		return false, fmt.Errorf("getting runtime: %w", err)
+	}
+
+	version, err := rt.Version()
+	if err != nil {
+		return false, fmt.Errorf("getting runtime version: %w", err)
+	}
+
+	return version.HasAPI(runtime.BeefyAPI), nil
+}
+
+// createBEEFYService creates a new BEEFY service, voting with the BEEFY key of the keystore
+// if the node is an authority with such a key.
+func (nodeBuilder) createBEEFYService(config *cfg.Config, st *state.Service, ks KeyStore,
+	net *network.Service) (*beefy.Service, error) {
+	if ks.Name() != "beef" || ks.Type() != crypto.Secp256k1Type {
+		return nil, ErrInvalidKeystoreType
+	}
+
+	// BEEFY shares the log level of the GRANDPA finality gadget it follows.
+	beefyLogLevel, err := log.ParseLevel(config.Log.Grandpa)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse beefy log level: %w", err)
+	}
+
+	keys := ks.Keypairs()
+	beefyCfg := &beefy.Config{
+		LogLvl:       beefyLogLevel,
+		BlockState:   st.Block,
+		StorageState: st.Storage,
+		Network:      net,
+		Authority:    config.Core.Role == common.AuthorityRole && len(keys) > 0,
+		ForkID:       config.Network.ForkID,
+	}
+
+	if beefyCfg.Authority {
+		beefyCfg.Keypair = keys[0].(*secp256k1.Keypair)
+	}
+
+	return beefy.NewService(beefyCfg)
+}
+
 func (nodeBuilder) createBlockVerifier(st *state.Service) *babe.VerificationManager {
-	return babe.NewVerificationManager(st.Block, st.Slot, st.Epoch)
+	return babe.NewVerificationManager(st.Block, st.Slot, st.Epoch, st.Telemetry)
+}
+
+func (nodeBuilder) createAuraVerifier(st *state.Service) (*aura.Verifier, error) {
+	slotDuration, err := st.Epoch.GetSlotDuration()
+	if err != nil {
+		return nil, fmt.Errorf("getting slot duration: %w", err)
+	}
+	return aura.NewVerifier(st.Block, st.Storage, slotDuration), nil
 }
 
 func (nodeBuilder) newSyncService(config *cfg.Config, st *state.Service, fg sync.FinalityGadget,
-	verifier *babe.VerificationManager, cs *core.Service, net *network.Service, telemetryMailer Telemetry) (
-	network.Syncer, error) {
+	beefyImporter sync.BeefyJustificationImporter, verifier sync.BabeVerifier, cs *core.Service,
+	net *network.Service, telemetryMailer Telemetry) (network.Syncer, error) {
 	slotDuration, err := st.Epoch.GetSlotDuration()
 	if err != nil {
 		return nil, err
@@ -528,6 +691,7 @@ func (nodeBuilder) newSyncService(config *cfg.Config, st *state.Service, fg sync
 		FinalityGadget:     fg,
 		BabeVerifier:       verifier,
 		BlockImportHandler: cs,
+		BeefyImporter:      beefyImporter,
 		Telemetry:          telemetryMailer,
 		BadBlocks:          genesisData.BadBlocks,
 		RequestMaker:       requestMaker,
@@ -22,7 +22,9 @@
 	"github.com/ChainSafe/gossamer/dot/types"
 	"github.com/ChainSafe/gossamer/internal/log"
 	babe "github.com/ChainSafe/gossamer/lib/babe"
+	"github.com/ChainSafe/gossamer/lib/beefy"
 	"github.com/ChainSafe/gossamer/lib/common"
+	"github.com/ChainSafe/gossamer/lib/crypto/secp256k1"
 	"github.com/ChainSafe/gossamer/lib/grandpa"
 	"github.com/ChainSafe/gossamer/lib/keystore"
 	"github.com/ChainSafe/gossamer/lib/runtime"
@@ -313,6 +315,70 @@ func Test_nodeBuilder_createGRANDPAService(t *testing.T) {
 	}
 }
 
+func Test_nodeBuilder_createBEEFYService(t *testing.T) {
+	t.Parallel()
+
+	ks := keystore.NewGlobalKeystore()
+	kp, err := secp256k1.GenerateKeypair()
+	require.NoError(t, err)
+	ks.Beef.Insert(kp)
+
+	tests := []struct {
+		name      string
+		ks        KeyStore
+		expectNil bool
+		err       error
+	}{
+		{
+			name:      "wrong key type",
+			ks:        ks.Gran,
+			expectNil: true,
+			err:       ErrInvalidKeystoreType,
+		},
+		{
+			name: "base case",
+			ks:   ks.Beef,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			config := DefaultTestWestendDevConfig(t)
+			builder := nodeBuilder{}
+			got, err := builder.createBEEFYService(config, &state.Service{}, tt.ks, nil)
+			assert.ErrorIs(t, err, tt.err)
+			if tt.expectNil {
+				assert.Nil(t, got)
+			} else {
+				assert.IsType(t, &beefy.Service{}, got)
+			}
+		})
+	}
+}
+
+func Test_nodeBuilder_isBEEFYEnabled(t *testing.T) {
+	config := DefaultTestWestendDevConfig(t)
+	config.ChainSpec = NewTestGenesisRawFile(t, config)
+
+	err := InitNode(config)
+	require.NoError(t, err)
+
+	builder := nodeBuilder{}
+	stateSrvc := newStateServiceWithoutMock(t)
+
+	ns, err := builder.createRuntimeStorage(stateSrvc)
+	require.NoError(t, err)
+
+	err = builder.loadRuntime(config, ns, stateSrvc, keystore.NewGlobalKeystore(), &network.Service{})
+	require.NoError(t, err)
+
+	enabled, err := builder.isBEEFYEnabled(stateSrvc)
+	require.NoError(t, err)
+	assert.True(t, enabled)
+}
+
 func Test_createRuntime(t *testing.T) {
 	t.Parallel()
 	config := DefaultTestWestendDevConfig(t)
@@ -408,7 +474,7 @@ type args struct {
 			ctrl := gomock.NewController(t)
 			stateSrvc := newStateService(t, ctrl)
 			no := nodeBuilder{}
-			got, err := no.newSyncService(config, stateSrvc, tt.args.fg, tt.args.verifier, tt.args.cs,
+			got, err := no.newSyncService(config, stateSrvc, tt.args.fg, nil, tt.args.verifier, tt.args.cs,
 				tt.args.net, tt.args.telemetryMailer)
 			assert.ErrorIs(t, err, tt.err)
 			if tt.expectNil {
@@ -554,7 +620,7 @@ func TestCreateSyncService(t *testing.T) {
 	coreSrvc, err := builder.createCoreService(config, ks, stateSrvc, networkService)
 	require.NoError(t, err)
 
-	_, err = builder.newSyncService(config, stateSrvc, &grandpa.Service{}, ver, coreSrvc, networkService, nil)
+	_, err = builder.newSyncService(config, stateSrvc, &grandpa.Service{}, nil, ver, coreSrvc, networkService, nil)
 	require.NoError(t, err)
 }
 
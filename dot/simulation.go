@@ -0,0 +1,57 @@
+// Copyright 2024 ChainSafe Systems (ON)
+// SPDX-License-Identifier: LGPL-3.0-only
+
+package dot
+
+import (
+	"fmt"
+
+	cfg "github.com/ChainSafe/gossamer/config"
+	"github.com/ChainSafe/gossamer/dot/network"
+	"github.com/ChainSafe/gossamer/dot/state"
+	"github.com/ChainSafe/gossamer/internal/log"
+	"github.com/ChainSafe/gossamer/lib/keystore"
+	"github.com/ChainSafe/gossamer/lib/services"
+)
+
+// simulatedNodeBuilder builds the services of a node like nodeBuilder,
+// its network service running on a simulated network.
+type simulatedNodeBuilder struct {
+	nodeBuilder
+	simulation network.SimulationConfig
+}
+
+// createNetworkService creates a network service on the simulated network
+// from the command configuration and genesis data
+func (b simulatedNodeBuilder) createNetworkService(config *cfg.Config, stateSrvc *state.Service,
+	telemetryMailer Telemetry) (*network.Service, error) {
+	networkConfig, err := newNetworkConfig(config, stateSrvc, telemetryMailer)
+	if err != nil {
+		return nil, err
+	}
+
+	return network.NewSimulatedService(networkConfig, b.simulation)
+}
+
+// NewSimulatedNode creates a node like NewNode, its network service running on the simulated
+// network given instead of the real transports. It is only meant to be used by simulation
+// tests, such as the ones of the netsim package.
+func NewSimulatedNode(config *cfg.Config, ks *keystore.GlobalKeystore,
+	simulation network.SimulationConfig) (*Node, error) {
+	serviceRegistryLogger := logger.New(log.AddContext("pkg", "services"))
+
+	isInitialised, err := IsNodeInitialised(config.BasePath)
+	if err != nil {
+		return nil, fmt.Errorf("checking if node is initialised: %w", err)
+	}
+
+	builder := simulatedNodeBuilder{simulation: simulation}
+	if !isInitialised {
+		err := builder.initNode(config)
+		if err != nil {
+			return nil, fmt.Errorf("cannot initialise node: %w", err)
+		}
+	}
+
+	return newNode(config, ks, builder, services.NewServiceRegistry(serviceRegistryLogger))
+}
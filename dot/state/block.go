@@ -38,6 +38,9 @@
 	justificationPrefix = []byte("jcp") // justificationPrefix + hash -> justification
 	firstSlotNumberKey  = []byte("fsn") // firstSlotNumberKey -> First slot number
 
+	beefyJustificationPrefix = []byte("bjp") // beefyJustificationPrefix + hash -> beefy justification
+	bestBeefyBlockKey        = []byte("bbb") // bestBeefyBlockKey -> hash of the best beefy finalised block
+
 	errNilBlockTree = errors.New("blocktree is nil")
 	errNilBlockBody = errors.New("block body is nil")
 
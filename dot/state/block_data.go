@@ -86,3 +86,33 @@ func (bs *BlockState) GetJustification(hash common.Hash) ([]byte, error) {
 
 	return data, nil
 }
+
+// HasBeefyJustification returns if the db contains a BEEFY justification at the given hash
+func (bs *BlockState) HasBeefyJustification(hash common.Hash) (bool, error) {
+	return bs.db.Has(prefixKey(hash, beefyJustificationPrefix))
+}
+
+// SetBeefyJustification sets a BEEFY justification in the database
+func (bs *BlockState) SetBeefyJustification(hash common.Hash, data []byte) error {
+	return bs.db.Put(prefixKey(hash, beefyJustificationPrefix), data)
+}
+
+// GetBeefyJustification retrieves a BEEFY justification from the database
+func (bs *BlockState) GetBeefyJustification(hash common.Hash) ([]byte, error) {
+	return bs.db.Get(prefixKey(hash, beefyJustificationPrefix))
+}
+
+// SetBestBeefyBlockHash sets the hash of the best block finalised by BEEFY in the database
+func (bs *BlockState) SetBestBeefyBlockHash(hash common.Hash) error {
+	return bs.db.Put(bestBeefyBlockKey, hash.ToBytes())
+}
+
+// GetBestBeefyBlockHash retrieves the hash of the best block finalised by BEEFY from the database
+func (bs *BlockState) GetBestBeefyBlockHash() (common.Hash, error) {
+	data, err := bs.db.Get(bestBeefyBlockKey)
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	return common.NewHash(data), nil
+}
@@ -7,6 +7,7 @@
 	"testing"
 
 	"github.com/ChainSafe/gossamer/dot/types"
+	"github.com/ChainSafe/gossamer/internal/database"
 	"github.com/ChainSafe/gossamer/lib/common"
 	"github.com/ChainSafe/gossamer/pkg/trie"
 
@@ -81,3 +82,34 @@ func TestGetSet_ReceiptMessageQueue_Justification(t *testing.T) {
 		}
 	}
 }
+
+func TestGetSet_BeefyJustification(t *testing.T) {
+	s := newTestBlockState(t, newTriesEmpty())
+
+	hash := common.NewHash([]byte{1})
+
+	has, err := s.HasBeefyJustification(hash)
+	require.NoError(t, err)
+	require.False(t, has)
+
+	_, err = s.GetBestBeefyBlockHash()
+	require.ErrorIs(t, err, database.ErrNotFound)
+
+	err = s.SetBeefyJustification(hash, []byte("proof"))
+	require.NoError(t, err)
+	err = s.SetBestBeefyBlockHash(hash)
+	require.NoError(t, err)
+
+	justification, err := s.GetBeefyJustification(hash)
+	require.NoError(t, err)
+	require.Equal(t, []byte("proof"), justification)
+
+	// the beefy justification is stored alongside the grandpa one
+	has, err = s.HasJustification(hash)
+	require.NoError(t, err)
+	require.False(t, has)
+
+	best, err := s.GetBestBeefyBlockHash()
+	require.NoError(t, err)
+	require.Equal(t, hash, best)
+}
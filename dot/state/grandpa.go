@@ -34,6 +34,7 @@
 	pauseKey          = []byte("pause")
 	resumeKey         = []byte("resume")
 	currentSetIDKey   = []byte("setID")
+	roundStateKey     = []byte("roundState")
 )
 
 // GrandpaState tracks information related to grandpa
@@ -398,6 +399,33 @@ func (s *GrandpaState) GetLatestRound() (uint64, error) {
 	return round, nil
 }
 
+// SetRoundState sets the state of the round our authority is voting in, with the votes it signed
+func (s *GrandpaState) SetRoundState(roundState types.GrandpaRoundState) error {
+	data, err := scale.Marshal(roundState)
+	if err != nil {
+		return err
+	}
+
+	return s.db.Put(roundStateKey, data)
+}
+
+// GetRoundState returns the state of the round our authority is voting in.
+// If no round state is stored, the error database.ErrNotFound is returned.
+func (s *GrandpaState) GetRoundState() (types.GrandpaRoundState, error) {
+	data, err := s.db.Get(roundStateKey)
+	if err != nil {
+		return types.GrandpaRoundState{}, err
+	}
+
+	var roundState types.GrandpaRoundState
+	err = scale.Unmarshal(data, &roundState)
+	if err != nil {
+		return types.GrandpaRoundState{}, err
+	}
+
+	return roundState, nil
+}
+
 // SetNextChange sets the next authority change at the given block number.
 // NOTE: This block number will be the last block in the current set and not part of the next set.
 func (s *GrandpaState) SetNextChange(authorities []types.GrandpaVoter, number uint) error {
@@ -124,6 +124,31 @@ func TestGrandpaState_LatestRound(t *testing.T) {
 	require.Equal(t, uint64(99), r)
 }
 
+func TestGrandpaState_RoundState(t *testing.T) {
+	db := NewInMemoryDB(t)
+	gs, err := NewGrandpaStateFromGenesis(db, nil, testAuths, nil)
+	require.NoError(t, err)
+
+	_, err = gs.GetRoundState()
+	require.ErrorIs(t, err, database.ErrNotFound)
+
+	roundState := types.GrandpaRoundState{
+		Round: 7,
+		SetID: 1,
+		Prevote: &types.GrandpaSignedVote{
+			Vote:        types.GrandpaVote{Hash: common.Hash{1}, Number: 3},
+			Signature:   [64]byte{2},
+			AuthorityID: testAuths[0].Key.AsBytes(),
+		},
+	}
+	err = gs.SetRoundState(roundState)
+	require.NoError(t, err)
+
+	stored, err := gs.GetRoundState()
+	require.NoError(t, err)
+	require.Equal(t, roundState, stored)
+}
+
 func testBlockState(t *testing.T, db database.Database) *BlockState {
 	ctrl := gomock.NewController(t)
 	telemetryMock := NewMockTelemetry(ctrl)
@@ -56,7 +56,12 @@ func (s *Service) Initialise(gen *genesis.Genesis, header *types.Header, t trie.
 	}
 	defer rt.Stop()
 
-	babeCfg, err := s.loadBabeConfigurationFromRuntime(rt)
+	var babeCfg *types.BabeConfiguration
+	if gen.IsAura() {
+		babeCfg, err = loadAuraConfigurationFromRuntime(rt)
+	} else {
+		babeCfg, err = s.loadBabeConfigurationFromRuntime(rt)
+	}
 	if err != nil {
 		return err
 	}
@@ -103,7 +108,7 @@ func (s *Service) Initialise(gen *genesis.Genesis, header *types.Header, t trie.
 		s.Block = blockState
 		s.Epoch = epochState
 		s.Grandpa = grandpaState
-		s.Slot = NewSlotState(db)
+		s.Slot = NewSlotState(db, s.slotCapacity)
 	} else if err = db.Close(); err != nil {
 		return fmt.Errorf("failed to close database: %s", err)
 	}
@@ -127,6 +132,17 @@ func (s *Service) loadBabeConfigurationFromRuntime(r BabeConfigurer) (*types.Bab
 	return babeCfg, nil
 }
 
+// loadAuraConfigurationFromRuntime returns the BABE configuration initialising the epoch
+// state of an Aura chain, built from the aura configuration of the runtime.
+func loadAuraConfigurationFromRuntime(r AuraConfigurer) (*types.BabeConfiguration, error) {
+	auraCfg, err := r.AuraConfiguration()
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch genesis aura configuration: %w", err)
+	}
+
+	return auraCfg.BabeConfiguration(), nil
+}
+
 func loadGrandpaAuthorities(t trie.Trie) ([]types.GrandpaVoter, error) {
 	key := common.MustHexToBytes(genesis.GrandpaAuthoritiesKeyHex)
 	authsRaw := t.Get(key)
@@ -72,6 +72,11 @@ type BabeConfigurer interface {
 	BabeConfiguration() (*types.BabeConfiguration, error)
 }
 
+// AuraConfigurer returns the aura configuration of the runtime.
+type AuraConfigurer interface {
+	AuraConfiguration() (*types.AuraConfiguration, error)
+}
+
 // Telemetry is the telemetry client to send telemetry messages.
 type Telemetry interface {
 	SendMessage(msg json.Marshaler)
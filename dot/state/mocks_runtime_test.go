@@ -44,6 +44,21 @@ func (m *MockInstance) EXPECT() *MockInstanceMockRecorder {
 	return m.recorder
 }
 
+// AccountNonce mocks base method.
+func (m *MockInstance) AccountNonce(arg0 []byte) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AccountNonce", arg0)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AccountNonce indicates an expected call of AccountNonce.
+func (mr *MockInstanceMockRecorder) AccountNonce(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AccountNonce", reflect.TypeOf((*MockInstance)(nil).AccountNonce), arg0)
+}
+
 // ApplyExtrinsic mocks base method.
 func (m *MockInstance) ApplyExtrinsic(arg0 types.Extrinsic) ([]byte, error) {
 	m.ctrl.T.Helper()
@@ -59,6 +74,36 @@ func (mr *MockInstanceMockRecorder) ApplyExtrinsic(arg0 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApplyExtrinsic", reflect.TypeOf((*MockInstance)(nil).ApplyExtrinsic), arg0)
 }
 
+// AuraConfiguration mocks base method.
+func (m *MockInstance) AuraConfiguration() (*types.AuraConfiguration, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AuraConfiguration")
+	ret0, _ := ret[0].(*types.AuraConfiguration)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AuraConfiguration indicates an expected call of AuraConfiguration.
+func (mr *MockInstanceMockRecorder) AuraConfiguration() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AuraConfiguration", reflect.TypeOf((*MockInstance)(nil).AuraConfiguration))
+}
+
+// AuthorityDiscoveryAuthorities mocks base method.
+func (m *MockInstance) AuthorityDiscoveryAuthorities() ([]types.AuthorityID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AuthorityDiscoveryAuthorities")
+	ret0, _ := ret[0].([]types.AuthorityID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AuthorityDiscoveryAuthorities indicates an expected call of AuthorityDiscoveryAuthorities.
+func (mr *MockInstanceMockRecorder) AuthorityDiscoveryAuthorities() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AuthorityDiscoveryAuthorities", reflect.TypeOf((*MockInstance)(nil).AuthorityDiscoveryAuthorities))
+}
+
 // BabeConfiguration mocks base method.
 func (m *MockInstance) BabeConfiguration() (*types.BabeConfiguration, error) {
 	m.ctrl.T.Helper()
@@ -103,16 +148,34 @@ func (mr *MockInstanceMockRecorder) BabeSubmitReportEquivocationUnsignedExtrinsi
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BabeSubmitReportEquivocationUnsignedExtrinsic", reflect.TypeOf((*MockInstance)(nil).BabeSubmitReportEquivocationUnsignedExtrinsic), arg0, arg1)
 }
 
+// BeefyValidatorSet mocks base method.
+func (m *MockInstance) BeefyValidatorSet() (*types.BeefyValidatorSet, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BeefyValidatorSet")
+	ret0, _ := ret[0].(*types.BeefyValidatorSet)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BeefyValidatorSet indicates an expected call of BeefyValidatorSet.
+func (mr *MockInstanceMockRecorder) BeefyValidatorSet() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BeefyValidatorSet", reflect.TypeOf((*MockInstance)(nil).BeefyValidatorSet))
+}
+
 // CheckInherents mocks base method.
-func (m *MockInstance) CheckInherents() {
+func (m *MockInstance) CheckInherents(arg0 *types.Block, arg1 *types.InherentData) (*types.CheckInherentsResult, error) {
 	m.ctrl.T.Helper()
-	m.ctrl.Call(m, "CheckInherents")
+	ret := m.ctrl.Call(m, "CheckInherents", arg0, arg1)
+	ret0, _ := ret[0].(*types.CheckInherentsResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
 // CheckInherents indicates an expected call of CheckInherents.
-func (mr *MockInstanceMockRecorder) CheckInherents() *gomock.Call {
+func (mr *MockInstanceMockRecorder) CheckInherents(arg0, arg1 any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckInherents", reflect.TypeOf((*MockInstance)(nil).CheckInherents))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckInherents", reflect.TypeOf((*MockInstance)(nil).CheckInherents), arg0, arg1)
 }
 
 // DecodeSessionKeys mocks base method.
@@ -36,6 +36,7 @@ type Service struct {
 	Slot              *SlotState
 	closeCh           chan interface{}
 	genesisBABEConfig *types.BabeConfiguration
+	slotCapacity      uint64
 
 	PrunerCfg pruner.Config
 	Telemetry Telemetry
@@ -58,6 +59,9 @@ type Config struct {
 	Telemetry         Telemetry
 	Metrics           metrics.IntervalConfig
 	GenesisBABEConfig *types.BabeConfiguration
+	// SlotCapacity is the number of past slots whose headers are kept to check
+	// equivocations, DefaultSlotCapacity if it is zero.
+	SlotCapacity uint64
 }
 
 // NewService create a new instance of Service
@@ -75,6 +79,7 @@ func NewService(config Config) *Service {
 		PrunerCfg:         config.PrunerCfg,
 		Telemetry:         config.Telemetry,
 		genesisBABEConfig: config.GenesisBABEConfig,
+		slotCapacity:      config.SlotCapacity,
 	}
 }
 
@@ -154,7 +159,7 @@ func (s *Service) Start() (err error) {
 	s.Transaction = NewTransactionState(s.Telemetry)
 
 	// create epoch and slot state
-	s.Slot = NewSlotState(s.db)
+	s.Slot = NewSlotState(s.db, s.slotCapacity)
 
 	s.Epoch, err = NewEpochState(s.db, s.Block, s.genesisBABEConfig)
 	if err != nil {
@@ -17,26 +17,35 @@
 
 const slotTablePrefix = "slot"
 
-// We keep at least this number of slots in database.
-const maxSlotCapacity uint64 = 1000
-
-// We prune slots when they reach this number.
-const pruningBound = 2 * maxSlotCapacity
+// DefaultSlotCapacity is the default number of past slots whose headers are kept in
+// database to check equivocations.
+const DefaultSlotCapacity uint64 = 1000
 
 var (
-	slotHeaderMapKey   = []byte("slot_header_map")
-	slotHeaderStartKey = []byte("slot_header_start")
+	slotHeaderMapKey          = []byte("slot_header_map")
+	slotHeaderStartKey        = []byte("slot_header_start")
+	slotEquivocationProofsKey = []byte("slot_equivocation_proofs")
 )
 
 type SlotState struct {
 	db database.Table
+	// capacity is the minimum number of past slots kept in database, the slots are
+	// pruned when they reach twice this number.
+	capacity uint64
 }
 
-func NewSlotState(db database.Database) *SlotState {
+// NewSlotState returns the slot state keeping the headers of the given number of past
+// slots, or of DefaultSlotCapacity slots if it is zero.
+func NewSlotState(db database.Database, capacity uint64) *SlotState {
 	slotStateDB := database.NewTable(db, slotTablePrefix)
 
+	if capacity == 0 {
+		capacity = DefaultSlotCapacity
+	}
+
 	return &SlotState{
-		db: slotStateDB,
+		db:       slotStateDB,
+		capacity: capacity,
 	}
 }
 
@@ -49,7 +58,7 @@ func (s *SlotState) CheckEquivocation(slotNow, slot uint64, header *types.Header
 	signer types.AuthorityID) (*types.BabeEquivocationProof, error) { //skipcq: GO-R1005
 	// We don't check equivocations for old headers out of our capacity.
 	// checking slotNow is greater than slot to avoid overflow, same as saturating_sub
-	if primitives.SaturatingSub(slotNow, slot) > maxSlotCapacity {
+	if primitives.SaturatingSub(slotNow, slot) > s.capacity {
 		return nil, nil
 	}
 
@@ -109,12 +118,18 @@ func (s *SlotState) CheckEquivocation(slotNow, slot uint64, header *types.Header
 		if headerAndSigner.Signer == signer {
 			// 2) with different hash
 			if headerAndSigner.Header.Hash() != header.Hash() {
-				return &types.BabeEquivocationProof{
+				proof := &types.BabeEquivocationProof{
 					Slot:         slot,
 					Offender:     signer,
 					FirstHeader:  *headerAndSigner.Header,
 					SecondHeader: *header,
-				}, nil
+				}
+
+				err = s.storeEquivocationProof(proof)
+				if err != nil {
+					return nil, fmt.Errorf("storing equivocation proof: %w", err)
+				}
+				return proof, nil
 			} else {
 				// We don't need to continue in case of duplicated header,
 				// since it's already saved and a possible equivocation
@@ -127,8 +142,8 @@ func (s *SlotState) CheckEquivocation(slotNow, slot uint64, header *types.Header
 	keysToDelete := make([][]byte, 0)
 	newFirstSavedSlot := firstSavedSlot
 
-	if slotNow-firstSavedSlot >= pruningBound {
-		newFirstSavedSlot = primitives.SaturatingSub(slotNow, maxSlotCapacity)
+	if slotNow-firstSavedSlot >= 2*s.capacity {
+		newFirstSavedSlot = primitives.SaturatingSub(slotNow, s.capacity)
 
 		for s := firstSavedSlot; s < newFirstSavedSlot; s++ {
 			slotEncoded := make([]byte, 8)
@@ -178,6 +193,13 @@ func (s *SlotState) CheckEquivocation(slotNow, slot uint64, header *types.Header
 		}
 	}
 
+	if newFirstSavedSlot != firstSavedSlot {
+		err = s.pruneEquivocationProofs(batch, newFirstSavedSlot)
+		if err != nil {
+			return nil, fmt.Errorf("pruning equivocation proofs: %w", err)
+		}
+	}
+
 	err = batch.Flush()
 	if err != nil {
 		return nil, fmt.Errorf("failed to flush batch operations: %w", err)
@@ -185,3 +207,67 @@ func (s *SlotState) CheckEquivocation(slotNow, slot uint64, header *types.Header
 
 	return nil, nil
 }
+
+// EquivocationProofs returns the proofs of the equivocations detected in the slots kept
+// in database, ordered by slot.
+func (s *SlotState) EquivocationProofs() ([]types.BabeEquivocationProof, error) {
+	iter, err := s.db.NewPrefixIterator(slotEquivocationProofsKey)
+	if err != nil {
+		return nil, fmt.Errorf("creating equivocation proofs iterator: %w", err)
+	}
+	defer iter.Release()
+
+	var proofs []types.BabeEquivocationProof
+	for iter.First(); iter.Valid(); iter.Next() {
+		// the headers should be empty headers to decode their digests
+		proof := types.BabeEquivocationProof{
+			FirstHeader:  *types.NewEmptyHeader(),
+			SecondHeader: *types.NewEmptyHeader(),
+		}
+		err = scale.Unmarshal(iter.Value(), &proof)
+		if err != nil {
+			return nil, fmt.Errorf("unmarshaling equivocation proof: %w", err)
+		}
+		proofs = append(proofs, proof)
+	}
+	return proofs, nil
+}
+
+// equivocationProofKey returns the key of the equivocation proof of the offender in the slot.
+// The slot is big endian encoded so that the proofs are iterated in the order of their slots.
+func equivocationProofKey(slot uint64, offender types.AuthorityID) []byte {
+	key := make([]byte, 0, len(slotEquivocationProofsKey)+8+len(offender))
+	key = append(key, slotEquivocationProofsKey...)
+	key = binary.BigEndian.AppendUint64(key, slot)
+	return append(key, offender[:]...)
+}
+
+func (s *SlotState) storeEquivocationProof(proof *types.BabeEquivocationProof) error {
+	encodedProof, err := scale.Marshal(*proof)
+	if err != nil {
+		return fmt.Errorf("marshalling equivocation proof: %w", err)
+	}
+
+	return s.db.Put(equivocationProofKey(proof.Slot, proof.Offender), encodedProof)
+}
+
+// pruneEquivocationProofs removes the equivocation proofs of the slots before the first
+// saved slot given.
+func (s *SlotState) pruneEquivocationProofs(batch database.Batch, firstSavedSlot uint64) error {
+	proofs, err := s.EquivocationProofs()
+	if err != nil {
+		return err
+	}
+
+	for _, proof := range proofs {
+		if proof.Slot >= firstSavedSlot {
+			break
+		}
+
+		err = batch.Del(equivocationProofKey(proof.Slot, proof.Offender))
+		if err != nil {
+			return fmt.Errorf("while batch deleting equivocation proof of slot %d: %w", proof.Slot, err)
+		}
+	}
+	return nil
+}
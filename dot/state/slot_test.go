@@ -78,7 +78,7 @@ func Test_checkEquivocation(t *testing.T) {
 	header5 := createHeader(t, 4) // @ slot MAX_SLOT_CAPACITY + 4
 	header6 := createHeader(t, 3) // @ slot 4
 
-	slotState := NewSlotState(inMemoryDB)
+	slotState := NewSlotState(inMemoryDB, DefaultSlotCapacity)
 
 	// It's ok to sign same headers.
 	equivProf, err := slotState.CheckEquivocation(2, 2, header1, aliceAuthorityID)
@@ -107,7 +107,7 @@ func Test_checkEquivocation(t *testing.T) {
 
 	// Here we trigger pruning and save header 4.
 	equivProf, err = slotState.CheckEquivocation(
-		pruningBound+2, maxSlotCapacity+4, header4, aliceAuthorityID)
+		2*DefaultSlotCapacity+2, DefaultSlotCapacity+4, header4, aliceAuthorityID)
 	require.NoError(t, err)
 	require.Nil(t, equivProf)
 
@@ -116,12 +116,12 @@ func Test_checkEquivocation(t *testing.T) {
 
 	// This fails because header 5 is an equivocation of header 4.
 	equivProf, err = slotState.CheckEquivocation(
-		pruningBound+3, maxSlotCapacity+4, header5, aliceAuthorityID)
+		2*DefaultSlotCapacity+3, DefaultSlotCapacity+4, header5, aliceAuthorityID)
 	require.NoError(t, err)
 	require.NotNil(t, equivProf)
 
 	require.Equal(t, &types.BabeEquivocationProof{
-		Slot:         maxSlotCapacity + 4,
+		Slot:         DefaultSlotCapacity + 4,
 		Offender:     aliceAuthorityID,
 		FirstHeader:  *header4,
 		SecondHeader: *header5,
@@ -129,7 +129,82 @@ func Test_checkEquivocation(t *testing.T) {
 
 	// This is ok because we pruned the corresponding header. Shows that we are pruning.
 	equivProf, err = slotState.CheckEquivocation(
-		pruningBound+4, 4, header6, aliceAuthorityID)
+		2*DefaultSlotCapacity+4, 4, header6, aliceAuthorityID)
 	require.NoError(t, err)
 	require.Nil(t, equivProf)
 }
+
+func Test_SlotState_EquivocationProofs(t *testing.T) {
+	inMemoryDB, err := database.NewPebble(t.TempDir(), true)
+	require.NoError(t, err)
+
+	kr, err := keystore.NewSr25519Keyring()
+	require.NoError(t, err)
+
+	alicePublicKey := kr.KeyAlice.Public().(*sr25519.PublicKey)
+	aliceAuthorityID := types.AuthorityID(alicePublicKey.AsBytes())
+	bobPublicKey := kr.KeyBob.Public().(*sr25519.PublicKey)
+	bobAuthorityID := types.AuthorityID(bobPublicKey.AsBytes())
+
+	header1 := createHeader(t, 1) // @ slot 2
+	header2 := createHeader(t, 2) // @ slot 2
+	header3 := createHeader(t, 3) // @ slot 22
+	header4 := createHeader(t, 4) // @ slot 15
+	header5 := createHeader(t, 5) // @ slot 15
+
+	const capacity = 10
+	slotState := NewSlotState(inMemoryDB, capacity)
+
+	proofs, err := slotState.EquivocationProofs()
+	require.NoError(t, err)
+	require.Empty(t, proofs)
+
+	equivProof, err := slotState.CheckEquivocation(2, 2, header1, aliceAuthorityID)
+	require.NoError(t, err)
+	require.Nil(t, equivProof)
+
+	equivProof, err = slotState.CheckEquivocation(3, 2, header2, aliceAuthorityID)
+	require.NoError(t, err)
+	require.NotNil(t, equivProof)
+
+	proofs, err = slotState.EquivocationProofs()
+	require.NoError(t, err)
+	require.Len(t, proofs, 1)
+	require.Equal(t, uint64(2), proofs[0].Slot)
+	require.Equal(t, aliceAuthorityID, proofs[0].Offender)
+	require.Equal(t, header1.Hash(), proofs[0].FirstHeader.Hash())
+	require.Equal(t, header2.Hash(), proofs[0].SecondHeader.Hash())
+
+	// headers older than the capacity are not checked
+	equivProof, err = slotState.CheckEquivocation(capacity+3, 2, header3, aliceAuthorityID)
+	require.NoError(t, err)
+	require.Nil(t, equivProof)
+
+	equivProof, err = slotState.CheckEquivocation(15, 15, header4, bobAuthorityID)
+	require.NoError(t, err)
+	require.Nil(t, equivProof)
+
+	equivProof, err = slotState.CheckEquivocation(15, 15, header5, bobAuthorityID)
+	require.NoError(t, err)
+	require.NotNil(t, equivProof)
+
+	proofs, err = slotState.EquivocationProofs()
+	require.NoError(t, err)
+	require.Len(t, proofs, 2)
+	require.Equal(t, uint64(2), proofs[0].Slot)
+	require.Equal(t, uint64(15), proofs[1].Slot)
+	require.Equal(t, bobAuthorityID, proofs[1].Offender)
+
+	// pruning the slots prunes their equivocation proofs
+	equivProof, err = slotState.CheckEquivocation(2*capacity+2, 2*capacity+2, header3, aliceAuthorityID)
+	require.NoError(t, err)
+	require.Nil(t, equivProof)
+	require.False(t, checkSlotToMapKeyExists(t, slotState.db, 2))
+
+	proofs, err = slotState.EquivocationProofs()
+	require.NoError(t, err)
+	require.Len(t, proofs, 1)
+	require.Equal(t, uint64(15), proofs[0].Slot)
+	require.Equal(t, header4.Hash(), proofs[0].FirstHeader.Hash())
+	require.Equal(t, header5.Hash(), proofs[0].SecondHeader.Hash())
+}
@@ -8,13 +8,19 @@
 	"encoding/json"
 	"errors"
 	"fmt"
+	"sort"
 	"sync"
+	"time"
 
 	"github.com/ChainSafe/gossamer/dot/telemetry"
 	"github.com/ChainSafe/gossamer/dot/types"
 	"github.com/ChainSafe/gossamer/internal/database"
+	"github.com/ChainSafe/gossamer/lib/aura"
+	"github.com/ChainSafe/gossamer/lib/beefy"
 	"github.com/ChainSafe/gossamer/lib/common"
+	"github.com/ChainSafe/gossamer/lib/runtime"
 	rtstorage "github.com/ChainSafe/gossamer/lib/runtime/storage"
+	"github.com/ChainSafe/gossamer/pkg/scale"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
 )
@@ -56,6 +62,11 @@
 	BlockImportHandler interface {
 		HandleBlockImport(block *types.Block, state *rtstorage.TrieState, announce bool) error
 	}
+
+	// BeefyJustificationImporter imports the BEEFY justifications received with the blocks
+	BeefyJustificationImporter interface {
+		ImportJustification(blockNumber uint, proof beefy.VersionedFinalityProof) error
+	}
 )
 
 type blockImporter struct {
@@ -65,7 +76,9 @@ type blockImporter struct {
 	babeVerifier       BabeVerifier
 	finalityGadget     FinalityGadget
 	blockImportHandler BlockImportHandler
+	beefyImporter      BeefyJustificationImporter
 	telemetry          Telemetry
+	now                func() time.Time // local clock the block inherents are checked against
 }
 
 func newBlockImporter(cfg *FullSyncConfig) *blockImporter {
@@ -76,11 +89,13 @@ func newBlockImporter(cfg *FullSyncConfig) *blockImporter {
 		babeVerifier:       cfg.BabeVerifier,
 		finalityGadget:     cfg.FinalityGadget,
 		blockImportHandler: cfg.BlockImportHandler,
+		beefyImporter:      cfg.BeefyImporter,
 		telemetry:          cfg.Telemetry,
+		now:                time.Now,
 	}
 }
 
-func (b *blockImporter) importBlock(bd *types.BlockData, origin BlockOrigin) (imported bool, err error) {
+func (b *blockImporter) importBlock(bd *types.BlockData) (imported bool, err error) {
 	blockAlreadyExists, err := b.blockState.HasHeader(bd.Hash)
 	if err != nil && !errors.Is(err, database.ErrNotFound) {
 		return false, err
@@ -90,19 +105,41 @@ func (b *blockImporter) importBlock(bd *types.BlockData, origin BlockOrigin) (im
 		return false, nil
 	}
 
-	err = b.processBlockData(*bd, origin)
+	err = b.processBlockData(*bd)
 	if err != nil {
 		logger.Errorf("processing block #%d (%s) failed: %s", bd.Header.Number, bd.Hash, err)
 		return false, err
 	}
 
+	b.importBeefyJustification(bd)
 	return true, nil
 }
 
+// importBeefyJustification imports the BEEFY justification of the block data, if any. The
+// block is imported even if its BEEFY justification is invalid, which is only logged.
+func (b *blockImporter) importBeefyJustification(bd *types.BlockData) {
+	encoded, ok := bd.Justifications.Get(types.BeefyEngineID)
+	if !ok || b.beefyImporter == nil {
+		return
+	}
+
+	var proof beefy.VersionedFinalityProof
+	err := scale.Unmarshal(encoded, &proof)
+	if err != nil {
+		logger.Warnf("decoding beefy justification of block %s: %s", bd.Hash, err)
+		return
+	}
+
+	err = b.beefyImporter.ImportJustification(bd.Header.Number, proof)
+	if err != nil {
+		logger.Warnf("importing beefy justification of block %s: %s", bd.Hash, err)
+	}
+}
+
 // processBlockData processes the BlockData from a BlockResponse and
 // returns the index of the last BlockData it handled on success,
 // or the index of the block data that errored on failure.
-func (b *blockImporter) processBlockData(blockData types.BlockData, origin BlockOrigin) error {
+func (b *blockImporter) processBlockData(blockData types.BlockData) error {
 	if blockData.Header != nil {
 		var (
 			hasJustification = blockData.Justification != nil && len(*blockData.Justification) > 0
@@ -120,7 +157,7 @@ func (b *blockImporter) processBlockData(blockData types.BlockData, origin Block
 		}
 
 		if blockData.Body != nil {
-			err := b.processBlockDataWithHeaderAndBody(blockData, origin)
+			err := b.processBlockDataWithHeaderAndBody(blockData)
 			if err != nil {
 				return fmt.Errorf("processing block data with header and body: %w", err)
 			}
@@ -148,14 +185,16 @@ func (b *blockImporter) processBlockData(blockData types.BlockData, origin Block
 	return nil
 }
 
-func (b *blockImporter) processBlockDataWithHeaderAndBody(blockData types.BlockData,
-	origin BlockOrigin) (err error) {
-
-	if origin != networkInitialSync {
-		err = b.babeVerifier.VerifyBlock(blockData.Header)
-		if err != nil {
-			return fmt.Errorf("babe verifying block: %w", err)
-		}
+// processBlockDataWithHeaderAndBody verifies the block, which checks its author did not
+// equivocate, then executes and imports it.
+func (b *blockImporter) processBlockDataWithHeaderAndBody(blockData types.BlockData) (err error) {
+	err = b.babeVerifier.VerifyBlock(blockData.Header)
+	// the Aura blocks of a slot that has not started yet are imported once their slot starts
+	if errors.Is(err, aura.ErrBlockFromFuture) {
+		return fmt.Errorf("%w: %s", errBlockInFuture, err)
+	}
+	if err != nil {
+		return fmt.Errorf("babe verifying block: %w", err)
 	}
 
 	accBlockSize := 0
@@ -206,6 +245,11 @@ func (b *blockImporter) handleBlock(block *types.Block) error {
 
 	rt.SetContextStorage(ts)
 
+	err = b.checkInherents(rt, block)
+	if err != nil {
+		return err
+	}
+
 	_, err = rt.ExecuteBlock(block)
 	if err != nil {
 		return fmt.Errorf("failed to execute block %d: %w", block.Header.Number, err)
@@ -224,3 +268,71 @@ func (b *blockImporter) handleBlock(block *types.Block) error {
 
 	return nil
 }
+
+// checkInherents checks the inherents of the block against the local clock and the slot of the
+// block. It returns an error wrapping errBlockInFuture if the only error is the block timestamp
+// being too far in the future, so that the block can be imported later. The block is only
+// rejected if one of the errors is fatal, the other errors are logged.
+func (b *blockImporter) checkInherents(rt runtime.Instance, block *types.Block) error {
+	data, err := newInherentDataToCheck(&block.Header, b.now())
+	if err != nil {
+		return fmt.Errorf("creating inherent data: %w", err)
+	}
+
+	result, err := rt.CheckInherents(block, data)
+	if err != nil {
+		return fmt.Errorf("checking inherents of block %d: %w", block.Header.Number, err)
+	}
+
+	if result.Okay {
+		return nil
+	}
+
+	timestampErr, ok := result.InherentError(types.Timstap0)
+	if ok && len(result.Errors) == 1 && len(timestampErr) > 0 &&
+		types.TimestampInherentError(timestampErr[0]) == types.TimestampTooFarInFuture {
+		return fmt.Errorf("%w: block %d (%s)", errBlockInFuture, block.Header.Number, block.Header.Hash())
+	}
+
+	inherents := make([]string, 0, len(result.Errors))
+	for key := range result.Errors {
+		inherents = append(inherents, string(key[:]))
+	}
+	sort.Strings(inherents)
+
+	if !result.FatalError {
+		logger.Warnf("non fatal errors for inherents %v of block %d (%s)",
+			inherents, block.Header.Number, block.Header.Hash())
+		return nil
+	}
+
+	return fmt.Errorf("%w: block %d with errors for inherents %v",
+		errInvalidInherents, block.Header.Number, inherents)
+}
+
+// newInherentDataToCheck returns the inherent data the inherents of the block are checked
+// against, with the timestamp of the local clock and the BABE or Aura slot of the block.
+func newInherentDataToCheck(header *types.Header, now time.Time) (*types.InherentData, error) {
+	slot, err := types.GetSlotFromHeader(header)
+	if err != nil {
+		return nil, fmt.Errorf("getting slot from header: %w", err)
+	}
+
+	slotInherent := types.Babeslot
+	if types.IsAuraHeader(header) {
+		slotInherent = types.Auraslot
+	}
+
+	data := types.NewInherentData()
+	err = data.SetInherent(types.Timstap0, uint64(now.UnixMilli())) //nolint:gosec
+	if err != nil {
+		return nil, fmt.Errorf("setting inherent %q: %w", types.Timstap0.Bytes(), err)
+	}
+
+	err = data.SetInherent(slotInherent, slot)
+	if err != nil {
+		return nil, fmt.Errorf("setting inherent %q: %w", slotInherent.Bytes(), err)
+	}
+
+	return data, nil
+}
@@ -0,0 +1,269 @@
+// Copyright 2024 ChainSafe Systems (ON)
+// SPDX-License-Identifier: LGPL-3.0-only
+
+package sync
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/ChainSafe/gossamer/dot/types"
+	"github.com/ChainSafe/gossamer/lib/aura"
+	"github.com/ChainSafe/gossamer/lib/beefy"
+	"github.com/ChainSafe/gossamer/lib/runtime/mocks"
+	"github.com/ChainSafe/gossamer/pkg/scale"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func newBabeBlockAtSlot(t *testing.T, slot uint64) *types.Block {
+	t.Helper()
+
+	preDigest, err := types.NewBabeSecondaryPlainPreDigest(0, slot).ToPreRuntimeDigest()
+	require.NoError(t, err)
+	digest := types.NewDigest()
+	require.NoError(t, digest.Add(*preDigest))
+
+	return &types.Block{
+		Header: types.Header{
+			Number: 1,
+			Digest: digest,
+		},
+		Body: types.Body{},
+	}
+}
+
+func Test_blockImporter_checkInherents(t *testing.T) {
+	t.Parallel()
+
+	now := time.Unix(1000, 0)
+	block := newBabeBlockAtSlot(t, 5)
+
+	expectedData := types.NewInherentData()
+	require.NoError(t, expectedData.SetInherent(types.Timstap0, uint64(now.UnixMilli())))
+	require.NoError(t, expectedData.SetInherent(types.Babeslot, uint64(5)))
+
+	errTest := errors.New("test error")
+
+	testCases := map[string]struct {
+		result     *types.CheckInherentsResult
+		checkErr   error
+		errWrapped error
+		errMessage string
+	}{
+		"okay": {
+			result: &types.CheckInherentsResult{Okay: true},
+		},
+		"runtime_error": {
+			checkErr:   errTest,
+			errWrapped: errTest,
+			errMessage: "checking inherents of block 1: test error",
+		},
+		"too_far_in_future": {
+			result: &types.CheckInherentsResult{
+				Errors: map[[8]byte][]byte{
+					types.Timstap0.Bytes(): {byte(types.TimestampTooFarInFuture)},
+				},
+			},
+			errWrapped: errBlockInFuture,
+			errMessage: "block is too far in the future: block 1 (" + block.Header.Hash().String() + ")",
+		},
+		"too_early": {
+			result: &types.CheckInherentsResult{
+				FatalError: true,
+				Errors: map[[8]byte][]byte{
+					types.Timstap0.Bytes(): {byte(types.TimestampTooEarly)},
+				},
+			},
+			errWrapped: errInvalidInherents,
+			errMessage: "invalid inherents: block 1 with errors for inherents [timstap0]",
+		},
+		"other_inherent_error": {
+			result: &types.CheckInherentsResult{
+				FatalError: true,
+				Errors: map[[8]byte][]byte{
+					types.Babeslot.Bytes(): {},
+				},
+			},
+			errWrapped: errInvalidInherents,
+			errMessage: "invalid inherents: block 1 with errors for inherents [babeslot]",
+		},
+		"non_fatal_inherent_error": {
+			result: &types.CheckInherentsResult{
+				Errors: map[[8]byte][]byte{
+					types.Babeslot.Bytes(): {},
+				},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+
+			rt := mocks.NewMockInstance(ctrl)
+			rt.EXPECT().CheckInherents(block, expectedData).Return(testCase.result, testCase.checkErr)
+
+			importer := &blockImporter{
+				now: func() time.Time { return now },
+			}
+
+			err := importer.checkInherents(rt, block)
+
+			assert.ErrorIs(t, err, testCase.errWrapped)
+			if testCase.errWrapped != nil {
+				assert.EqualError(t, err, testCase.errMessage)
+			}
+		})
+	}
+}
+
+func Test_blockImporter_processBlockDataWithHeaderAndBody_verification(t *testing.T) {
+	t.Parallel()
+
+	block := newBabeBlockAtSlot(t, 5)
+	blockData := types.BlockData{
+		Hash:   block.Header.Hash(),
+		Header: &block.Header,
+		Body:   &block.Body,
+	}
+
+	errTest := errors.New("test error")
+
+	testCases := map[string]struct {
+		verifyErr  error
+		errWrapped error
+		errMessage string
+	}{
+		"aura_block_from_future": {
+			verifyErr:  fmt.Errorf("%w: slot 5, current slot 4", aura.ErrBlockFromFuture),
+			errWrapped: errBlockInFuture,
+			errMessage: "block is too far in the future: block from the future: slot 5, current slot 4",
+		},
+		"invalid_block": {
+			verifyErr:  errTest,
+			errWrapped: errTest,
+			errMessage: "babe verifying block: test error",
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+
+			verifier := NewMockBabeVerifier(ctrl)
+			verifier.EXPECT().VerifyBlock(&block.Header).Return(testCase.verifyErr)
+
+			importer := &blockImporter{
+				babeVerifier: verifier,
+			}
+
+			err := importer.processBlockDataWithHeaderAndBody(blockData)
+
+			assert.ErrorIs(t, err, testCase.errWrapped)
+			assert.EqualError(t, err, testCase.errMessage)
+		})
+	}
+}
+
+func Test_blockImporter_importBeefyJustification(t *testing.T) {
+	t.Parallel()
+
+	encodedProof := scale.MustMarshal(beefy.NewVersionedFinalityProof(beefy.SignedCommitment{
+		Commitment: beefy.Commitment{BlockNumber: 8, ValidatorSetID: 1},
+		Signatures: []*beefy.Signature{nil},
+	}))
+	var proof beefy.VersionedFinalityProof
+	err := scale.Unmarshal(encodedProof, &proof)
+	require.NoError(t, err)
+
+	testCases := map[string]struct {
+		justifications types.Justifications
+		importErr      error
+		imported       bool
+	}{
+		"no_beefy_justification": {
+			justifications: types.Justifications{
+				{EngineID: types.GrandpaEngineID, EncodedJustification: []byte{1}},
+			},
+		},
+		"invalid_encoding": {
+			justifications: types.Justifications{
+				{EngineID: types.BeefyEngineID, EncodedJustification: []byte{9}},
+			},
+		},
+		"imported": {
+			justifications: types.Justifications{
+				{EngineID: types.GrandpaEngineID, EncodedJustification: []byte{1}},
+				{EngineID: types.BeefyEngineID, EncodedJustification: encodedProof},
+			},
+			imported: true,
+		},
+		"invalid_justification_is_not_fatal": {
+			justifications: types.Justifications{
+				{EngineID: types.BeefyEngineID, EncodedJustification: encodedProof},
+			},
+			importErr: beefy.ErrNotEnoughSignatures,
+			imported:  true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+
+			beefyImporter := NewMockBeefyJustificationImporter(ctrl)
+			if testCase.imported {
+				beefyImporter.EXPECT().ImportJustification(uint(8), proof).Return(testCase.importErr)
+			}
+
+			importer := &blockImporter{
+				beefyImporter: beefyImporter,
+			}
+
+			importer.importBeefyJustification(&types.BlockData{
+				Header:         &types.Header{Number: 8},
+				Justifications: testCase.justifications,
+			})
+		})
+	}
+}
+
+func Test_newInherentDataToCheck(t *testing.T) {
+	t.Parallel()
+
+	now := time.Unix(1000, 0)
+
+	t.Run("babe_slot", func(t *testing.T) {
+		t.Parallel()
+
+		block := newBabeBlockAtSlot(t, 7)
+
+		data, err := newInherentDataToCheck(&block.Header, now)
+		require.NoError(t, err)
+
+		expected := types.NewInherentData()
+		require.NoError(t, expected.SetInherent(types.Timstap0, uint64(1000000)))
+		require.NoError(t, expected.SetInherent(types.Babeslot, uint64(7)))
+		assert.Equal(t, expected, data)
+	})
+
+	t.Run("no_digest", func(t *testing.T) {
+		t.Parallel()
+
+		header := &types.Header{Number: 1}
+
+		data, err := newInherentDataToCheck(header, now)
+		assert.ErrorIs(t, err, types.ErrChainHeadMissingDigest)
+		assert.Nil(t, data)
+	})
+}
@@ -15,6 +15,7 @@
 	"github.com/ChainSafe/gossamer/dot/peerset"
 	"github.com/ChainSafe/gossamer/dot/types"
 	"github.com/ChainSafe/gossamer/internal/database"
+	"github.com/ChainSafe/gossamer/lib/common"
 
 	"github.com/libp2p/go-libp2p/core/peer"
 )
@@ -28,6 +29,8 @@
 	errNilHeaderInResponse = errors.New("expected header, received none")
 	errNilBodyInResponse   = errors.New("expected body, received none")
 	errBadBlockReceived    = errors.New("bad block received")
+	errBlockInFuture       = errors.New("block is too far in the future")
+	errInvalidInherents    = errors.New("invalid inherents")
 )
 
 // Config is the configuration for the sync Service.
@@ -37,6 +40,7 @@ type FullSyncConfig struct {
 	BabeVerifier       BabeVerifier
 	FinalityGadget     FinalityGadget
 	BlockImportHandler BlockImportHandler
+	BeefyImporter      BeefyJustificationImporter
 	Telemetry          Telemetry
 	BlockState         BlockState
 	BadBlocks          []string
@@ -45,7 +49,7 @@ type FullSyncConfig struct {
 }
 
 type importer interface {
-	importBlock(*types.BlockData, BlockOrigin) (imported bool, err error)
+	importBlock(*types.BlockData) (imported bool, err error)
 }
 
 // FullSyncStrategy protocol is the "default" protocol.
@@ -186,7 +190,8 @@ func (f *FullSyncStrategy) Process(results []*SyncTaskResult) (
 	sortFragmentsOfChain(readyBlocks)
 	orderedFragments := mergeFragmentsOfChain(readyBlocks)
 
-	nextBlocksToImport := make([]*types.BlockData, 0)
+	// the blocks deferred for being too far in the future are tried again first
+	nextBlocksToImport := f.unreadyBlocks.takeFutureBlocks()
 	disjointFragments := make([][]*types.BlockData, 0)
 
 	for _, fragment := range orderedFragments {
@@ -203,10 +208,25 @@ func (f *FullSyncStrategy) Process(results []*SyncTaskResult) (
 		disjointFragments = append(disjointFragments, fragment)
 	}
 
+	// the blocks too far in the future and their descendants are deferred
+	deferred := make(map[common.Hash]struct{})
+
 	// this loop goal is to import ready blocks as well as update the highestFinalized header
 	for len(nextBlocksToImport) > 0 || len(disjointFragments) > 0 {
 		for _, blockToImport := range nextBlocksToImport {
-			imported, err := f.blockImporter.importBlock(blockToImport, networkInitialSync)
+			if _, ok := deferred[blockToImport.Header.ParentHash]; ok {
+				deferred[blockToImport.Hash] = struct{}{}
+				f.unreadyBlocks.newFutureBlock(blockToImport)
+				continue
+			}
+
+			imported, err := f.blockImporter.importBlock(blockToImport)
+			if errors.Is(err, errBlockInFuture) {
+				logger.Debugf("deferring import of block: %s", err)
+				deferred[blockToImport.Hash] = struct{}{}
+				f.unreadyBlocks.newFutureBlock(blockToImport)
+				continue
+			}
 			if err != nil {
 				return false, nil, nil, fmt.Errorf("while handling ready block: %w", err)
 			}
@@ -231,6 +251,12 @@ func (f *FullSyncStrategy) Process(results []*SyncTaskResult) (
 				continue
 			}
 
+			if _, ok := deferred[validFragment[0].Header.ParentHash]; ok {
+				// deferred with its parent on the next iteration
+				nextBlocksToImport = append(nextBlocksToImport, validFragment...)
+				continue
+			}
+
 			ok, err := f.blockState.HasHeader(validFragment[0].Header.ParentHash)
 			if err != nil && !errors.Is(err, database.ErrNotFound) {
 				return false, nil, nil, err
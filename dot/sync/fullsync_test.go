@@ -5,13 +5,20 @@
 
 import (
 	"container/list"
+	"fmt"
 	"testing"
 
 	"github.com/ChainSafe/gossamer/dot/network"
 	"github.com/ChainSafe/gossamer/dot/network/messages"
 	"github.com/ChainSafe/gossamer/dot/peerset"
+	"github.com/ChainSafe/gossamer/dot/state"
 	"github.com/ChainSafe/gossamer/dot/types"
+	"github.com/ChainSafe/gossamer/internal/database"
+	"github.com/ChainSafe/gossamer/lib/aura"
 	"github.com/ChainSafe/gossamer/lib/common"
+	"github.com/ChainSafe/gossamer/lib/runtime/mocks"
+	rtstorage "github.com/ChainSafe/gossamer/lib/runtime/storage"
+	inmemory_trie "github.com/ChainSafe/gossamer/pkg/trie/inmemory"
 	"github.com/libp2p/go-libp2p/core/peer"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
@@ -98,16 +105,18 @@ func TestFullSyncNextActions(t *testing.T) {
 				expectedQueueLen: 0,
 				expectedTasks: []*messages.BlockRequestMessage{
 					{
-						RequestedData: messages.RequestedDataBody,
-						StartingBlock: *messages.NewFromBlock(uint(129)),
-						Direction:     messages.Ascending,
-						Max:           refTo(1),
+						RequestedData:                 messages.RequestedDataBody,
+						StartingBlock:                 *messages.NewFromBlock(uint(129)),
+						Direction:                     messages.Ascending,
+						Max:                           refTo(1),
+						SupportMultipleJustifications: true,
 					},
 					{
-						RequestedData: messages.BootstrapRequestData,
-						StartingBlock: *messages.NewFromBlock(uint(1)),
-						Direction:     messages.Ascending,
-						Max:           refTo(127),
+						RequestedData:                 messages.BootstrapRequestData,
+						StartingBlock:                 *messages.NewFromBlock(uint(1)),
+						Direction:                     messages.Ascending,
+						Max:                           refTo(127),
+						SupportMultipleJustifications: true,
 					},
 				},
 			},
@@ -130,16 +139,18 @@ func TestFullSyncNextActions(t *testing.T) {
 				expectedQueueLen: 1,
 				expectedTasks: []*messages.BlockRequestMessage{
 					{
-						RequestedData: messages.RequestedDataBody,
-						StartingBlock: *messages.NewFromBlock(common.BytesToHash([]byte{0, 1, 1, 2})),
-						Direction:     messages.Ascending,
-						Max:           refTo(1),
+						RequestedData:                 messages.RequestedDataBody,
+						StartingBlock:                 *messages.NewFromBlock(common.BytesToHash([]byte{0, 1, 1, 2})),
+						Direction:                     messages.Ascending,
+						Max:                           refTo(1),
+						SupportMultipleJustifications: true,
 					},
 					{
-						RequestedData: messages.BootstrapRequestData,
-						StartingBlock: *messages.NewFromBlock(uint(1)),
-						Direction:     messages.Ascending,
-						Max:           refTo(127),
+						RequestedData:                 messages.BootstrapRequestData,
+						StartingBlock:                 *messages.NewFromBlock(uint(1)),
+						Direction:                     messages.Ascending,
+						Max:                           refTo(127),
+						SupportMultipleJustifications: true,
 					},
 				},
 			},
@@ -236,7 +247,7 @@ func TestFullSyncProcess(t *testing.T) {
 
 		mockImporter := NewMockimporter(ctrl)
 		mockImporter.EXPECT().
-			importBlock(gomock.AssignableToTypeOf(&types.BlockData{}), networkInitialSync).
+			importBlock(gomock.AssignableToTypeOf(&types.BlockData{})).
 			Return(true, nil).
 			Times(10 + 128 + 128)
 
@@ -290,6 +301,267 @@ func TestFullSyncProcess(t *testing.T) {
 		require.Len(t, fs.unreadyBlocks.incompleteBlocks, 0)
 		require.Len(t, fs.unreadyBlocks.disjointFragments, 0)
 	})
+
+	t.Run("block_in_future_deferred_to_next_process", func(t *testing.T) {
+		newBlockData := func(parent *types.Header, stateRoot byte) *types.BlockData {
+			header := types.NewHeader(parent.Hash(), common.Hash{stateRoot}, common.Hash{},
+				parent.Number+1, types.NewDigest())
+			return &types.BlockData{
+				Hash:   header.Hash(),
+				Header: header,
+				Body:   &types.Body{},
+			}
+		}
+
+		// genesis <- b1 <- b2 <- b3 <- b4
+		//                     <- f3
+		genesisHeader := types.NewHeader(common.Hash{}, common.Hash{}, common.Hash{}, 0, types.NewDigest())
+		b1 := newBlockData(genesisHeader, 1)
+		b2 := newBlockData(b1.Header, 2)
+		b3 := newBlockData(b2.Header, 3)
+		f3 := newBlockData(b2.Header, 4)
+		b4 := newBlockData(b3.Header, 5)
+
+		newResult := func(blocks ...*types.BlockData) *SyncTaskResult {
+			return &SyncTaskResult{
+				who: peer.ID("peerA"),
+				request: messages.NewBlockRequest(*messages.NewFromBlock(blocks[0].Header.Number),
+					uint32(len(blocks)), messages.BootstrapRequestData, messages.Ascending),
+				completed: true,
+				response:  &messages.BlockResponseMessage{BlockData: blocks},
+			}
+		}
+
+		ctrl := gomock.NewController(t)
+		mockBlockState := NewMockBlockState(ctrl)
+		mockBlockState.EXPECT().GetHighestFinalisedHeader().Return(genesisHeader, nil).AnyTimes()
+
+		mockImporter := NewMockimporter(ctrl)
+
+		cfg := &FullSyncConfig{
+			BlockState: mockBlockState,
+		}
+
+		fs := NewFullSyncStrategy(cfg)
+		fs.blockImporter = mockImporter
+
+		// b2 is too far in the future, so its descendant b3 and the fragment of the
+		// fork f3, whose parent is b2, are deferred with it.
+		mockBlockState.EXPECT().HasHeader(genesisHeader.Hash()).Return(true, nil)
+		mockBlockState.EXPECT().HasHeader(b2.Hash).Return(false, nil)
+		gomock.InOrder(
+			mockImporter.EXPECT().importBlock(b1).Return(true, nil),
+			mockImporter.EXPECT().importBlock(b2).
+				Return(false, fmt.Errorf("%w: block 2", errBlockInFuture)),
+		)
+
+		done, _, _, err := fs.Process([]*SyncTaskResult{newResult(b1, b2, b3), newResult(f3)})
+		require.NoError(t, err)
+		require.False(t, done)
+
+		require.Equal(t, []*types.BlockData{b2, b3, f3}, fs.unreadyBlocks.futureBlocks)
+		require.Empty(t, fs.unreadyBlocks.disjointFragments)
+		require.Equal(t, 0, fs.requestQueue.Len())
+		require.Equal(t, 1, fs.syncedBlocks)
+
+		// the deferred blocks are imported first on the next call, before the
+		// fragment received whose parent is one of them.
+		gomock.InOrder(
+			mockBlockState.EXPECT().HasHeader(b3.Hash).Return(false, nil),
+			mockBlockState.EXPECT().HasHeader(b3.Hash).Return(true, nil),
+		)
+		gomock.InOrder(
+			mockImporter.EXPECT().importBlock(b2).Return(true, nil),
+			mockImporter.EXPECT().importBlock(b3).Return(true, nil),
+			mockImporter.EXPECT().importBlock(f3).Return(true, nil),
+			mockImporter.EXPECT().importBlock(b4).Return(true, nil),
+		)
+
+		done, _, _, err = fs.Process([]*SyncTaskResult{newResult(b4)})
+		require.NoError(t, err)
+		require.False(t, done)
+
+		require.Empty(t, fs.unreadyBlocks.futureBlocks)
+		require.Empty(t, fs.unreadyBlocks.disjointFragments)
+		require.Equal(t, 0, fs.requestQueue.Len())
+		require.Equal(t, 5, fs.syncedBlocks)
+	})
+}
+
+// newTestImportingStrategy returns a full sync strategy importing the blocks children of the
+// genesis header returned with a real block importer, whose blocks are verified by the verifier.
+func newTestImportingStrategy(t *testing.T, ctrl *gomock.Controller, verifier BabeVerifier) (
+	*FullSyncStrategy, *types.Header) {
+	t.Helper()
+
+	trieState := rtstorage.NewTrieState(inmemory_trie.NewEmptyTrie())
+	genesisHeader := types.NewHeader(common.Hash{}, trieState.Trie().MustHash(), common.Hash{}, 0, types.NewDigest())
+
+	rt := mocks.NewMockInstance(ctrl)
+	rt.EXPECT().SetContextStorage(trieState).AnyTimes()
+	rt.EXPECT().CheckInherents(gomock.Any(), gomock.Any()).
+		Return(&types.CheckInherentsResult{Okay: true}, nil).AnyTimes()
+	rt.EXPECT().ExecuteBlock(gomock.Any()).Return(nil, nil).AnyTimes()
+
+	blockState := NewMockBlockState(ctrl)
+	blockState.EXPECT().GetHighestFinalisedHeader().Return(genesisHeader, nil).AnyTimes()
+	blockState.EXPECT().HasHeader(genesisHeader.Hash()).Return(true, nil).AnyTimes()
+	blockState.EXPECT().HasHeader(gomock.Any()).Return(false, nil).AnyTimes()
+	blockState.EXPECT().GetHeader(genesisHeader.Hash()).Return(genesisHeader, nil).AnyTimes()
+	blockState.EXPECT().GetRuntime(genesisHeader.Hash()).Return(rt, nil).AnyTimes()
+	blockState.EXPECT().CompareAndSetBlockData(gomock.Any()).Return(nil).AnyTimes()
+
+	storageState := NewMockStorageState(ctrl)
+	storageState.EXPECT().Lock().AnyTimes()
+	storageState.EXPECT().Unlock().AnyTimes()
+	storageState.EXPECT().TrieState(&genesisHeader.StateRoot).Return(trieState, nil).AnyTimes()
+
+	blockImportHandler := NewMockBlockImportHandler(ctrl)
+	blockImportHandler.EXPECT().HandleBlockImport(gomock.Any(), trieState, false).Return(nil).AnyTimes()
+
+	telemetry := NewMockTelemetry(ctrl)
+	telemetry.EXPECT().SendMessage(gomock.Any()).AnyTimes()
+
+	fs := NewFullSyncStrategy(&FullSyncConfig{
+		BlockState:         blockState,
+		StorageState:       storageState,
+		BabeVerifier:       verifier,
+		BlockImportHandler: blockImportHandler,
+		Telemetry:          telemetry,
+	})
+	return fs, genesisHeader
+}
+
+// newTestBlockDataAtSlot returns the block data of an empty BABE block of the slot given.
+func newTestBlockDataAtSlot(t *testing.T, parent *types.Header, slot uint64, extrinsicsRoot byte) *types.BlockData {
+	t.Helper()
+
+	preDigest, err := types.NewBabeSecondaryPlainPreDigest(0, slot).ToPreRuntimeDigest()
+	require.NoError(t, err)
+	digest := types.NewDigest()
+	require.NoError(t, digest.Add(*preDigest))
+
+	header := types.NewHeader(parent.Hash(), parent.StateRoot, common.Hash{extrinsicsRoot}, parent.Number+1, digest)
+	return &types.BlockData{
+		Hash:   header.Hash(),
+		Header: header,
+		Body:   &types.Body{},
+	}
+}
+
+func newTestSyncTaskResult(blocks ...*types.BlockData) *SyncTaskResult {
+	return &SyncTaskResult{
+		who: peer.ID("peerA"),
+		request: messages.NewBlockRequest(*messages.NewFromBlock(blocks[0].Header.Number),
+			uint32(len(blocks)), messages.BootstrapRequestData, messages.Ascending),
+		completed: true,
+		response:  &messages.BlockResponseMessage{BlockData: blocks},
+	}
+}
+
+func TestFullSyncProcess_equivocation(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+
+	db, err := database.NewPebble(t.TempDir(), true)
+	require.NoError(t, err)
+	slotState := state.NewSlotState(db, state.DefaultSlotCapacity)
+	author := types.AuthorityID{1}
+
+	// the verifier checks the headers received from peers for equivocations.
+	verifier := NewMockBabeVerifier(ctrl)
+	verifier.EXPECT().VerifyBlock(gomock.Any()).DoAndReturn(func(header *types.Header) error {
+		slot, err := types.GetSlotFromHeader(header)
+		require.NoError(t, err)
+		_, err = slotState.CheckEquivocation(slot, slot, header, author)
+		return err
+	}).Times(2)
+
+	fs, genesisHeader := newTestImportingStrategy(t, ctrl, verifier)
+
+	// the author of b1 authored the fork f1 in the same slot.
+	const slot = 5
+	b1 := newTestBlockDataAtSlot(t, genesisHeader, slot, 1)
+	f1 := newTestBlockDataAtSlot(t, genesisHeader, slot, 2)
+
+	done, _, _, err := fs.Process([]*SyncTaskResult{newTestSyncTaskResult(b1), newTestSyncTaskResult(f1)})
+	require.NoError(t, err)
+	require.False(t, done)
+	require.Equal(t, 2, fs.syncedBlocks)
+
+	proofs, err := slotState.EquivocationProofs()
+	require.NoError(t, err)
+	require.Len(t, proofs, 1)
+	require.Equal(t, author, proofs[0].Offender)
+	require.Equal(t, uint64(slot), proofs[0].Slot)
+	require.Equal(t, b1.Hash, proofs[0].FirstHeader.Hash())
+	require.Equal(t, f1.Hash, proofs[0].SecondHeader.Hash())
+}
+
+func TestFullSyncProcess_aura(t *testing.T) {
+	t.Parallel()
+
+	newAuraBlockData := func(t *testing.T, parent *types.Header, slot uint64) *types.BlockData {
+		t.Helper()
+		digest := types.NewDigest()
+		require.NoError(t, digest.Add(*types.NewAuraPreRuntimeDigest(slot)))
+		require.NoError(t, digest.Add(types.SealDigest{ConsensusEngineID: types.AuraEngineID, Data: []byte{1}}))
+
+		header := types.NewHeader(parent.Hash(), parent.StateRoot, common.Hash{}, parent.Number+1, digest)
+		return &types.BlockData{
+			Hash:   header.Hash(),
+			Header: header,
+			Body:   &types.Body{},
+		}
+	}
+
+	testCases := map[string]struct {
+		verifyErr          error
+		errWrapped         error
+		syncedBlocks       int
+		futureBlocksNumber []uint
+	}{
+		"valid_block_imported": {
+			syncedBlocks: 1,
+		},
+		"block_from_future_deferred_with_its_descendants": {
+			verifyErr:          fmt.Errorf("%w: slot 6, current slot 5", aura.ErrBlockFromFuture),
+			futureBlocksNumber: []uint{1, 2},
+		},
+		"bad_seal_rejected": {
+			verifyErr:  aura.ErrBadSignature,
+			errWrapped: aura.ErrBadSignature,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+
+			verifier := NewMockBabeVerifier(ctrl)
+			fs, genesisHeader := newTestImportingStrategy(t, ctrl, verifier)
+
+			b1 := newAuraBlockData(t, genesisHeader, 6)
+			blocks := []*types.BlockData{b1}
+			if testCase.futureBlocksNumber != nil {
+				blocks = append(blocks, newAuraBlockData(t, b1.Header, 7))
+			}
+
+			// only the first block is verified, its descendants are deferred with it.
+			verifier.EXPECT().VerifyBlock(b1.Header).Return(testCase.verifyErr)
+
+			_, _, _, err := fs.Process([]*SyncTaskResult{newTestSyncTaskResult(blocks...)})
+
+			require.ErrorIs(t, err, testCase.errWrapped)
+			require.Equal(t, testCase.syncedBlocks, fs.syncedBlocks)
+			require.Len(t, fs.unreadyBlocks.futureBlocks, len(testCase.futureBlocksNumber))
+			for i, number := range testCase.futureBlocksNumber {
+				require.Equal(t, number, fs.unreadyBlocks.futureBlocks[i].Header.Number)
+			}
+		})
+	}
 }
 
 func TestFullSyncBlockAnnounce(t *testing.T) {
@@ -467,16 +739,18 @@ func TestFullSyncBlockAnnounce(t *testing.T) {
 
 			expectedRequests := []messages.P2PMessage{
 				&messages.BlockRequestMessage{
-					RequestedData: messages.RequestedDataBody + messages.RequestedDataJustification,
-					StartingBlock: *messages.NewFromBlock(block17Hash),
-					Direction:     messages.Ascending,
-					Max:           refTo(1),
+					RequestedData:                 messages.RequestedDataBody + messages.RequestedDataJustification,
+					StartingBlock:                 *messages.NewFromBlock(block17Hash),
+					Direction:                     messages.Ascending,
+					Max:                           refTo(1),
+					SupportMultipleJustifications: true,
 				},
 				&messages.BlockRequestMessage{
-					RequestedData: messages.BootstrapRequestData,
-					StartingBlock: *messages.NewFromBlock(uint(1)),
-					Direction:     messages.Ascending,
-					Max:           refTo(17),
+					RequestedData:                 messages.BootstrapRequestData,
+					StartingBlock:                 *messages.NewFromBlock(uint(1)),
+					Direction:                     messages.Ascending,
+					Max:                           refTo(17),
+					SupportMultipleJustifications: true,
 				},
 			}
 
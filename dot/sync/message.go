@@ -61,14 +61,48 @@ func (s *SyncService) CreateBlockResponse(from peer.ID, req *messages.BlockReque
 
 	s.seenBlockSyncRequests.Put(requestHash, numOfRequests+1)
 
+	var response *messages.BlockResponseMessage
 	switch req.Direction {
 	case messages.Ascending:
-		return s.handleAscendingRequest(req)
+		response, err = s.handleAscendingRequest(req)
 	case messages.Descending:
-		return s.handleDescendingRequest(req)
+		response, err = s.handleDescendingRequest(req)
 	default:
 		return nil, fmt.Errorf("%w: %v", errInvalidRequestDirection, req.Direction)
 	}
+	if err != nil {
+		return nil, err
+	}
+
+	if req.SupportMultipleJustifications && req.RequestField(messages.RequestedDataJustification) {
+		s.setJustifications(response)
+	}
+	return response, nil
+}
+
+// setJustifications replaces the single GRANDPA justification of the blocks of the response
+// by the justifications of each consensus engine, for the peers supporting them.
+func (s *SyncService) setJustifications(response *messages.BlockResponseMessage) {
+	for _, blockData := range response.BlockData {
+		var justifications types.Justifications
+		if blockData.Justification != nil {
+			justifications = append(justifications, types.Justification{
+				EngineID:             types.GrandpaEngineID,
+				EncodedJustification: *blockData.Justification,
+			})
+		}
+
+		beefyJustification, err := s.blockState.GetBeefyJustification(blockData.Hash)
+		if err == nil && beefyJustification != nil {
+			justifications = append(justifications, types.Justification{
+				EngineID:             types.BeefyEngineID,
+				EncodedJustification: beefyJustification,
+			})
+		}
+
+		blockData.Justification = nil
+		blockData.Justifications = justifications
+	}
 }
 
 func (s *SyncService) handleAscendingRequest(req *messages.BlockRequestMessage) (
@@ -58,6 +58,29 @@ type args struct {
 				Header: &types.Header{Number: 2},
 			}}},
 		},
+		"ascending_request_multiple_justifications": {
+			blockStateBuilder: func(ctrl *gomock.Controller) BlockState {
+				mockBlockState := NewMockBlockState(ctrl)
+				mockBlockState.EXPECT().BestBlockNumber().Return(uint(1), nil)
+				mockBlockState.EXPECT().GetHashByNumber(uint(1)).Return(common.Hash{1, 2}, nil)
+				mockBlockState.EXPECT().GetJustification(common.Hash{1, 2}).Return([]byte{3}, nil)
+				mockBlockState.EXPECT().GetBeefyJustification(common.Hash{1, 2}).Return([]byte{4}, nil)
+				return mockBlockState
+			},
+			args: args{req: &messages.BlockRequestMessage{
+				RequestedData:                 messages.RequestedDataJustification,
+				StartingBlock:                 *messages.NewFromBlock(uint(0)),
+				Direction:                     messages.Ascending,
+				SupportMultipleJustifications: true,
+			}},
+			want: &messages.BlockResponseMessage{BlockData: []*types.BlockData{{
+				Hash: common.Hash{1, 2},
+				Justifications: types.Justifications{
+					{EngineID: types.GrandpaEngineID, EncodedJustification: []byte{3}},
+					{EngineID: types.BeefyEngineID, EncodedJustification: []byte{4}},
+				},
+			}}},
+		},
 		"ascending_request_start_number_higher": {
 			blockStateBuilder: func(ctrl *gomock.Controller) BlockState {
 				mockBlockState := NewMockBlockState(ctrl)
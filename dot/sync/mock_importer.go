@@ -40,16 +40,16 @@ func (m *Mockimporter) EXPECT() *MockimporterMockRecorder {
 }
 
 // importBlock mocks base method.
-func (m *Mockimporter) importBlock(arg0 *types.BlockData, arg1 BlockOrigin) (bool, error) {
+func (m *Mockimporter) importBlock(arg0 *types.BlockData) (bool, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "importBlock", arg0, arg1)
+	ret := m.ctrl.Call(m, "importBlock", arg0)
 	ret0, _ := ret[0].(bool)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // importBlock indicates an expected call of importBlock.
-func (mr *MockimporterMockRecorder) importBlock(arg0, arg1 any) *gomock.Call {
+func (mr *MockimporterMockRecorder) importBlock(arg0 any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "importBlock", reflect.TypeOf((*Mockimporter)(nil).importBlock), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "importBlock", reflect.TypeOf((*Mockimporter)(nil).importBlock), arg0)
 }
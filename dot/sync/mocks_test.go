@@ -1,9 +1,9 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: github.com/ChainSafe/gossamer/dot/sync (interfaces: Telemetry,BlockState,StorageState,TransactionState,BabeVerifier,FinalityGadget,BlockImportHandler,Network)
+// Source: github.com/ChainSafe/gossamer/dot/sync (interfaces: Telemetry,BlockState,StorageState,TransactionState,BabeVerifier,FinalityGadget,BlockImportHandler,BeefyJustificationImporter,Network)
 //
 // Generated by this command:
 //
-//	mockgen -destination=mocks_test.go -package=sync . Telemetry,BlockState,StorageState,TransactionState,BabeVerifier,FinalityGadget,BlockImportHandler,Network
+//	mockgen -destination=mocks_test.go -package=sync . Telemetry,BlockState,StorageState,TransactionState,BabeVerifier,FinalityGadget,BlockImportHandler,BeefyJustificationImporter,Network
 //
 
 // Package sync is a generated GoMock package.
@@ -17,6 +17,7 @@
 	network "github.com/ChainSafe/gossamer/dot/network"
 	peerset "github.com/ChainSafe/gossamer/dot/peerset"
 	types "github.com/ChainSafe/gossamer/dot/types"
+	beefy "github.com/ChainSafe/gossamer/lib/beefy"
 	common "github.com/ChainSafe/gossamer/lib/common"
 	runtime "github.com/ChainSafe/gossamer/lib/runtime"
 	storage "github.com/ChainSafe/gossamer/lib/runtime/storage"
@@ -141,6 +142,21 @@ func (mr *MockBlockStateMockRecorder) GetAllBlocksAtNumber(arg0 any) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllBlocksAtNumber", reflect.TypeOf((*MockBlockState)(nil).GetAllBlocksAtNumber), arg0)
 }
 
+// GetBeefyJustification mocks base method.
+func (m *MockBlockState) GetBeefyJustification(arg0 common.Hash) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBeefyJustification", arg0)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBeefyJustification indicates an expected call of GetBeefyJustification.
+func (mr *MockBlockStateMockRecorder) GetBeefyJustification(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBeefyJustification", reflect.TypeOf((*MockBlockState)(nil).GetBeefyJustification), arg0)
+}
+
 // GetBlockBody mocks base method.
 func (m *MockBlockState) GetBlockBody(arg0 common.Hash) (*types.Body, error) {
 	m.ctrl.T.Helper()
@@ -643,6 +659,43 @@ func (mr *MockBlockImportHandlerMockRecorder) HandleBlockImport(arg0, arg1, arg2
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HandleBlockImport", reflect.TypeOf((*MockBlockImportHandler)(nil).HandleBlockImport), arg0, arg1, arg2)
 }
 
+// MockBeefyJustificationImporter is a mock of BeefyJustificationImporter interface.
+type MockBeefyJustificationImporter struct {
+	ctrl     *gomock.Controller
+	recorder *MockBeefyJustificationImporterMockRecorder
+}
+
+// MockBeefyJustificationImporterMockRecorder is the mock recorder for MockBeefyJustificationImporter.
+type MockBeefyJustificationImporterMockRecorder struct {
+	mock *MockBeefyJustificationImporter
+}
+
+// NewMockBeefyJustificationImporter creates a new mock instance.
+func NewMockBeefyJustificationImporter(ctrl *gomock.Controller) *MockBeefyJustificationImporter {
+	mock := &MockBeefyJustificationImporter{ctrl: ctrl}
+	mock.recorder = &MockBeefyJustificationImporterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBeefyJustificationImporter) EXPECT() *MockBeefyJustificationImporterMockRecorder {
+	return m.recorder
+}
+
+// ImportJustification mocks base method.
+func (m *MockBeefyJustificationImporter) ImportJustification(arg0 uint, arg1 beefy.VersionedFinalityProof) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ImportJustification", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ImportJustification indicates an expected call of ImportJustification.
+func (mr *MockBeefyJustificationImporterMockRecorder) ImportJustification(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportJustification", reflect.TypeOf((*MockBeefyJustificationImporter)(nil).ImportJustification), arg0, arg1)
+}
+
 // MockNetwork is a mock of Network interface.
 type MockNetwork struct {
 	ctrl     *gomock.Controller
@@ -36,13 +36,6 @@
 	logger = log.NewFromGlobal(log.AddContext("pkg", "sync"))
 )
 
-type BlockOrigin byte
-
-const (
-	networkInitialSync BlockOrigin = iota
-	networkBroadcast
-)
-
 type Network interface {
 	AllConnectedPeersIDs() []peer.ID
 	ReportPeer(change peerset.ReputationChange, p peer.ID)
@@ -64,6 +57,7 @@ type BlockState interface {
 	GetReceipt(common.Hash) ([]byte, error)
 	GetMessageQueue(common.Hash) ([]byte, error)
 	GetJustification(common.Hash) ([]byte, error)
+	GetBeefyJustification(common.Hash) ([]byte, error)
 	SetFinalisedHash(hash common.Hash, round uint64, setID uint64) error
 	SetJustification(hash common.Hash, data []byte) error
 	GetHashByNumber(blockNumber uint) (common.Hash, error)
@@ -16,6 +16,9 @@ type unreadyBlocks struct {
 	mtx               sync.RWMutex
 	incompleteBlocks  map[common.Hash]*types.BlockData
 	disjointFragments [][]*types.BlockData
+	// futureBlocks are the blocks too far in the future to be imported yet,
+	// and their descendants, in the order they should be imported.
+	futureBlocks []*types.BlockData
 }
 
 func newUnreadyBlocks() *unreadyBlocks {
@@ -85,6 +88,7 @@ func (u *unreadyBlocks) updateIncompleteBlocks(chain []*types.BlockData) []*type
 
 		incomplete.Body = blockData.Body
 		incomplete.Justification = blockData.Justification
+		incomplete.Justifications = blockData.Justifications
 
 		delete(u.incompleteBlocks, blockData.Hash)
 		completeBlocks = append(completeBlocks, incomplete)
@@ -93,6 +97,31 @@ func (u *unreadyBlocks) updateIncompleteBlocks(chain []*types.BlockData) []*type
 	return completeBlocks
 }
 
+// newFutureBlock defers the import of a block too far in the future, or of a descendant
+// of such a block
+func (u *unreadyBlocks) newFutureBlock(blockData *types.BlockData) {
+	u.mtx.Lock()
+	defer u.mtx.Unlock()
+
+	for _, futureBlock := range u.futureBlocks {
+		if futureBlock.Hash == blockData.Hash {
+			return
+		}
+	}
+	u.futureBlocks = append(u.futureBlocks, blockData)
+}
+
+// takeFutureBlocks returns the deferred blocks to try importing them again,
+// and removes them from the future blocks
+func (u *unreadyBlocks) takeFutureBlocks() []*types.BlockData {
+	u.mtx.Lock()
+	defer u.mtx.Unlock()
+
+	futureBlocks := u.futureBlocks
+	u.futureBlocks = nil
+	return futureBlocks
+}
+
 func (u *unreadyBlocks) isIncomplete(blockHash common.Hash) bool {
 	u.mtx.RLock()
 	defer u.mtx.RUnlock()
@@ -139,6 +168,10 @@ func (u *unreadyBlocks) removeIrrelevantFragments(finalisedNumber uint) {
 		return value.Header.Number <= finalisedNumber
 	})
 
+	u.futureBlocks = slices.DeleteFunc(u.futureBlocks, func(value *types.BlockData) bool {
+		return value.Header.Number <= finalisedNumber
+	})
+
 	fragmentIdx := 0
 	for _, fragment := range u.disjointFragments {
 		// the fragments are sorted in ascending order
@@ -7,6 +7,7 @@
 	"testing"
 
 	"github.com/ChainSafe/gossamer/dot/types"
+	"github.com/ChainSafe/gossamer/lib/common"
 	"github.com/stretchr/testify/require"
 )
 
@@ -178,4 +179,29 @@ func TestUnreadyBlocks_removeIrrelevantFragments(t *testing.T) {
 		ub.removeIrrelevantFragments(100)
 		require.Len(t, ub.disjointFragments, 3)
 	})
+	t.Run("removing_finalised_future_blocks", func(t *testing.T) {
+		ub := newUnreadyBlocks()
+		ub.futureBlocks = []*types.BlockData{
+			{Header: &types.Header{Number: 99}},
+			{Header: &types.Header{Number: 101}},
+		}
+		ub.removeIrrelevantFragments(100)
+		require.Equal(t, []*types.BlockData{
+			{Header: &types.Header{Number: 101}},
+		}, ub.futureBlocks)
+	})
+}
+
+func TestUnreadyBlocks_futureBlocks(t *testing.T) {
+	ub := newUnreadyBlocks()
+	first := &types.BlockData{Hash: common.Hash{1}, Header: &types.Header{Number: 1}}
+	second := &types.BlockData{Hash: common.Hash{2}, Header: &types.Header{Number: 2}}
+
+	ub.newFutureBlock(first)
+	ub.newFutureBlock(second)
+	// the same block deferred again is only kept once
+	ub.newFutureBlock(first)
+
+	require.Equal(t, []*types.BlockData{first, second}, ub.takeFutureBlocks())
+	require.Empty(t, ub.takeFutureBlocks())
 }
@@ -91,6 +91,23 @@ func (s *syncWorkerPool) submitRequests(tasks []*SyncTask) []*SyncTaskResult {
 		workerPool <- worker
 	}
 
+	// the workers failing a request are not given back to the pool, once all of them
+	// failed the tasks waiting for a worker are completed without being executed.
+	noWorkersLeft := make(chan struct{})
+	var workersLeftMtx sync.Mutex
+	workersLeft := len(pids)
+	if workersLeft == 0 {
+		close(noWorkersLeft)
+	}
+	workerFailed := func() {
+		workersLeftMtx.Lock()
+		defer workersLeftMtx.Unlock()
+		workersLeft--
+		if workersLeft == 0 {
+			close(noWorkersLeft)
+		}
+	}
+
 	failedTasks := make(chan *SyncTask, len(tasks))
 	results := make(chan *SyncTaskResult, len(tasks))
 
@@ -99,7 +116,7 @@ func (s *syncWorkerPool) submitRequests(tasks []*SyncTask) []*SyncTaskResult {
 		wg.Add(1)
 		go func(t *SyncTask) {
 			defer wg.Done()
-			executeTask(t, workerPool, failedTasks, results)
+			executeTask(t, workerPool, noWorkersLeft, workerFailed, failedTasks, results)
 		}(task)
 	}
 
@@ -107,19 +124,11 @@ func (s *syncWorkerPool) submitRequests(tasks []*SyncTask) []*SyncTaskResult {
 	go func() {
 		defer wg.Done()
 		for task := range failedTasks {
-			if len(workerPool) > 0 {
-				wg.Add(1)
-				go func(t *SyncTask) {
-					defer wg.Done()
-					executeTask(t, workerPool, failedTasks, results)
-				}(task)
-			} else {
-				results <- &SyncTaskResult{
-					completed: false,
-					request:   task.request,
-					response:  nil,
-				}
-			}
+			wg.Add(1)
+			go func(t *SyncTask) {
+				defer wg.Done()
+				executeTask(t, workerPool, noWorkersLeft, workerFailed, failedTasks, results)
+			}(task)
 		}
 	}()
 
@@ -147,13 +156,25 @@ func (s *syncWorkerPool) submitRequests(tasks []*SyncTask) []*SyncTaskResult {
 	return <-allResults
 }
 
-func executeTask(task *SyncTask, workerPool chan peer.ID, failedTasks chan *SyncTask, results chan *SyncTaskResult) {
-	worker := <-workerPool
+func executeTask(task *SyncTask, workerPool chan peer.ID, noWorkersLeft <-chan struct{}, workerFailed func(),
+	failedTasks chan *SyncTask, results chan *SyncTaskResult) {
+	var worker peer.ID
+	select {
+	case worker = <-workerPool:
+	case <-noWorkersLeft:
+		results <- &SyncTaskResult{
+			completed: false,
+			request:   task.request,
+			response:  nil,
+		}
+		return
+	}
 	logger.Infof("[EXECUTING] worker %s", worker)
 
 	err := task.requestMaker.Do(worker, task.request, task.response)
 	if err != nil {
 		logger.Infof("[ERR] worker %s, request: %s, err: %s", worker, task.request.String(), err.Error())
+		workerFailed()
 		failedTasks <- task
 	} else {
 		logger.Infof("[FINISHED] worker %s, request: %s", worker, task.request.String())
@@ -0,0 +1,84 @@
+// Copyright 2024 ChainSafe Systems (ON)
+// SPDX-License-Identifier: LGPL-3.0-only
+
+package sync
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ChainSafe/gossamer/dot/network/messages"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestSyncWorkerPool_submitRequests(t *testing.T) {
+	t.Parallel()
+
+	errTest := errors.New("test error")
+	const goodWorker, badWorker = peer.ID("good"), peer.ID("bad")
+
+	testCases := map[string]struct {
+		workers           []peer.ID
+		tasks             int
+		completedRequests int
+	}{
+		"no_workers": {
+			tasks: 2,
+		},
+		"all_workers_fail": {
+			workers: []peer.ID{badWorker, "other bad"},
+			tasks:   3,
+		},
+		"failed_tasks_retried_on_other_workers": {
+			workers:           []peer.ID{badWorker, goodWorker},
+			tasks:             3,
+			completedRequests: 3,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+
+			requestMaker := NewMockRequestMaker(ctrl)
+			requestMaker.EXPECT().Do(gomock.Any(), gomock.Any(), gomock.Any()).
+				DoAndReturn(func(who peer.ID, _, _ messages.P2PMessage) error {
+					if who == goodWorker {
+						return nil
+					}
+					return errTest
+				}).AnyTimes()
+
+			pool := newSyncWorkerPool(nil)
+			for _, worker := range testCase.workers {
+				err := pool.fromBlockAnnounceHandshake(worker)
+				require.NoError(t, err)
+			}
+
+			tasks := make([]*SyncTask, testCase.tasks)
+			for i := range tasks {
+				tasks[i] = &SyncTask{
+					requestMaker: requestMaker,
+					request: messages.NewBlockRequest(*messages.NewFromBlock(uint(i)), 1,
+						messages.BootstrapRequestData, messages.Ascending),
+				}
+			}
+
+			results := pool.submitRequests(tasks)
+			require.Len(t, results, testCase.tasks)
+
+			var completedRequests int
+			for _, result := range results {
+				if result.completed {
+					require.Equal(t, goodWorker, result.who)
+					completedRequests++
+				}
+			}
+			require.Equal(t, testCase.completedRequests, completedRequests)
+		})
+	}
+}
@@ -0,0 +1,48 @@
+// Copyright 2024 ChainSafe Systems (ON)
+// SPDX-License-Identifier: LGPL-3.0-only
+
+package telemetry
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/ChainSafe/gossamer/lib/common"
+)
+
+type babeEquivocationTM BabeEquivocation
+
+var _ json.Marshaler = (*BabeEquivocation)(nil)
+
+// BabeEquivocation holds `babe.equivocation` telemetry message, which is
+// supposed to be sent when a BABE authority authored two blocks in the same slot.
+type BabeEquivocation struct {
+	Slot         string      `json:"slot"`
+	Offender     string      `json:"offender"`
+	FirstHeader  common.Hash `json:"first_header"`
+	SecondHeader common.Hash `json:"second_header"`
+}
+
+// NewBabeEquivocation gets a new BabeEquivocation struct.
+func NewBabeEquivocation(slot, offender string, firstHeader, secondHeader common.Hash) *BabeEquivocation {
+	return &BabeEquivocation{
+		Slot:         slot,
+		Offender:     offender,
+		FirstHeader:  firstHeader,
+		SecondHeader: secondHeader,
+	}
+}
+
+func (be BabeEquivocation) MarshalJSON() ([]byte, error) {
+	telemetryData := struct {
+		babeEquivocationTM
+		MessageType string    `json:"msg"`
+		Timestamp   time.Time `json:"ts"`
+	}{
+		Timestamp:          time.Now(),
+		MessageType:        babeEquivocationMsg,
+		babeEquivocationTM: babeEquivocationTM(be),
+	}
+
+	return json.Marshal(telemetryData)
+}
@@ -275,6 +275,17 @@ func TestTelemetryMarshalMessage(t *testing.T) {
 				`"msg":"afg.received_commit","ts":"[0-9]{4}-[0-9]{2}-[0-9]{2}T[0-9]{2}:[0-9]{2}:` +
 				`[0-9]{2}.[0-9]+Z|([+-][0-9]{2}:[0-9]{2})"}$`,
 		},
+		"BabeEquivocation_marshal": {
+			message: &BabeEquivocation{
+				Slot:         "1",
+				Offender:     "0x0",
+				FirstHeader:  common.Hash{},
+				SecondHeader: common.Hash{},
+			},
+			expected: `^{"slot":"1","offender":"0x0","first_header":"0x[0]{64}","second_header":"0x[0]{64}",` +
+				`"msg":"babe.equivocation","ts":"[0-9]{4}-[0-9]{2}-[0-9]{2}T[0-9]{2}:[0-9]{2}:` +
+				`[0-9]{2}.[0-9]+Z|([+-][0-9]{2}:[0-9]{2})"}$`,
+		},
 		"BlockImport_marshal": {
 			message: &BlockImport{
 				BestHash: &common.Hash{},
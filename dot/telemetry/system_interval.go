@@ -26,6 +26,8 @@ type SystemInterval struct {
 	FinalisedHeight    uint         `json:"finalized_height,omitempty"`
 	TxCount            *big.Int     `json:"txcount,omitempty"`
 	UsedStateCacheSize *big.Int     `json:"used_state_cache_size,omitempty"`
+	// ProtocolBandwidth is the bandwidth used per network protocol and peer role.
+	ProtocolBandwidth []common.ProtocolBandwidth `json:"bandwidth_per_protocol,omitempty"`
 }
 
 // NewBandwidth function to create new Bandwidth Telemetry Message
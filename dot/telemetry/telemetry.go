@@ -15,6 +15,8 @@
 	afgApplyingScheduledAuthoritySetChangeMsg = "afg.applying_scheduled_authority_set_change"
 	afgApplyingForcedAuthoritySetChangeMsg    = "afg.applying_forced_authority_set_change"
 
+	babeEquivocationMsg = "babe.equivocation"
+
 	blockImportMsg = "block.import"
 
 	notifyFinalizedMsg = "notify.finalized"
@@ -0,0 +1,91 @@
+// Copyright 2024 ChainSafe Systems (ON)
+// SPDX-License-Identifier: LGPL-3.0-only
+
+package types
+
+import (
+	"fmt"
+
+	"github.com/ChainSafe/gossamer/pkg/scale"
+)
+
+// AuraEpochLength is the epoch length, in slots, recorded in the epoch state of the Aura
+// chains. Aura has no epochs, but the epoch state is shared with BABE and needs one.
+const AuraEpochLength uint64 = 600
+
+// AuraConfiguration is the configuration of the Aura consensus engine returned by the AuraApi.
+type AuraConfiguration struct {
+	SlotDuration uint64 // milliseconds
+	Authorities  []AuthorityID
+}
+
+// BabeConfiguration returns the BABE configuration used to initialise the epoch state
+// of an Aura chain, with the slot duration and the authorities of the Aura configuration.
+func (c *AuraConfiguration) BabeConfiguration() *BabeConfiguration {
+	authorities := make([]AuthorityRaw, len(c.Authorities))
+	for i, authority := range c.Authorities {
+		authorities[i] = AuthorityRaw{
+			Key:    authority,
+			Weight: 1,
+		}
+	}
+
+	return &BabeConfiguration{
+		SlotDuration:       c.SlotDuration,
+		EpochLength:        AuraEpochLength,
+		GenesisAuthorities: authorities,
+	}
+}
+
+// NewAuraPreRuntimeDigest returns the Aura PreRuntimeDigest of the slot given.
+func NewAuraPreRuntimeDigest(slot uint64) *PreRuntimeDigest {
+	return &PreRuntimeDigest{
+		ConsensusEngineID: AuraEngineID,
+		Data:              scale.MustMarshal(slot),
+	}
+}
+
+// DecodeAuraPreDigest decodes the slot of an Aura PreRuntimeDigest data.
+func DecodeAuraPreDigest(data []byte) (slot uint64, err error) {
+	err = scale.Unmarshal(data, &slot)
+	if err != nil {
+		return 0, fmt.Errorf("decoding aura slot: %w", err)
+	}
+	return slot, nil
+}
+
+// IsAuraHeader returns true if the first digest item of the header is an Aura PreRuntimeDigest.
+func IsAuraHeader(header *Header) bool {
+	if len(header.Digest) == 0 {
+		return false
+	}
+
+	digestValue, err := header.Digest[0].Value()
+	if err != nil {
+		return false
+	}
+	preDigest, ok := digestValue.(PreRuntimeDigest)
+	return ok && preDigest.ConsensusEngineID == AuraEngineID
+}
+
+// slotFromPreDigest returns the slot of a BABE or Aura PreRuntimeDigest.
+func slotFromPreDigest(preDigest PreRuntimeDigest) (uint64, error) {
+	if preDigest.ConsensusEngineID == AuraEngineID {
+		return DecodeAuraPreDigest(preDigest.Data)
+	}
+
+	digest, err := DecodeBabePreDigest(preDigest.Data)
+	if err != nil {
+		return 0, fmt.Errorf("cannot decode BabePreDigest from pre-digest: %s", err)
+	}
+
+	switch d := digest.(type) {
+	case BabePrimaryPreDigest:
+		return d.SlotNumber, nil
+	case BabeSecondaryVRFPreDigest:
+		return d.SlotNumber, nil
+	case BabeSecondaryPlainPreDigest:
+		return d.SlotNumber, nil
+	}
+	return 0, nil
+}
@@ -0,0 +1,63 @@
+// Copyright 2024 ChainSafe Systems (ON)
+// SPDX-License-Identifier: LGPL-3.0-only
+
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAuraSlotFromHeader(t *testing.T) {
+	t.Parallel()
+
+	header := NewEmptyHeader()
+	header.Number = 1
+	err := header.Digest.Add(*NewAuraPreRuntimeDigest(42))
+	require.NoError(t, err)
+
+	slot, err := GetSlotFromHeader(header)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(42), slot)
+
+	slot, err = header.SlotNumber()
+	require.NoError(t, err)
+	assert.Equal(t, uint64(42), slot)
+}
+
+func TestAuraConfiguration_BabeConfiguration(t *testing.T) {
+	t.Parallel()
+
+	cfg := &AuraConfiguration{
+		SlotDuration: 6000,
+		Authorities:  []AuthorityID{{1}, {2}},
+	}
+
+	expected := &BabeConfiguration{
+		SlotDuration: 6000,
+		EpochLength:  AuraEpochLength,
+		GenesisAuthorities: []AuthorityRaw{
+			{Key: AuthorityID{1}, Weight: 1},
+			{Key: AuthorityID{2}, Weight: 1},
+		},
+	}
+	assert.Equal(t, expected, cfg.BabeConfiguration())
+}
+
+func TestIsAuraHeader(t *testing.T) {
+	t.Parallel()
+
+	auraHeader := NewEmptyHeader()
+	err := auraHeader.Digest.Add(*NewAuraPreRuntimeDigest(42))
+	require.NoError(t, err)
+	assert.True(t, IsAuraHeader(auraHeader))
+
+	babeHeader := NewEmptyHeader()
+	err = babeHeader.Digest.Add(*NewBABEPreRuntimeDigest([]byte{1}))
+	require.NoError(t, err)
+	assert.False(t, IsAuraHeader(babeHeader))
+
+	assert.False(t, IsAuraHeader(NewEmptyHeader()))
+}
@@ -106,7 +106,7 @@ type ConfigData struct {
 	SecondarySlots byte
 }
 
-// GetSlotFromHeader returns the BABE slot from the given header
+// GetSlotFromHeader returns the BABE or Aura slot from the given header
 func GetSlotFromHeader(header *Header) (uint64, error) {
 	if header.Number == 0 {
 		return 0, ErrGenesisHeader
@@ -125,22 +125,7 @@ func GetSlotFromHeader(header *Header) (uint64, error) {
 		return 0, fmt.Errorf("%w: got %T", ErrNoFirstPreDigest, digestValue)
 	}
 
-	digest, err := DecodeBabePreDigest(preDigest.Data)
-	if err != nil {
-		return 0, fmt.Errorf("cannot decode BabePreDigest from pre-digest: %s", err)
-	}
-
-	var slotNumber uint64
-	switch d := digest.(type) {
-	case BabePrimaryPreDigest:
-		slotNumber = d.SlotNumber
-	case BabeSecondaryVRFPreDigest:
-		slotNumber = d.SlotNumber
-	case BabeSecondaryPlainPreDigest:
-		slotNumber = d.SlotNumber
-	}
-
-	return slotNumber, nil
+	return slotFromPreDigest(preDigest)
 }
 
 // IsPrimary returns true if the block was authored in a primary slot, false otherwise.
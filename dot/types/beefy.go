@@ -0,0 +1,16 @@
+// Copyright 2024 ChainSafe Systems (ON)
+// SPDX-License-Identifier: LGPL-3.0-only
+
+package types
+
+// BeefyAuthorityIDLength is the length of a BEEFY authority id, a compressed ECDSA public key.
+const BeefyAuthorityIDLength = 33
+
+// BeefyAuthorityID is the compressed ECDSA public key of a BEEFY authority.
+type BeefyAuthorityID [BeefyAuthorityIDLength]byte
+
+// BeefyValidatorSet is a set of BEEFY authorities with its unique id.
+type BeefyValidatorSet struct {
+	Validators []BeefyAuthorityID
+	ID         uint64
+}
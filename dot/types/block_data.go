@@ -19,6 +19,29 @@ type BlockData struct {
 	Receipt       *[]byte
 	MessageQueue  *[]byte
 	Justification *[]byte
+	// Justifications are the justifications of the block of each consensus engine, sent by
+	// the peers supporting multiple justifications. They are not part of the encoding.
+	Justifications Justifications `scale:"-"`
+}
+
+// Justification is the encoded justification of a block for a consensus engine.
+type Justification struct {
+	EngineID             ConsensusEngineID
+	EncodedJustification []byte
+}
+
+// Justifications are the justifications of a block, at most one for each consensus engine.
+type Justifications []Justification
+
+// Get returns the encoded justification of the consensus engine given, and false if there
+// is no justification for this consensus engine.
+func (j Justifications) Get(engineID ConsensusEngineID) (encoded []byte, ok bool) {
+	for _, justification := range j {
+		if justification.EngineID == engineID {
+			return justification.EncodedJustification, true
+		}
+	}
+	return nil, false
 }
 
 // NewEmptyBlockData Creates an empty blockData struct
@@ -60,5 +83,10 @@ func (bd *BlockData) String() string {
 		str = str + fmt.Sprintf("Justification=0x%x ", bd.Justification)
 	}
 
+	for _, justification := range bd.Justifications {
+		str = str + fmt.Sprintf("Justification(%s)=0x%x ",
+			justification.EngineID, justification.EncodedJustification)
+	}
+
 	return str
 }
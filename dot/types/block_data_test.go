@@ -182,3 +182,29 @@ func TestBlockDataEncodeAndDecodeAll(t *testing.T) {
 	}
 	require.Equal(t, bd, block)
 }
+
+func TestJustifications(t *testing.T) {
+	t.Parallel()
+
+	justifications := Justifications{
+		{EngineID: GrandpaEngineID, EncodedJustification: []byte{1, 2}},
+		{EngineID: BeefyEngineID, EncodedJustification: []byte{3}},
+	}
+
+	// encoded as a vector of consensus engine id and encoded justification pairs.
+	enc, err := scale.Marshal(justifications)
+	require.NoError(t, err)
+	require.Equal(t, common.MustHexToBytes("0x08"+"46524e4b"+"080102"+"42454546"+"0403"), enc)
+
+	var decoded Justifications
+	err = scale.Unmarshal(enc, &decoded)
+	require.NoError(t, err)
+	require.Equal(t, justifications, decoded)
+
+	encoded, ok := decoded.Get(BeefyEngineID)
+	require.True(t, ok)
+	require.Equal(t, []byte{3}, encoded)
+
+	_, ok = decoded.Get(BabeEngineID)
+	require.False(t, ok)
+}
@@ -143,6 +143,12 @@ func (h ConsensusEngineID) String() string {
 // GrandpaEngineID is the hard-coded grandpa ID
 var GrandpaEngineID = ConsensusEngineID{'F', 'R', 'N', 'K'}
 
+// AuraEngineID is the hard-coded aura ID
+var AuraEngineID = ConsensusEngineID{'a', 'u', 'r', 'a'}
+
+// BeefyEngineID is the hard-coded beefy ID
+var BeefyEngineID = ConsensusEngineID{'B', 'E', 'E', 'F'}
+
 // PreRuntimeDigest contains messages from the consensus engine to the runtime.
 type PreRuntimeDigest digestItem
 
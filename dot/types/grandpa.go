@@ -197,6 +197,17 @@ func (v GrandpaVote) String() string {
 	return fmt.Sprintf("hash=%s number=%d", v.Hash, v.Number)
 }
 
+// GrandpaRoundState is the state of the GRANDPA round our authority is voting in, with the
+// votes it signed in the round. It is persisted so a restarted authority does not vote twice
+// in the round.
+type GrandpaRoundState struct {
+	Round           uint64
+	SetID           uint64
+	PrimaryProposal *GrandpaSignedVote
+	Prevote         *GrandpaSignedVote
+	Precommit       *GrandpaSignedVote
+}
+
 // GrandpaEquivocation is used to create a proof of equivocation
 // https://github.com/paritytech/finality-grandpa/blob/19d251d0b0105d51a79d3c4532a9aae75a5035bd/src/lib.rs#L213 //nolint:lll
 type GrandpaEquivocation struct {
@@ -111,19 +111,11 @@ func (bh *Header) SlotNumber() (uint64, error) {
 			continue
 		}
 
-		digest, err := DecodeBabePreDigest(predigest.Data)
+		slot, err := slotFromPreDigest(predigest)
 		if err != nil {
-			return 0, fmt.Errorf("failed to decode babe header: %w", err)
-		}
-
-		switch d := digest.(type) {
-		case BabePrimaryPreDigest:
-			return d.SlotNumber, nil
-		case BabeSecondaryVRFPreDigest:
-			return d.SlotNumber, nil
-		case BabeSecondaryPlainPreDigest:
-			return d.SlotNumber, nil
+			return 0, fmt.Errorf("failed to decode pre-runtime digest: %w", err)
 		}
+		return slot, nil
 	}
 
 	return 0, ErrNoPreRuntimeDigest
@@ -27,6 +27,8 @@
 	Parachn0
 	// Newheads is an inherent key for new minimally-attested parachain heads.
 	Newheads
+	// Auraslot is the Aura inherent identifier.
+	Auraslot
 )
 
 // Bytes returns a byte array of given inherent identifier.
@@ -44,6 +46,8 @@ func (ii InherentIdentifier) Bytes() [8]byte {
 		copy(kb[:], []byte("parachn0"))
 	case Newheads:
 		copy(kb[:], []byte("newheads"))
+	case Auraslot:
+		copy(kb[:], []byte("auraslot"))
 	default:
 		panic("invalid inherent identifier")
 	}
@@ -125,3 +129,31 @@ func (d *InherentData) Encode() ([]byte, error) {
 
 	return buffer.Bytes(), nil
 }
+
+// TimestampInherentError is the error of the timestamp inherent checked by the runtime.
+type TimestampInherentError byte
+
+const (
+	// TimestampTooEarly is returned if the time between the block and its parent is too short.
+	TimestampTooEarly TimestampInherentError = iota
+	// TimestampTooFarInFuture is returned if the timestamp of the block is too far in the future.
+	TimestampTooFarInFuture
+)
+
+// CheckInherentsResult is the result of checking the inherents of a block with the
+// BlockBuilder_check_inherents runtime API function.
+type CheckInherentsResult struct {
+	// Okay is true if all the inherents of the block are valid.
+	Okay bool
+	// FatalError is true if one of the errors makes the block invalid.
+	FatalError bool
+	// Errors contains the scale encoded errors of the inherents, by inherent key.
+	Errors map[[8]byte][]byte
+}
+
+// InherentError returns the scale encoded error of the inherent, and false if the inherent
+// has no error.
+func (r *CheckInherentsResult) InherentError(inherentIdentifier InherentIdentifier) ([]byte, bool) {
+	err, ok := r.Errors[inherentIdentifier.Bytes()]
+	return err, ok
+}
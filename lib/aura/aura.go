@@ -0,0 +1,275 @@
+// Copyright 2024 ChainSafe Systems (ON)
+// SPDX-License-Identifier: LGPL-3.0-only
+
+// Package aura implements the Aura consensus engine, where the authorities take turns
+// to author a block in each slot in a round-robin fashion.
+package aura
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/ChainSafe/gossamer/dot/telemetry"
+	"github.com/ChainSafe/gossamer/dot/types"
+	"github.com/ChainSafe/gossamer/internal/log"
+	"github.com/ChainSafe/gossamer/lib/babe"
+	"github.com/ChainSafe/gossamer/lib/crypto/sr25519"
+)
+
+var logger = log.NewFromGlobal(log.AddContext("pkg", "aura"))
+
+var slotEngine = babe.SlotEngine{
+	EngineID:     types.AuraEngineID,
+	SlotInherent: types.Auraslot,
+}
+
+// Service authors the blocks of the slots assigned to its authority key.
+type Service struct {
+	ctx          context.Context
+	cancel       context.CancelFunc
+	authority    bool
+	slotDuration time.Duration
+
+	blockState         BlockState
+	storageState       StorageState
+	transactionState   babe.TransactionState
+	blockImportHandler BlockImportHandler
+
+	keypair *sr25519.Keypair
+
+	sync.RWMutex
+	pause chan struct{}
+
+	telemetry Telemetry
+	wg        sync.WaitGroup
+}
+
+// ServiceConfig represents an Aura configuration
+type ServiceConfig struct {
+	LogLvl             log.Level
+	BlockState         BlockState
+	StorageState       StorageState
+	TransactionState   babe.TransactionState
+	BlockImportHandler BlockImportHandler
+	Keypair            *sr25519.Keypair
+	SlotDuration       time.Duration
+	Authority          bool
+	Telemetry          Telemetry
+}
+
+// NewService creates an Aura service.
+func NewService(cfg *ServiceConfig) (*Service, error) {
+	if cfg.Keypair == nil && cfg.Authority {
+		return nil, errNoAuthorityKeyProvided
+	}
+
+	logger.Patch(log.SetLevel(cfg.LogLvl))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	service := &Service{
+		ctx:                ctx,
+		cancel:             cancel,
+		authority:          cfg.Authority,
+		slotDuration:       cfg.SlotDuration,
+		blockState:         cfg.BlockState,
+		storageState:       cfg.StorageState,
+		transactionState:   cfg.TransactionState,
+		blockImportHandler: cfg.BlockImportHandler,
+		keypair:            cfg.Keypair,
+		pause:              make(chan struct{}),
+		telemetry:          cfg.Telemetry,
+	}
+
+	logger.Debugf("created service with block producer ID=%v and slot duration %s",
+		cfg.Authority, cfg.SlotDuration)
+
+	return service, nil
+}
+
+// Start starts Aura block authoring
+func (s *Service) Start() error {
+	if !s.authority {
+		return nil
+	}
+
+	s.wg.Add(1)
+	go func() {
+		s.run()
+		s.wg.Done()
+	}()
+	return nil
+}
+
+// Stop stops the service. If stop is called, it cannot be resumed.
+func (s *Service) Stop() error {
+	if !s.authority {
+		return nil
+	}
+
+	s.Lock()
+	defer s.Unlock()
+
+	if s.ctx.Err() != nil {
+		return errServiceStopped
+	}
+
+	s.cancel()
+	s.wg.Wait()
+	return nil
+}
+
+// Pause pauses the service ie. halts block production
+func (s *Service) Pause() error {
+	s.Lock()
+	defer s.Unlock()
+
+	if s.IsPaused() {
+		return nil
+	}
+
+	close(s.pause)
+	return nil
+}
+
+// Resume resumes the service ie. resumes block production
+func (s *Service) Resume() error {
+	s.Lock()
+	defer s.Unlock()
+
+	if !s.IsPaused() {
+		return nil
+	}
+
+	s.pause = make(chan struct{})
+	s.wg.Add(1)
+	go func() {
+		s.run()
+		s.wg.Done()
+	}()
+	logger.Debug("service resumed")
+	return nil
+}
+
+// IsPaused returns if the service is paused or not (ie. producing blocks)
+func (s *Service) IsPaused() bool {
+	select {
+	case <-s.pause:
+		return true
+	default:
+		return false
+	}
+}
+
+// SlotDuration returns the slot duration in milliseconds
+func (s *Service) SlotDuration() uint64 {
+	return uint64(s.slotDuration.Milliseconds()) //nolint:gosec
+}
+
+// EpochLength returns the epoch length recorded in the epoch state, Aura having no epochs.
+func (*Service) EpochLength() uint64 {
+	return types.AuraEpochLength
+}
+
+// run authors blocks in the slots of our authority until the service is stopped or paused.
+func (s *Service) run() {
+	s.RLock()
+	pause := s.pause
+	s.RUnlock()
+
+	ctx, cancel := context.WithCancel(s.ctx)
+	defer cancel()
+	go func() {
+		select {
+		case <-pause:
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+
+	ticker := babe.NewSlotTicker(s.slotDuration)
+	for {
+		slot, err := ticker.WaitForNextSlot(ctx)
+		if err != nil {
+			return
+		}
+
+		err = s.handleSlot(slot)
+		if errors.Is(err, errNotOurSlot) {
+			continue
+		} else if err != nil {
+			logger.Warnf("failed to handle slot %d: %s", slot.Number(), err)
+		}
+	}
+}
+
+var errNotOurSlot = errors.New("not our slot")
+
+// handleSlot authors a block on top of the best block if our authority is the author of the slot.
+func (s *Service) handleSlot(slot babe.Slot) error {
+	parent, err := s.blockState.BestBlockHeader()
+	if err != nil {
+		return fmt.Errorf("getting best block header: %w", err)
+	}
+
+	if parent.Hash() != s.blockState.GenesisHash() {
+		parentSlot, err := types.GetSlotFromHeader(parent)
+		if err != nil {
+			return fmt.Errorf("getting best block slot: %w", err)
+		}
+		if parentSlot >= slot.Number() {
+			return fmt.Errorf("%w: best block slot is %d and got slot %d",
+				ErrSlotNotIncreasing, parentSlot, slot.Number())
+		}
+	}
+
+	// there is a chance that the best block header may change in the course of building the block,
+	// so let's copy it first.
+	parent, err = parent.DeepCopy()
+	if err != nil {
+		return fmt.Errorf("copying parent header: %w", err)
+	}
+
+	s.storageState.Lock()
+	defer s.storageState.Unlock()
+
+	cfg, ts, rt, err := configurationAt(s.blockState, s.storageState, parent)
+	if err != nil {
+		return err
+	}
+
+	author, err := slotAuthor(slot.Number(), cfg.Authorities)
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(author[:], s.keypair.Public().Encode()) {
+		return errNotOurSlot
+	}
+
+	builder := babe.NewSlotEngineBlockBuilder(slotEngine, s.keypair, s.transactionState, s.blockState,
+		types.NewAuraPreRuntimeDigest(slot.Number()))
+	block, err := builder.BuildBlock(parent, slot, rt)
+	if err != nil {
+		return fmt.Errorf("building block: %w", err)
+	}
+
+	logger.Infof("built block %d with hash %s, state root %s and slot %d",
+		block.Header.Number, block.Header.Hash(), block.Header.StateRoot, slot.Number())
+
+	s.telemetry.SendMessage(
+		telemetry.NewPreparedBlockForProposing(
+			block.Header.Hash(),
+			fmt.Sprint(block.Header.Number),
+		),
+	)
+
+	err = s.blockImportHandler.HandleBlockProduced(block, ts)
+	if err != nil {
+		return fmt.Errorf("importing built block: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,27 @@
+// Copyright 2024 ChainSafe Systems (ON)
+// SPDX-License-Identifier: LGPL-3.0-only
+
+package aura
+
+import "errors"
+
+var (
+	// ErrBadSignature is returned when a seal is invalid
+	ErrBadSignature = errors.New("could not verify signature")
+
+	// ErrNoAuthorities is returned when the runtime has no Aura authorities
+	ErrNoAuthorities = errors.New("no aura authorities")
+
+	// ErrBlockFromFuture is returned when the slot of a block has not started yet,
+	// the import of the block is then deferred until its slot starts
+	ErrBlockFromFuture = errors.New("block from the future")
+
+	// ErrSlotNotIncreasing is returned when the slot of a block is not greater than the slot of its parent
+	ErrSlotNotIncreasing = errors.New("slot not greater than parent slot")
+
+	errNoAuthorityKeyProvided = errors.New("cannot create Aura service as authority; no keypair provided")
+	errNoPreRuntimeDigest     = errors.New("no aura pre-runtime digest")
+	errLastDigestItemNotSeal  = errors.New("last digest item is not an aura seal")
+	errMissingDigestItems     = errors.New("block header is missing digest items")
+	errServiceStopped         = errors.New("service already stopped")
+)
@@ -0,0 +1,36 @@
+// Copyright 2024 ChainSafe Systems (ON)
+// SPDX-License-Identifier: LGPL-3.0-only
+
+package aura
+
+import (
+	"encoding/json"
+	"sync"
+
+	"github.com/ChainSafe/gossamer/dot/types"
+	"github.com/ChainSafe/gossamer/lib/babe"
+	"github.com/ChainSafe/gossamer/lib/common"
+	rtstorage "github.com/ChainSafe/gossamer/lib/runtime/storage"
+)
+
+// BlockState is the interface for the block state methods, it is the same
+// as the BABE one since the BABE block builder is reused.
+type BlockState interface {
+	babe.BlockState
+}
+
+// StorageState interface for storage state methods
+type StorageState interface {
+	TrieState(hash *common.Hash) (*rtstorage.TrieState, error)
+	sync.Locker
+}
+
+// BlockImportHandler is the interface for the handler of new blocks
+type BlockImportHandler interface {
+	HandleBlockProduced(block *types.Block, state *rtstorage.TrieState) error
+}
+
+// Telemetry is the telemetry client to send telemetry messages.
+type Telemetry interface {
+	SendMessage(msg json.Marshaler)
+}
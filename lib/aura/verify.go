@@ -0,0 +1,162 @@
+// Copyright 2024 ChainSafe Systems (ON)
+// SPDX-License-Identifier: LGPL-3.0-only
+
+package aura
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/ChainSafe/gossamer/dot/types"
+	"github.com/ChainSafe/gossamer/lib/common"
+	"github.com/ChainSafe/gossamer/lib/crypto/sr25519"
+	"github.com/ChainSafe/gossamer/lib/runtime"
+	rtstorage "github.com/ChainSafe/gossamer/lib/runtime/storage"
+	"github.com/ChainSafe/gossamer/pkg/scale"
+)
+
+// Verifier verifies that the blocks were authored by the Aura authority of their slot.
+type Verifier struct {
+	blockState   BlockState
+	storageState StorageState
+	slotDuration time.Duration
+}
+
+// NewVerifier returns a new Aura block verifier.
+func NewVerifier(blockState BlockState, storageState StorageState, slotDuration time.Duration) *Verifier {
+	return &Verifier{
+		blockState:   blockState,
+		storageState: storageState,
+		slotDuration: slotDuration,
+	}
+}
+
+// VerifyBlock verifies that the block was sealed by the authority whose turn it was to author
+// a block in the block slot, using the authorities of the parent block state.
+// It returns an error if the block is invalid.
+func (v *Verifier) VerifyBlock(header *types.Header) error {
+	parent, err := v.blockState.GetHeader(header.ParentHash)
+	if err != nil {
+		return fmt.Errorf("getting parent header: %w", err)
+	}
+
+	var parentSlot uint64
+	if parent.Hash() != v.blockState.GenesisHash() {
+		parentSlot, err = types.GetSlotFromHeader(parent)
+		if err != nil {
+			return fmt.Errorf("getting parent slot: %w", err)
+		}
+	}
+
+	v.storageState.Lock()
+	cfg, _, _, err := configurationAt(v.blockState, v.storageState, parent)
+	v.storageState.Unlock()
+	if err != nil {
+		return err
+	}
+
+	return verifyHeader(header, parentSlot, cfg.Authorities, currentSlot(v.slotDuration))
+}
+
+// verifyHeader verifies the Aura pre-runtime digest and seal of the header, given the slot
+// of its parent, the authorities at its parent and the current slot. The header of a slot
+// that has not started yet is not verified, it returns an error wrapping ErrBlockFromFuture
+// so that its import is deferred until its slot starts.
+func verifyHeader(header *types.Header, parentSlot uint64, authorities []types.AuthorityID,
+	slotNow uint64) error {
+	if len(header.Digest) < 2 {
+		return errMissingDigestItems
+	}
+
+	slot, err := types.GetSlotFromHeader(header)
+	if err != nil {
+		return fmt.Errorf("%w: %s", errNoPreRuntimeDigest, err)
+	}
+
+	if slot > slotNow {
+		return fmt.Errorf("%w: slot %d, current slot %d", ErrBlockFromFuture, slot, slotNow)
+	}
+
+	if slot <= parentSlot {
+		return fmt.Errorf("%w: slot %d, parent slot %d", ErrSlotNotIncreasing, slot, parentSlot)
+	}
+
+	sealValue, err := header.Digest[len(header.Digest)-1].Value()
+	if err != nil {
+		return fmt.Errorf("getting seal item value: %w", err)
+	}
+	seal, ok := sealValue.(types.SealDigest)
+	if !ok || seal.ConsensusEngineID != types.AuraEngineID {
+		return fmt.Errorf("%w: got %s", errLastDigestItemNotSeal, sealValue)
+	}
+
+	author, err := slotAuthor(slot, authorities)
+	if err != nil {
+		return err
+	}
+
+	key, err := sr25519.NewPublicKey(author[:])
+	if err != nil {
+		return fmt.Errorf("decoding authority key: %w", err)
+	}
+
+	// the seal signs the header without the seal.
+	unsealed := types.NewHeader(header.ParentHash, header.StateRoot, header.ExtrinsicsRoot, header.Number,
+		header.Digest[:len(header.Digest)-1])
+	encoded, err := scale.Marshal(*unsealed)
+	if err != nil {
+		return fmt.Errorf("encoding header: %w", err)
+	}
+
+	hash, err := common.Blake2bHash(encoded)
+	if err != nil {
+		return fmt.Errorf("hashing header: %w", err)
+	}
+
+	ok, err = key.Verify(hash[:], seal.Data)
+	if err != nil {
+		return fmt.Errorf("verifying seal: %w", err)
+	}
+	if !ok {
+		return ErrBadSignature
+	}
+
+	return nil
+}
+
+// slotAuthor returns the authority whose turn it is to author a block in the slot,
+// the authorities taking turns in a round-robin fashion.
+func slotAuthor(slot uint64, authorities []types.AuthorityID) (types.AuthorityID, error) {
+	if len(authorities) == 0 {
+		return types.AuthorityID{}, ErrNoAuthorities
+	}
+	return authorities[slot%uint64(len(authorities))], nil
+}
+
+// configurationAt returns the Aura configuration of the runtime at the state of the block
+// given, with the runtime and its storage set to that state. The storage state must be
+// locked by the caller.
+func configurationAt(blockState BlockState, storageState StorageState, header *types.Header) (
+	cfg *types.AuraConfiguration, ts *rtstorage.TrieState, rt runtime.Instance, err error) {
+	ts, err = storageState.TrieState(&header.StateRoot)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("getting trie state with state root %s: %w", header.StateRoot, err)
+	}
+
+	rt, err = blockState.GetRuntime(header.Hash())
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("getting runtime: %w", err)
+	}
+
+	rt.SetContextStorage(ts)
+	cfg, err = rt.AuraConfiguration()
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("getting aura configuration: %w", err)
+	}
+
+	return cfg, ts, rt, nil
+}
+
+func currentSlot(slotDuration time.Duration) uint64 {
+	return uint64(time.Now().UnixNano()) / uint64(slotDuration.Nanoseconds()) //nolint:gosec
+}
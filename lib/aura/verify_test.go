@@ -0,0 +1,157 @@
+// Copyright 2024 ChainSafe Systems (ON)
+// SPDX-License-Identifier: LGPL-3.0-only
+
+package aura
+
+import (
+	"testing"
+
+	"github.com/ChainSafe/gossamer/dot/types"
+	"github.com/ChainSafe/gossamer/lib/common"
+	"github.com/ChainSafe/gossamer/lib/crypto/sr25519"
+	"github.com/ChainSafe/gossamer/pkg/scale"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newSealedHeader(t *testing.T, kp *sr25519.Keypair, slot uint64) *types.Header {
+	t.Helper()
+
+	header := types.NewEmptyHeader()
+	header.Number = 1
+	err := header.Digest.Add(*types.NewAuraPreRuntimeDigest(slot))
+	require.NoError(t, err)
+
+	encoded, err := scale.Marshal(*header)
+	require.NoError(t, err)
+	hash, err := common.Blake2bHash(encoded)
+	require.NoError(t, err)
+	signature, err := kp.Sign(hash[:])
+	require.NoError(t, err)
+
+	err = header.Digest.Add(types.SealDigest{
+		ConsensusEngineID: types.AuraEngineID,
+		Data:              signature,
+	})
+	require.NoError(t, err)
+	return header
+}
+
+func Test_verifyHeader(t *testing.T) {
+	t.Parallel()
+
+	alice, err := sr25519.GenerateKeypair()
+	require.NoError(t, err)
+	bob, err := sr25519.GenerateKeypair()
+	require.NoError(t, err)
+
+	var aliceID, bobID types.AuthorityID
+	copy(aliceID[:], alice.Public().Encode())
+	copy(bobID[:], bob.Public().Encode())
+	authorities := []types.AuthorityID{aliceID, bobID}
+
+	babeSealed := newSealedHeader(t, alice, 4)
+	babeSealed.Digest[1] = types.NewDigestItem()
+	err = babeSealed.Digest[1].SetValue(types.SealDigest{
+		ConsensusEngineID: types.BabeEngineID,
+		Data:              []byte{1},
+	})
+	require.NoError(t, err)
+
+	tamperedHeader := newSealedHeader(t, alice, 4)
+	tamperedHeader.Number = 2
+
+	testCases := map[string]struct {
+		header      *types.Header
+		parentSlot  uint64
+		authorities []types.AuthorityID
+		slotNow     uint64
+		errWrapped  error
+		errMessage  string
+	}{
+		"valid_block": {
+			header:      newSealedHeader(t, alice, 4),
+			parentSlot:  3,
+			authorities: authorities,
+			slotNow:     5,
+		},
+		"missing_seal": {
+			header:      newTestHeaderWithoutSeal(t, 4),
+			authorities: authorities,
+			slotNow:     5,
+			errWrapped:  errMissingDigestItems,
+			errMessage:  "block header is missing digest items",
+		},
+		"block_from_future": {
+			header:      newSealedHeader(t, alice, 6),
+			authorities: authorities,
+			slotNow:     5,
+			errWrapped:  ErrBlockFromFuture,
+			errMessage:  "block from the future: slot 6, current slot 5",
+		},
+		"block_from_future_with_wrong_slot_author": {
+			header:      newSealedHeader(t, alice, 7),
+			authorities: authorities,
+			slotNow:     5,
+			errWrapped:  ErrBlockFromFuture,
+			errMessage:  "block from the future: slot 7, current slot 5",
+		},
+		"slot_not_increasing": {
+			header:      newSealedHeader(t, alice, 4),
+			parentSlot:  4,
+			authorities: authorities,
+			slotNow:     5,
+			errWrapped:  ErrSlotNotIncreasing,
+			errMessage:  "slot not greater than parent slot: slot 4, parent slot 4",
+		},
+		"not_an_aura_seal": {
+			header:      babeSealed,
+			authorities: authorities,
+			slotNow:     5,
+			errWrapped:  errLastDigestItemNotSeal,
+		},
+		"no_authorities": {
+			header:     newSealedHeader(t, alice, 4),
+			slotNow:    5,
+			errWrapped: ErrNoAuthorities,
+			errMessage: "no aura authorities",
+		},
+		"wrong_slot_author": {
+			header:      newSealedHeader(t, alice, 5),
+			authorities: authorities,
+			slotNow:     5,
+			errWrapped:  ErrBadSignature,
+			errMessage:  "could not verify signature",
+		},
+		"tampered_header": {
+			header:      tamperedHeader,
+			authorities: authorities,
+			slotNow:     5,
+			errWrapped:  ErrBadSignature,
+			errMessage:  "could not verify signature",
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := verifyHeader(testCase.header, testCase.parentSlot, testCase.authorities, testCase.slotNow)
+
+			assert.ErrorIs(t, err, testCase.errWrapped)
+			if testCase.errMessage != "" {
+				assert.EqualError(t, err, testCase.errMessage)
+			}
+		})
+	}
+}
+
+func newTestHeaderWithoutSeal(t *testing.T, slot uint64) *types.Header {
+	t.Helper()
+
+	header := types.NewEmptyHeader()
+	err := header.Digest.Add(*types.NewAuraPreRuntimeDigest(slot))
+	require.NoError(t, err)
+	return header
+}
@@ -37,6 +37,7 @@ type Service struct {
 	epochState       EpochState
 
 	blockImportHandler BlockImportHandler
+	backoffAuthoring   BackoffAuthoringBlocksStrategy
 
 	// BABE authority keypair
 	keypair *sr25519.Keypair // TODO: change to BABE keystore (#1864)
@@ -62,6 +63,9 @@ type ServiceConfig struct {
 	IsDev              bool
 	Authority          bool
 	Telemetry          Telemetry
+	// BackoffAuthoring is the strategy skipping slots when finality lags, the authoring
+	// never backs off if it is nil.
+	BackoffAuthoring BackoffAuthoringBlocksStrategy
 }
 
 // Validate returns error if config does not contain required attributes
@@ -103,6 +107,7 @@ func (Builder) NewServiceIFace(cfg *ServiceConfig) (service *Service, err error)
 		authority:          cfg.Authority,
 		dev:                cfg.IsDev,
 		blockImportHandler: cfg.BlockImportHandler,
+		backoffAuthoring:   cfg.BackoffAuthoring,
 		constants: constants{
 			slotDuration: slotDuration,
 			epochLength:  cfg.EpochState.GetEpochLength(),
@@ -145,6 +150,7 @@ func NewService(cfg *ServiceConfig) (*Service, error) {
 		authority:          cfg.Authority,
 		dev:                cfg.IsDev,
 		blockImportHandler: cfg.BlockImportHandler,
+		backoffAuthoring:   cfg.BackoffAuthoring,
 		constants: constants{
 			slotDuration: slotDuration,
 			epochLength:  cfg.EpochState.GetEpochLength(),
@@ -427,6 +433,17 @@ func (b *Service) handleSlot(epoch uint64, slot Slot,
 	if err != nil {
 		return fmt.Errorf("could not get parent for claiming slot %d: %w", slot.number, err)
 	}
+
+	backoff, err := b.shouldBackoff(parent, slot.number)
+	if err != nil {
+		return fmt.Errorf("checking authoring backoff in slot %d: %w", slot.number, err)
+	}
+	if backoff {
+		logger.Debugf("backing off authoring in slot %d, finality is lagging behind block %d",
+			slot.number, parent.Number)
+		return nil
+	}
+
 	b.storageState.Lock()
 	defer b.storageState.Unlock()
 
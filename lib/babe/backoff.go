@@ -0,0 +1,98 @@
+// Copyright 2024 ChainSafe Systems (ON)
+// SPDX-License-Identifier: LGPL-3.0-only
+
+package babe
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/ChainSafe/gossamer/dot/types"
+)
+
+const (
+	// DefaultBackoffMaxInterval is the default maximum number of slots skipped between two
+	// authored blocks when finality lags.
+	DefaultBackoffMaxInterval = uint(100)
+	// DefaultBackoffUnfinalisedSlack is the default number of unfinalised blocks before the
+	// block authoring backs off.
+	DefaultBackoffUnfinalisedSlack = uint(50)
+	// DefaultBackoffAuthoringBias is the default number of unfinalised blocks above the slack
+	// adding one slot to skip.
+	DefaultBackoffAuthoringBias = uint(2)
+)
+
+// BackoffAuthoringBlocksStrategy decides whether the block authoring is skipped in a slot.
+type BackoffAuthoringBlocksStrategy interface {
+	// ShouldBackoff returns true if no block should be authored in the current slot, given the
+	// number and slot of the chain head and the number of the finalised head.
+	ShouldBackoff(chainHeadNumber uint, chainHeadSlot uint64, finalisedNumber uint, slotNow uint64) bool
+}
+
+// BackoffAuthoringOnFinalisedHeadLagging is a backoff strategy skipping more slots between
+// the authored blocks as the unfinalised chain grows, so that the chain does not run away
+// when finality stalls.
+type BackoffAuthoringOnFinalisedHeadLagging struct {
+	// MaxInterval is the maximum number of slots skipped after the chain head slot.
+	MaxInterval uint
+	// UnfinalisedSlack is the number of unfinalised blocks before slots are skipped.
+	UnfinalisedSlack uint
+	// AuthoringBias is the number of unfinalised blocks above the slack adding one slot
+	// to skip. It must not be zero.
+	AuthoringBias uint
+}
+
+// NewBackoffAuthoringOnFinalisedHeadLagging returns the backoff strategy with the
+// default parameters.
+func NewBackoffAuthoringOnFinalisedHeadLagging() *BackoffAuthoringOnFinalisedHeadLagging {
+	return &BackoffAuthoringOnFinalisedHeadLagging{
+		MaxInterval:      DefaultBackoffMaxInterval,
+		UnfinalisedSlack: DefaultBackoffUnfinalisedSlack,
+		AuthoringBias:    DefaultBackoffAuthoringBias,
+	}
+}
+
+// ShouldBackoff returns true if the current slot is not far enough ahead of the chain head
+// slot, the interval growing with the length of the unfinalised chain.
+func (b *BackoffAuthoringOnFinalisedHeadLagging) ShouldBackoff(chainHeadNumber uint, chainHeadSlot uint64,
+	finalisedNumber uint, slotNow uint64) bool {
+	// this should not happen, the authoring is not changed if it does
+	if slotNow <= chainHeadSlot {
+		return false
+	}
+
+	var unfinalisedLength uint
+	if chainHeadNumber > finalisedNumber {
+		unfinalisedLength = chainHeadNumber - finalisedNumber
+	}
+
+	var interval uint
+	if unfinalisedLength > b.UnfinalisedSlack {
+		interval = (unfinalisedLength - b.UnfinalisedSlack) / b.AuthoringBias
+	}
+	interval = min(interval, b.MaxInterval)
+
+	return slotNow <= chainHeadSlot+uint64(interval)
+}
+
+// shouldBackoff returns true if the block authoring on top of the parent given is skipped in
+// the slot by the backoff strategy of the service.
+func (b *Service) shouldBackoff(parent *types.Header, slotNow uint64) (bool, error) {
+	if b.backoffAuthoring == nil {
+		return false, nil
+	}
+
+	parentSlot, err := types.GetSlotFromHeader(parent)
+	if errors.Is(err, types.ErrGenesisHeader) {
+		return false, nil
+	} else if err != nil {
+		return false, fmt.Errorf("getting slot of parent header: %w", err)
+	}
+
+	finalised, err := b.blockState.GetHighestFinalisedHeader()
+	if err != nil {
+		return false, fmt.Errorf("getting highest finalised header: %w", err)
+	}
+
+	return b.backoffAuthoring.ShouldBackoff(parent.Number, parentSlot, finalised.Number, slotNow), nil
+}
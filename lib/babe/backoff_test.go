@@ -0,0 +1,146 @@
+// Copyright 2024 ChainSafe Systems (ON)
+// SPDX-License-Identifier: LGPL-3.0-only
+
+package babe
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ChainSafe/gossamer/dot/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func Test_BackoffAuthoringOnFinalisedHeadLagging_ShouldBackoff(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		chainHeadNumber uint
+		chainHeadSlot   uint64
+		finalisedNumber uint
+		slotNow         uint64
+		backoff         bool
+	}{
+		"within_slack": {
+			chainHeadNumber: 50,
+			chainHeadSlot:   100,
+			slotNow:         101,
+		},
+		"slot_not_after_chain_head": {
+			chainHeadNumber: 200,
+			chainHeadSlot:   100,
+			slotNow:         100,
+		},
+		"lagging_slot_within_interval": {
+			chainHeadNumber: 60,
+			chainHeadSlot:   100,
+			slotNow:         105,
+			backoff:         true,
+		},
+		"lagging_slot_after_interval": {
+			chainHeadNumber: 60,
+			chainHeadSlot:   100,
+			slotNow:         106,
+		},
+		"finalised_head_catching_up": {
+			chainHeadNumber: 60,
+			chainHeadSlot:   100,
+			finalisedNumber: 10,
+			slotNow:         101,
+		},
+		"max_interval": {
+			chainHeadNumber: 1000,
+			chainHeadSlot:   100,
+			slotNow:         200,
+			backoff:         true,
+		},
+		"after_max_interval": {
+			chainHeadNumber: 1000,
+			chainHeadSlot:   100,
+			slotNow:         201,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			strategy := NewBackoffAuthoringOnFinalisedHeadLagging()
+
+			backoff := strategy.ShouldBackoff(testCase.chainHeadNumber, testCase.chainHeadSlot,
+				testCase.finalisedNumber, testCase.slotNow)
+
+			assert.Equal(t, testCase.backoff, backoff)
+		})
+	}
+}
+
+func Test_Service_shouldBackoff(t *testing.T) {
+	t.Parallel()
+
+	errTest := errors.New("test error")
+
+	preDigest, err := types.NewBabeSecondaryPlainPreDigest(0, 100).ToPreRuntimeDigest()
+	require.NoError(t, err)
+	digest := types.NewDigest()
+	require.NoError(t, digest.Add(*preDigest))
+	parent := &types.Header{Number: 60, Digest: digest}
+
+	t.Run("no_strategy", func(t *testing.T) {
+		t.Parallel()
+
+		service := &Service{}
+
+		backoff, err := service.shouldBackoff(parent, 101)
+		require.NoError(t, err)
+		assert.False(t, backoff)
+	})
+
+	t.Run("genesis_parent", func(t *testing.T) {
+		t.Parallel()
+
+		service := &Service{
+			backoffAuthoring: NewBackoffAuthoringOnFinalisedHeadLagging(),
+		}
+
+		backoff, err := service.shouldBackoff(&types.Header{}, 1)
+		require.NoError(t, err)
+		assert.False(t, backoff)
+	})
+
+	t.Run("finalised_header_error", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+
+		blockState := NewMockBlockState(ctrl)
+		blockState.EXPECT().GetHighestFinalisedHeader().Return(nil, errTest)
+		service := &Service{
+			blockState:       blockState,
+			backoffAuthoring: NewBackoffAuthoringOnFinalisedHeadLagging(),
+		}
+
+		backoff, err := service.shouldBackoff(parent, 101)
+		assert.ErrorIs(t, err, errTest)
+		assert.EqualError(t, err, "getting highest finalised header: test error")
+		assert.False(t, backoff)
+	})
+
+	t.Run("finality_lagging", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+
+		blockState := NewMockBlockState(ctrl)
+		blockState.EXPECT().GetHighestFinalisedHeader().Return(&types.Header{}, nil)
+		service := &Service{
+			blockState:       blockState,
+			backoffAuthoring: NewBackoffAuthoringOnFinalisedHeadLagging(),
+		}
+
+		backoff, err := service.shouldBackoff(parent, 101)
+		require.NoError(t, err)
+		assert.True(t, backoff)
+	})
+}
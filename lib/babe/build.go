@@ -50,8 +50,21 @@ func (b *Service) buildBlock(parent *types.Header, slot Slot, rt Runtime,
 	return block, nil
 }
 
+// SlotEngine is a slot based consensus engine blocks are built for. It is identified by the
+// engine id of the block seals and by the inherent giving the block slot to the runtime.
+type SlotEngine struct {
+	EngineID     types.ConsensusEngineID
+	SlotInherent types.InherentIdentifier
+}
+
+var babeSlotEngine = SlotEngine{
+	EngineID:     types.BabeEngineID,
+	SlotInherent: types.Babeslot,
+}
+
 // BlockBuilder builds blocks.
 type BlockBuilder struct {
+	engine                SlotEngine
 	keypair               *sr25519.Keypair
 	transactionState      TransactionState
 	blockState            BlockState
@@ -68,6 +81,7 @@ func NewBlockBuilder(
 	preRuntimeDigest *types.PreRuntimeDigest,
 ) *BlockBuilder {
 	return &BlockBuilder{
+		engine:                babeSlotEngine,
 		keypair:               kp,
 		transactionState:      ts,
 		blockState:            bs,
@@ -76,6 +90,29 @@ func NewBlockBuilder(
 	}
 }
 
+// NewSlotEngineBlockBuilder creates a new block builder for the slot based consensus engine given.
+func NewSlotEngineBlockBuilder(
+	engine SlotEngine,
+	kp *sr25519.Keypair,
+	ts TransactionState,
+	bs BlockState,
+	preRuntimeDigest *types.PreRuntimeDigest,
+) *BlockBuilder {
+	return &BlockBuilder{
+		engine:           engine,
+		keypair:          kp,
+		transactionState: ts,
+		blockState:       bs,
+		preRuntimeDigest: preRuntimeDigest,
+	}
+}
+
+// BuildBlock builds a block for the slot with the given parent, using the runtime given
+// whose storage must be set to the parent state.
+func (b *BlockBuilder) BuildBlock(parent *types.Header, slot Slot, rt Runtime) (*types.Block, error) {
+	return b.buildBlock(parent, slot, rt)
+}
+
 func (b *BlockBuilder) buildBlock(parent *types.Header, slot Slot, rt Runtime) (*types.Block, error) {
 	logger.Tracef("build block with parent %s and slot: %s", parent, slot)
 
@@ -97,7 +134,7 @@ func (b *BlockBuilder) buildBlock(parent *types.Header, slot Slot, rt Runtime) (
 	logger.Trace("initialised block")
 
 	// add block inherents
-	inherents, err := buildBlockInherents(slot, rt, parent)
+	inherents, err := buildBlockInherents(b.engine.SlotInherent, slot, rt, parent)
 	if err != nil {
 		return nil, fmt.Errorf("cannot build inherents: %s", err)
 	}
@@ -163,7 +200,7 @@ func (b *BlockBuilder) buildBlockSeal(header *types.Header) (*types.SealDigest,
 	}
 
 	return &types.SealDigest{
-		ConsensusEngineID: types.BabeEngineID,
+		ConsensusEngineID: b.engine.EngineID,
 		Data:              sig,
 	}, nil
 }
@@ -226,7 +263,8 @@ func (b *BlockBuilder) buildBlockExtrinsics(slot Slot, rt ExtrinsicHandler) []*t
 	return included
 }
 
-func buildBlockInherents(slot Slot, rt ExtrinsicHandler, parent *types.Header) ([][]byte, error) {
+func buildBlockInherents(slotInherent types.InherentIdentifier, slot Slot, rt ExtrinsicHandler,
+	parent *types.Header) ([][]byte, error) {
 	// Setup inherents: add timstap0
 	idata := types.NewInherentData()
 	err := idata.SetInherent(types.Timstap0, uint64(slot.start.UnixMilli())) //nolint:gosec
@@ -234,8 +272,8 @@ func buildBlockInherents(slot Slot, rt ExtrinsicHandler, parent *types.Header) (
 		return nil, err
 	}
 
-	// add babeslot
-	err = idata.SetInherent(types.Babeslot, slot.number)
+	// add babeslot or auraslot
+	err = idata.SetInherent(slotInherent, slot.number)
 	if err != nil {
 		return nil, err
 	}
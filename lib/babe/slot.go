@@ -21,6 +21,22 @@ func timeUntilNextSlot(slotDuration time.Duration) time.Duration {
 	return time.Duration(remaining)
 }
 
+// SlotTicker yields the slots as they start, for the slot based consensus engines other than BABE.
+type SlotTicker struct {
+	handler slotHandler
+}
+
+// NewSlotTicker creates a slot ticker for slots of the given duration.
+func NewSlotTicker(slotDuration time.Duration) *SlotTicker {
+	return &SlotTicker{handler: newSlotHandler(slotDuration)}
+}
+
+// WaitForNextSlot blocks until a slot greater than the last one returned starts, or until
+// the context is cancelled. Like for BABE, a slot is skipped if less than a third of it remains.
+func (t *SlotTicker) WaitForNextSlot(ctx context.Context) (Slot, error) {
+	return t.handler.waitForNextSlot(ctx)
+}
+
 type slotHandler struct {
 	slotDuration time.Duration
 	lastSlot     *Slot
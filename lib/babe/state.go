@@ -25,6 +25,7 @@ type BlockState interface {
 	BestBlockHeader() (*types.Header, error)
 	AddBlock(*types.Block) error
 	GetHeader(common.Hash) (*types.Header, error)
+	GetHighestFinalisedHeader() (*types.Header, error)
 	GetBlockByNumber(blockNumber uint) (*types.Block, error)
 	GetBlockHashesBySlot(slot uint64) (blockHashes []common.Hash, err error)
 	GenesisHash() common.Hash
@@ -37,6 +37,16 @@ func NewSlot(start time.Time, duration time.Duration, number uint64) *Slot {
 	}
 }
 
+// Number returns the slot number.
+func (s Slot) Number() uint64 {
+	return s.number
+}
+
+// Start returns the start time of the slot.
+func (s Slot) Start() time.Time {
+	return s.start
+}
+
 func (s Slot) String() string {
 	return fmt.Sprintf("slot number %d started at %s for a duration of %s",
 		s.number, s.start, s.duration)
@@ -9,12 +9,16 @@
 	"sync"
 	"time"
 
+	"github.com/ChainSafe/gossamer/dot/telemetry"
 	"github.com/ChainSafe/gossamer/dot/types"
 	"github.com/ChainSafe/gossamer/lib/common"
 	"github.com/ChainSafe/gossamer/lib/crypto/sr25519"
 	"github.com/ChainSafe/gossamer/pkg/scale"
+	ethmetrics "github.com/ethereum/go-ethereum/metrics"
 )
 
+const equivocationsCounter = "gossamer/babe/equivocations"
+
 var errEmptyKeyOwnershipProof = errors.New("key ownership proof is nil")
 
 // verifierInfo contains the information needed to verify blocks
@@ -41,6 +45,7 @@ type VerificationManager struct {
 	blockState BlockState
 	slotState  SlotState
 	epochState EpochState
+	telemetry  Telemetry
 	epochInfo  map[uint64]*verifierInfo // map of epoch number -> info needed for verification
 	// there may be different OnDisabled digests on different
 	// branches of the chain, so we need to keep track of all of them.
@@ -48,12 +53,15 @@ type VerificationManager struct {
 	onDisabled map[uint64]map[uint32][]*onDisabledInfo
 }
 
-// NewVerificationManager returns a new NewVerificationManager
-func NewVerificationManager(blockState BlockState, slotState SlotState, epochState EpochState) *VerificationManager {
+// NewVerificationManager returns a new NewVerificationManager. The equivocations detected are
+// sent to the telemetry given, if it is not nil.
+func NewVerificationManager(blockState BlockState, slotState SlotState, epochState EpochState,
+	telemetry Telemetry) *VerificationManager {
 	return &VerificationManager{
 		epochState: epochState,
 		slotState:  slotState,
 		blockState: blockState,
+		telemetry:  telemetry,
 		epochInfo:  make(map[uint64]*verifierInfo),
 		onDisabled: make(map[uint64]map[uint32][]*onDisabledInfo),
 	}
@@ -166,6 +174,7 @@ func (v *VerificationManager) VerifyBlock(header *types.Header) error {
 	}
 
 	verifier := newVerifier(v.blockState, v.slotState, currentBlockEpoch, info, slotDuration)
+	verifier.telemetry = v.telemetry
 	return verifier.verifyAuthorshipRight(header)
 }
 
@@ -203,6 +212,7 @@ type verifier struct {
 	threshold      *scale.Uint128
 	secondarySlots bool
 	slotDuration   time.Duration
+	telemetry      Telemetry
 }
 
 // newVerifier returns a Verifier for the epoch described by the given descriptor
@@ -275,7 +285,7 @@ func (b *verifier) verifyAuthorshipRight(header *types.Header) error {
 		return fmt.Errorf("from raw sr25519: %w", err)
 	}
 
-	// remove seal before verifying signature
+	// remove seal before verifying signature, the header checked for equivocation keeps its seal
 	h := types.NewDigest()
 	for _, val := range header.Digest[:len(header.Digest)-1] {
 		digestValue, err := val.Value()
@@ -288,18 +298,15 @@ func (b *verifier) verifyAuthorshipRight(header *types.Header) error {
 		}
 	}
 
-	header.Digest = h
-	defer func() {
-		sealItemVal, err := sealItem.Value()
-		if err != nil {
-			logger.Errorf("getting seal item value: %s", err)
-		}
-		if err = header.Digest.Add(sealItemVal); err != nil {
-			logger.Errorf("failed to re-add seal to digest: %s", err)
-		}
-	}()
+	unsealedHeader := types.Header{
+		ParentHash:     header.ParentHash,
+		Number:         header.Number,
+		StateRoot:      header.StateRoot,
+		ExtrinsicsRoot: header.ExtrinsicsRoot,
+		Digest:         h,
+	}
 
-	encHeader, err := scale.Marshal(*header)
+	encHeader, err := scale.Marshal(unsealedHeader)
 	if err != nil {
 		return err
 	}
@@ -355,7 +362,7 @@ func (b *verifier) submitAndReportEquivocation(equivocationProof *types.BabeEqui
 }
 
 // verifyBlockEquivocation checks if the given block's author has occupied the corresponding slot more than once.
-// It returns true if the block was equivocated.
+// It returns true if the block was equivocated, after reporting the equivocation to the runtime.
 // TODO: Check if it is initial sync
 // don't report any equivocations during initial sync
 // as they are most likely stale.
@@ -386,6 +393,8 @@ func (b *verifier) verifyBlockEquivocation(header *types.Header) (bool, error) {
 		return false, nil
 	}
 
+	b.notifyEquivocation(equivocationProof)
+
 	err = b.submitAndReportEquivocation(equivocationProof)
 	if err != nil {
 		return false, fmt.Errorf("submiting equivocation: %w", err)
@@ -394,6 +403,30 @@ func (b *verifier) verifyBlockEquivocation(header *types.Header) (bool, error) {
 	return true, nil
 }
 
+// notifyEquivocation logs the equivocation detected, counts it in the metrics and sends it to
+// the telemetry, for the operators to monitor the misbehaving authorities.
+func (b *verifier) notifyEquivocation(equivocationProof *types.BabeEquivocationProof) {
+	offender := common.BytesToHex(equivocationProof.Offender[:])
+	// the headers are copied so that the proof headers are not changed by the hash caching
+	firstHeader, secondHeader := equivocationProof.FirstHeader, equivocationProof.SecondHeader
+	firstHash, secondHash := firstHeader.Hash(), secondHeader.Hash()
+	logger.Warnf("authority %s equivocated in slot %d with blocks %s and %s",
+		offender, equivocationProof.Slot, firstHash, secondHash)
+
+	// is necessary to enable ethmetrics to be possible register values
+	ethmetrics.Enabled = true
+	ethmetrics.GetOrRegisterCounter(equivocationsCounter, nil).Inc(1)
+
+	if b.telemetry != nil {
+		b.telemetry.SendMessage(telemetry.NewBabeEquivocation(
+			fmt.Sprint(equivocationProof.Slot),
+			offender,
+			firstHash,
+			secondHash,
+		))
+	}
+}
+
 func (b *verifier) verifyPreRuntimeDigest(digest *types.PreRuntimeDigest) (any, error) {
 	babePreDigest, err := types.DecodeBabePreDigest(digest.Data)
 	if err != nil {
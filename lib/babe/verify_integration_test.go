@@ -31,8 +31,8 @@ func TestVerificationManager_OnDisabled_InvalidIndex(t *testing.T) {
 	db, err := database.NewPebble(t.TempDir(), true)
 	require.NoError(t, err)
 
-	slotState := state.NewSlotState(db)
-	vm := NewVerificationManager(babeService.blockState, slotState, babeService.epochState)
+	slotState := state.NewSlotState(db, state.DefaultSlotCapacity)
+	vm := NewVerificationManager(babeService.blockState, slotState, babeService.epochState, nil)
 
 	epochDescriptor, err := babeService.initiateEpoch(testEpochIndex)
 	require.NoError(t, err)
@@ -54,9 +54,9 @@ func TestVerificationManager_OnDisabled_NewDigest(t *testing.T) {
 
 	db, err := database.NewPebble(t.TempDir(), true)
 	require.NoError(t, err)
-	slotState := state.NewSlotState(db)
+	slotState := state.NewSlotState(db, state.DefaultSlotCapacity)
 
-	vm := NewVerificationManager(babeService.blockState, slotState, babeService.epochState)
+	vm := NewVerificationManager(babeService.blockState, slotState, babeService.epochState, nil)
 
 	epochDescriptor, err := babeService.initiateEpoch(testEpochIndex)
 	require.NoError(t, err)
@@ -100,8 +100,8 @@ func TestVerificationManager_OnDisabled_DuplicateDigest(t *testing.T) {
 
 	db, err := database.NewPebble(t.TempDir(), true)
 	require.NoError(t, err)
-	slotState := state.NewSlotState(db)
-	vm := NewVerificationManager(babeService.blockState, slotState, babeService.epochState)
+	slotState := state.NewSlotState(db, state.DefaultSlotCapacity)
+	vm := NewVerificationManager(babeService.blockState, slotState, babeService.epochState, nil)
 
 	vm.epochInfo[testEpochIndex] = &verifierInfo{
 		authorities: epochDescriptor.data.authorities,
@@ -156,9 +156,9 @@ func TestVerificationManager_VerifyBlock_Secondary(t *testing.T) {
 
 	db, err := database.NewPebble(t.TempDir(), true)
 	require.NoError(t, err)
-	slotState := state.NewSlotState(db)
+	slotState := state.NewSlotState(db, state.DefaultSlotCapacity)
 
-	vm := NewVerificationManager(babeService.blockState, slotState, babeService.epochState)
+	vm := NewVerificationManager(babeService.blockState, slotState, babeService.epochState, nil)
 
 	const epoch = 0
 	epochDescriptor, err := babeService.initiateEpoch(epoch)
@@ -216,9 +216,9 @@ func TestVerificationManager_VerifyBlock_CurrentEpoch(t *testing.T) {
 
 	db, err := database.NewPebble(t.TempDir(), true)
 	require.NoError(t, err)
-	slotState := state.NewSlotState(db)
+	slotState := state.NewSlotState(db, state.DefaultSlotCapacity)
 
-	vm := NewVerificationManager(babeService.blockState, slotState, babeService.epochState)
+	vm := NewVerificationManager(babeService.blockState, slotState, babeService.epochState, nil)
 
 	epochDescriptor, err := babeService.initiateEpoch(0)
 	require.NoError(t, err)
@@ -252,9 +252,9 @@ func TestVerificationManager_VerifyBlock_FutureEpoch(t *testing.T) {
 
 	db, err := database.NewPebble(t.TempDir(), true)
 	require.NoError(t, err)
-	slotState := state.NewSlotState(db)
+	slotState := state.NewSlotState(db, state.DefaultSlotCapacity)
 
-	verificationManager := NewVerificationManager(babeService.blockState, slotState, babeService.epochState)
+	verificationManager := NewVerificationManager(babeService.blockState, slotState, babeService.epochState, nil)
 
 	const futureEpoch = uint64(2)
 	err = babeService.epochState.(*state.EpochState).SetEpochDataRaw(futureEpoch, &types.EpochDataRaw{
@@ -302,8 +302,8 @@ func TestVerificationManager_VerifyBlock_MultipleEpochs(t *testing.T) {
 
 	db, err := database.NewPebble(t.TempDir(), true)
 	require.NoError(t, err)
-	slotState := state.NewSlotState(db)
-	verificationManager := NewVerificationManager(babeService.blockState, slotState, babeService.epochState)
+	slotState := state.NewSlotState(db, state.DefaultSlotCapacity)
+	verificationManager := NewVerificationManager(babeService.blockState, slotState, babeService.epochState, nil)
 
 	const epoch = uint64(0)
 	epochDescriptor, err := babeService.initiateEpoch(epoch)
@@ -407,9 +407,9 @@ func TestVerificationManager_VerifyBlock_InvalidBlockOverThreshold(t *testing.T)
 
 	db, err := database.NewPebble(t.TempDir(), true)
 	require.NoError(t, err)
-	slotState := state.NewSlotState(db)
+	slotState := state.NewSlotState(db, state.DefaultSlotCapacity)
 
-	vm := NewVerificationManager(babeService.blockState, slotState, babeService.epochState)
+	vm := NewVerificationManager(babeService.blockState, slotState, babeService.epochState, nil)
 
 	const epoch = 0
 	epochDescriptor, err := babeService.initiateEpoch(epoch)
@@ -449,9 +449,9 @@ func TestVerificationManager_VerifyBlock_InvalidBlockAuthority(t *testing.T) {
 
 	db, err := database.NewPebble(t.TempDir(), true)
 	require.NoError(t, err)
-	slotState := state.NewSlotState(db)
+	slotState := state.NewSlotState(db, state.DefaultSlotCapacity)
 
-	verificationManager := NewVerificationManager(babeServiceBob.blockState, slotState, babeServiceBob.epochState)
+	verificationManager := NewVerificationManager(babeServiceBob.blockState, slotState, babeServiceBob.epochState, nil)
 
 	epochDescriptor, err := babeService.initiateEpoch(testEpochIndex)
 	require.NoError(t, err)
@@ -498,7 +498,7 @@ func TestVerifyPrimarySlotWinner(t *testing.T) {
 
 	db, err := database.NewPebble(t.TempDir(), true)
 	require.NoError(t, err)
-	slotState := state.NewSlotState(db)
+	slotState := state.NewSlotState(db, state.DefaultSlotCapacity)
 
 	verifier := newVerifier(babeService.blockState, slotState, testEpochIndex, &verifierInfo{
 		authorities: epochDescriptor.data.authorities,
@@ -531,7 +531,7 @@ func TestVerifyAuthorshipRight(t *testing.T) {
 
 	db, err := database.NewPebble(t.TempDir(), true)
 	require.NoError(t, err)
-	slotState := state.NewSlotState(db)
+	slotState := state.NewSlotState(db, state.DefaultSlotCapacity)
 
 	verifier := newVerifier(babeService.blockState, slotState, testEpochIndex, &verifierInfo{
 		authorities: epochDescriptor.data.authorities,
@@ -549,9 +549,9 @@ func TestVerifyAuthorshipRight_Equivocation(t *testing.T) {
 
 	db, err := database.NewPebble(t.TempDir(), true)
 	require.NoError(t, err)
-	slotState := state.NewSlotState(db)
+	slotState := state.NewSlotState(db, state.DefaultSlotCapacity)
 
-	verificationManager := NewVerificationManager(babeService.blockState, slotState, babeService.epochState)
+	verificationManager := NewVerificationManager(babeService.blockState, slotState, babeService.epochState, nil)
 
 	epochData, err := babeService.initiateEpoch(testEpochIndex)
 	require.NoError(t, err)
@@ -653,7 +653,7 @@ func TestVerifyForkBlocksWithRespectiveEpochData(t *testing.T) {
 
 	digestHandler.Start()
 
-	verificationManager := NewVerificationManager(stateService.Block, stateService.Slot, epochState)
+	verificationManager := NewVerificationManager(stateService.Block, stateService.Slot, epochState, nil)
 
 	/*
 	* lets issue different blocks starting from genesis (a fork)
@@ -897,9 +897,9 @@ func TestVerifyBlockAfterSkippedEpochs(t *testing.T) {
 
 	db, err := database.NewPebble(t.TempDir(), true)
 	require.NoError(t, err)
-	slotState := state.NewSlotState(db)
+	slotState := state.NewSlotState(db, state.DefaultSlotCapacity)
 
-	verificationManager := NewVerificationManager(babeService.blockState, slotState, babeService.epochState)
+	verificationManager := NewVerificationManager(babeService.blockState, slotState, babeService.epochState, nil)
 
 	firstBlockSlot := Slot{
 		start:    time.Unix(0, 0),
@@ -10,6 +10,7 @@
 	"time"
 
 	"github.com/ChainSafe/gossamer/dot/state"
+	"github.com/ChainSafe/gossamer/dot/telemetry"
 	"github.com/ChainSafe/gossamer/dot/types"
 	"github.com/ChainSafe/gossamer/lib/babe/mocks"
 	"github.com/ChainSafe/gossamer/lib/common"
@@ -892,6 +893,9 @@ func Test_verifyBlockEquivocation(t *testing.T) {
 				mockBlockState.EXPECT().BestBlockHash().Return(defaultHeader.Hash())
 				mockBlockState.EXPECT().GetRuntime(defaultHeader.Hash()).Return(nil, getRuntimeErr)
 
+				mockTelemetry := NewMockTelemetry(ctrl)
+				mockTelemetry.EXPECT().SendMessage(gomock.AssignableToTypeOf(&telemetry.BabeEquivocation{}))
+
 				return &verifier{
 					authorities: []types.AuthorityRaw{
 						{
@@ -902,6 +906,7 @@ func Test_verifyBlockEquivocation(t *testing.T) {
 					blockState:   mockBlockState,
 					slotState:    mockSlotState,
 					slotDuration: 6 * time.Second,
+					telemetry:    mockTelemetry,
 				}
 			},
 		},
@@ -1374,7 +1379,7 @@ func TestVerificationManager_VerifyBlock(t *testing.T) {
 					Return(uint64(0), errTestGetEpoch)
 
 				return NewVerificationManager(mockBlockState,
-					NewMockSlotState(nil), mockEpochStateGetEpochErr)
+					NewMockSlotState(nil), mockEpochStateGetEpochErr, nil)
 			},
 			expErr: fmt.Errorf("getting epoch for block header: %w", errTestGetEpoch),
 		},
@@ -1398,7 +1403,7 @@ func TestVerificationManager_VerifyBlock(t *testing.T) {
 				mockEpochState.EXPECT().GetEpochDataRaw(uint64(1), testBlockHeaderEmpty).
 					Return(nil, errTestGetEpochData)
 
-				return NewVerificationManager(mockBlockState, NewMockSlotState(nil), mockEpochState)
+				return NewVerificationManager(mockBlockState, NewMockSlotState(nil), mockEpochState, nil)
 			},
 			header: testBlockHeaderEmpty,
 			expErr: fmt.Errorf("getting verifier info: "+
@@ -1426,7 +1431,7 @@ func TestVerificationManager_VerifyBlock(t *testing.T) {
 				mockEpochState.EXPECT().GetConfigData(uint64(1), testBlockHeaderEmpty).
 					Return(nil, errTestGetEpochData)
 
-				return NewVerificationManager(mockBlockState, NewMockSlotState(nil), mockEpochState)
+				return NewVerificationManager(mockBlockState, NewMockSlotState(nil), mockEpochState, nil)
 			},
 			header: testBlockHeaderEmpty,
 			expErr: fmt.Errorf("getting verifier info: "+
@@ -1466,7 +1471,7 @@ func TestVerificationManager_VerifyBlock(t *testing.T) {
 						[32]byte(kp.Public().Encode())).
 					Return(nil, nil)
 
-				return NewVerificationManager(mockBlockState, mockSlotState, mockEpochState)
+				return NewVerificationManager(mockBlockState, mockSlotState, mockEpochState, nil)
 			},
 			header: headerWithPreRuntimeDigest,
 		},
@@ -1562,27 +1567,27 @@ func TestVerificationManager_SetOnDisabled(t *testing.T) {
 		},
 	}
 
-	vm0 := NewVerificationManager(mockBlockStateEmpty, mockSlotState, mockEpochStateGetEpochErr)
-	vm1 := NewVerificationManager(mockBlockStateEmpty, mockSlotState, mockEpochStateGetEpochDataErr)
+	vm0 := NewVerificationManager(mockBlockStateEmpty, mockSlotState, mockEpochStateGetEpochErr, nil)
+	vm1 := NewVerificationManager(mockBlockStateEmpty, mockSlotState, mockEpochStateGetEpochDataErr, nil)
 	vm1.epochInfo[1] = info
 
-	vm2 := NewVerificationManager(mockBlockStateEmpty, mockSlotState, mockEpochStateIndexLenErr)
+	vm2 := NewVerificationManager(mockBlockStateEmpty, mockSlotState, mockEpochStateIndexLenErr, nil)
 	vm2.epochInfo[2] = info
 
-	vm3 := NewVerificationManager(mockBlockStateEmpty, mockSlotState, mockEpochStateSetDisabledProd)
+	vm3 := NewVerificationManager(mockBlockStateEmpty, mockSlotState, mockEpochStateSetDisabledProd, nil)
 	vm3.epochInfo[2] = info
 
-	vm4 := NewVerificationManager(mockBlockStateIsDescendantErr, mockSlotState, mockEpochStateOk)
+	vm4 := NewVerificationManager(mockBlockStateIsDescendantErr, mockSlotState, mockEpochStateOk, nil)
 	vm4.epochInfo[2] = info
 	vm4.onDisabled[2] = map[uint32][]*onDisabledInfo{}
 	vm4.onDisabled[2][0] = disabledInfo
 
-	vm5 := NewVerificationManager(mockBlockStateAuthorityDisabled, mockSlotState, mockEpochStateOk2)
+	vm5 := NewVerificationManager(mockBlockStateAuthorityDisabled, mockSlotState, mockEpochStateOk2, nil)
 	vm5.epochInfo[2] = info
 	vm5.onDisabled[2] = map[uint32][]*onDisabledInfo{}
 	vm5.onDisabled[2][0] = disabledInfo
 
-	vm6 := NewVerificationManager(mockBlockStateOk, mockSlotState, mockEpochStateOk3)
+	vm6 := NewVerificationManager(mockBlockStateOk, mockSlotState, mockEpochStateOk3, nil)
 	vm6.epochInfo[2] = info
 	vm6.onDisabled[2] = map[uint32][]*onDisabledInfo{}
 	vm6.onDisabled[2][0] = disabledInfo
@@ -0,0 +1,637 @@
+// Copyright 2024 ChainSafe Systems (ON)
+// SPDX-License-Identifier: LGPL-3.0-only
+
+// Package beefy implements the BEEFY finality gadget, where the authorities sign commitments
+// to the GRANDPA finalized blocks with their ECDSA key, so that light clients of other chains
+// can efficiently follow the finality of this chain.
+package beefy
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/ChainSafe/gossamer/dot/types"
+	"github.com/ChainSafe/gossamer/internal/database"
+	"github.com/ChainSafe/gossamer/internal/log"
+	"github.com/ChainSafe/gossamer/lib/common"
+	"github.com/ChainSafe/gossamer/lib/crypto/secp256k1"
+	"github.com/ChainSafe/gossamer/pkg/scale"
+)
+
+var logger = log.NewFromGlobal(log.AddContext("pkg", "beefy"))
+
+// defaultMinBlockDelta is the minimum number of blocks between two blocks voted on,
+// the worker voting on fewer blocks as the BEEFY finality lags behind GRANDPA.
+const defaultMinBlockDelta = 8
+
+const justificationsBufferSize = 128
+
+// maxPendingJustifications is the maximum number of justifications waiting for GRANDPA
+// to finalise their blocks.
+const maxPendingJustifications = 128
+
+// Service is the BEEFY worker, voting on the GRANDPA finalized blocks and finalizing
+// the blocks once signed by enough validators.
+type Service struct {
+	ctx    context.Context
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+
+	blockState   BlockState
+	storageState StorageState
+	network      Network
+	keypair      *secp256k1.Keypair
+	forkID       string
+
+	minBlockDelta uint32
+
+	// lock protects the voting state below.
+	lock sync.Mutex
+	// validatorSet is nil until the BEEFY pallet is deployed in the runtime.
+	validatorSet *types.BeefyValidatorSet
+	rounds       *rounds
+	sessionStart uint32
+	bestGrandpa  uint32
+	bestBeefy    uint32
+	lastVoted    uint32
+	// pendingJustifications are the justifications imported with their blocks,
+	// waiting for GRANDPA to finalise their blocks.
+	pendingJustifications map[uint32]SignedCommitment
+
+	finalisedCh chan *types.FinalisationInfo
+
+	justificationsLock sync.RWMutex
+	justifications     map[chan []byte]struct{}
+}
+
+// Config represents a BEEFY service configuration
+type Config struct {
+	LogLvl        log.Level
+	BlockState    BlockState
+	StorageState  StorageState
+	Network       Network
+	Keypair       *secp256k1.Keypair
+	Authority     bool
+	ForkID        string
+	MinBlockDelta uint32
+}
+
+// NewService returns a new BEEFY service
+func NewService(cfg *Config) (*Service, error) {
+	if cfg.Authority && cfg.Keypair == nil {
+		return nil, ErrNilKeypair
+	}
+
+	logger.Patch(log.SetLevel(cfg.LogLvl))
+
+	keypair := cfg.Keypair
+	if !cfg.Authority {
+		keypair = nil
+	}
+
+	minBlockDelta := cfg.MinBlockDelta
+	if minBlockDelta == 0 {
+		minBlockDelta = defaultMinBlockDelta
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	return &Service{
+		ctx:            ctx,
+		cancel:         cancel,
+		blockState:     cfg.BlockState,
+		storageState:   cfg.StorageState,
+		network:        cfg.Network,
+		keypair:        keypair,
+		forkID:         cfg.ForkID,
+		minBlockDelta:  minBlockDelta,
+		justifications: make(map[chan []byte]struct{}),
+
+		pendingJustifications: make(map[uint32]SignedCommitment),
+	}, nil
+}
+
+// Start registers the BEEFY notifications protocol and starts voting on the finalized blocks
+func (s *Service) Start() error {
+	err := s.registerProtocol()
+	if err != nil {
+		return fmt.Errorf("registering beefy protocol: %w", err)
+	}
+
+	finalised, err := s.blockState.GetHighestFinalisedHeader()
+	if err != nil {
+		return fmt.Errorf("getting highest finalised header: %w", err)
+	}
+
+	s.lock.Lock()
+	err = s.initialize(finalised)
+	s.lock.Unlock()
+	if err != nil {
+		return err
+	}
+
+	s.finalisedCh = s.blockState.GetFinalisedNotifierChannel()
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+		s.run()
+	}()
+	return nil
+}
+
+// Stop stops the BEEFY service
+func (s *Service) Stop() error {
+	s.cancel()
+	s.wg.Wait()
+	if s.finalisedCh != nil {
+		s.blockState.FreeFinalisedNotifierChannel(s.finalisedCh)
+	}
+	return nil
+}
+
+func (s *Service) run() {
+	for {
+		select {
+		case <-s.ctx.Done():
+			return
+		case info, ok := <-s.finalisedCh:
+			if !ok {
+				return
+			}
+
+			err := s.onFinalised(&info.Header)
+			if err != nil {
+				logger.Warnf("failed to handle finalised block %d: %s", info.Header.Number, err)
+			}
+		}
+	}
+}
+
+// initialize loads the best BEEFY block and the validator set of the runtime at the
+// finalised block given. The BEEFY worker stays inactive until the runtime has a validator set.
+func (s *Service) initialize(finalised *types.Header) error {
+	s.bestGrandpa = uint32(finalised.Number) //nolint:gosec
+
+	bestBeefyHash, err := s.blockState.GetBestBeefyBlockHash()
+	switch {
+	case errors.Is(err, database.ErrNotFound):
+	case err != nil:
+		return fmt.Errorf("getting best beefy block hash: %w", err)
+	default:
+		bestBeefy, err := s.blockState.GetHeader(bestBeefyHash)
+		if err != nil {
+			return fmt.Errorf("getting best beefy block header: %w", err)
+		}
+		s.bestBeefy = uint32(bestBeefy.Number) //nolint:gosec
+	}
+
+	validatorSet, err := s.validatorSetAt(finalised)
+	if err != nil {
+		logger.Debugf("beefy is not active at block %d: %s", finalised.Number, err)
+		return nil
+	}
+	if validatorSet == nil {
+		logger.Debugf("beefy is not active at block %d: no validator set", finalised.Number)
+		return nil
+	}
+
+	sessionStart, err := s.findSessionStart(finalised, validatorSet.ID)
+	if err != nil {
+		return err
+	}
+
+	s.startSession(*validatorSet, sessionStart)
+	return nil
+}
+
+// validatorSetAt returns the BEEFY validator set of the runtime at the state of the block given.
+func (s *Service) validatorSetAt(header *types.Header) (*types.BeefyValidatorSet, error) {
+	s.storageState.Lock()
+	defer s.storageState.Unlock()
+
+	ts, err := s.storageState.TrieState(&header.StateRoot)
+	if err != nil {
+		return nil, fmt.Errorf("getting trie state with state root %s: %w", header.StateRoot, err)
+	}
+
+	rt, err := s.blockState.GetRuntime(header.Hash())
+	if err != nil {
+		return nil, fmt.Errorf("getting runtime: %w", err)
+	}
+
+	rt.SetContextStorage(ts)
+	return rt.BeefyValidatorSet()
+}
+
+// findSessionStart walks back the finalised chain from the block given to find the block
+// which enacted the validator set with the id given, the first block of its session.
+// It stops at the best BEEFY block, BEEFY having finalised the earlier session starts.
+func (s *Service) findSessionStart(header *types.Header, setID uint64) (uint32, error) {
+	for header.Number > uint(s.bestBeefy) && header.Number > 0 {
+		logs, err := consensusLogs(header)
+		if err != nil {
+			return 0, err
+		}
+
+		for _, value := range logs {
+			change, ok := value.(authoritiesChange)
+			if ok && change.ID == setID {
+				return uint32(header.Number), nil //nolint:gosec
+			}
+		}
+
+		header, err = s.blockState.GetHeader(header.ParentHash)
+		if err != nil {
+			return 0, fmt.Errorf("getting parent header: %w", err)
+		}
+	}
+
+	if s.bestBeefy == 0 {
+		return 1, nil
+	}
+	return s.bestBeefy, nil
+}
+
+func (s *Service) startSession(validatorSet types.BeefyValidatorSet, sessionStart uint32) {
+	logger.Infof("starting beefy session of validator set %d with %d validators at block %d",
+		validatorSet.ID, len(validatorSet.Validators), sessionStart)
+
+	s.validatorSet = &validatorSet
+	s.rounds = newRounds(validatorSet)
+	s.sessionStart = sessionStart
+}
+
+// onFinalised starts the sessions of the validator sets enacted in the blocks newly
+// finalised by GRANDPA, and votes on the next block to finalise with BEEFY.
+func (s *Service) onFinalised(header *types.Header) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	number := uint32(header.Number) //nolint:gosec
+	if number <= s.bestGrandpa {
+		return nil
+	}
+
+	if s.validatorSet == nil {
+		err := s.initialize(header)
+		if err != nil {
+			return err
+		}
+	} else {
+		for blockNumber := s.bestGrandpa + 1; blockNumber <= number; blockNumber++ {
+			blockHeader, err := s.blockState.GetHeaderByNumber(uint(blockNumber))
+			if err != nil {
+				return fmt.Errorf("getting header of block %d: %w", blockNumber, err)
+			}
+
+			logs, err := consensusLogs(blockHeader)
+			if err != nil {
+				return err
+			}
+
+			for _, value := range logs {
+				change, ok := value.(authoritiesChange)
+				if ok && change.ID > s.validatorSet.ID {
+					s.startSession(types.BeefyValidatorSet(change), blockNumber)
+				}
+			}
+		}
+	}
+
+	s.bestGrandpa = number
+	if s.validatorSet == nil {
+		return nil
+	}
+
+	s.importPendingJustifications()
+	return s.vote()
+}
+
+// voteTarget returns the number of the next block to vote on, and false if there is no
+// block to vote on until GRANDPA finalises more blocks. The first block of the session
+// is voted on first, then the blocks are voted on less frequently as BEEFY lags behind
+// GRANDPA, with at least the minimum delta between two blocks voted on.
+func voteTarget(bestGrandpa, bestBeefy, sessionStart, minDelta uint32) (target uint32, ok bool) {
+	if bestBeefy < sessionStart {
+		target = sessionStart
+	} else {
+		if bestGrandpa < bestBeefy {
+			return 0, false
+		}
+
+		diff := bestGrandpa - bestBeefy + 1
+		delta := nextPowerOfTwo(diff)
+		if delta < minDelta {
+			delta = minDelta
+		}
+		target = bestBeefy + delta/2
+	}
+
+	return target, target <= bestGrandpa
+}
+
+func nextPowerOfTwo(n uint32) uint32 {
+	power := uint32(1)
+	for power < n {
+		power <<= 1
+	}
+	return power
+}
+
+// vote signs and gossips our vote on the commitment of the vote target, if our
+// authority is a validator of the current validator set.
+func (s *Service) vote() error {
+	if s.keypair == nil {
+		return nil
+	}
+
+	id := authorityID(s.keypair)
+	if _, ok := s.rounds.validatorIndex(id); !ok {
+		return nil
+	}
+
+	target, ok := voteTarget(s.bestGrandpa, s.bestBeefy, s.sessionStart, s.minBlockDelta)
+	if !ok || target <= s.lastVoted {
+		return nil
+	}
+
+	header, err := s.blockState.GetHeaderByNumber(uint(target))
+	if err != nil {
+		return fmt.Errorf("getting header of block %d: %w", target, err)
+	}
+
+	payload, err := commitmentPayload(header)
+	if err != nil {
+		return err
+	}
+	if payload == nil {
+		logger.Debugf("no mmr root digest in block %d, not voting", target)
+		return nil
+	}
+
+	commitment := Commitment{
+		Payload:        payload,
+		BlockNumber:    target,
+		ValidatorSetID: s.validatorSet.ID,
+	}
+	signature, err := sign(s.keypair, commitment)
+	if err != nil {
+		return err
+	}
+
+	vote := VoteMessage{
+		Commitment: commitment,
+		ID:         id,
+		Signature:  signature,
+	}
+	s.lastVoted = target
+	logger.Debugf("voting on block %d of validator set %d", target, s.validatorSet.ID)
+
+	signedCommitment, err := s.rounds.addVote(vote)
+	if err != nil {
+		return fmt.Errorf("adding our vote: %w", err)
+	}
+	s.gossip(vote)
+
+	if signedCommitment != nil {
+		return s.finalise(*signedCommitment, true)
+	}
+	return nil
+}
+
+// commitmentPayload returns the payload of the commitment to the block, holding the
+// MMR root of its consensus digest, or nil if the block has no MMR root digest.
+func commitmentPayload(header *types.Header) (Payload, error) {
+	logs, err := consensusLogs(header)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, value := range logs {
+		root, ok := value.(mmrRoot)
+		if !ok {
+			continue
+		}
+
+		data, err := scale.Marshal(common.Hash(root))
+		if err != nil {
+			return nil, fmt.Errorf("encoding mmr root: %w", err)
+		}
+		return Payload{{ID: MmrRootID, Data: data}}, nil
+	}
+	return nil, nil
+}
+
+// handleVote adds the vote received from a peer, finalising its block if the vote
+// completes the signatures required.
+func (s *Service) handleVote(vote VoteMessage) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	err := s.checkMessageBlock(vote.Commitment.BlockNumber)
+	if err != nil {
+		return err
+	}
+
+	signedCommitment, err := s.rounds.addVote(vote)
+	if err != nil {
+		return err
+	}
+
+	if signedCommitment != nil {
+		return s.finalise(*signedCommitment, true)
+	}
+	return nil
+}
+
+// handleFinalityProof verifies and imports the finality proof received from a peer.
+func (s *Service) handleFinalityProof(proof VersionedFinalityProof) error {
+	signedCommitment, err := proof.SignedCommitment()
+	if err != nil {
+		return fmt.Errorf("%w: %s", errInvalidSignedCommitment, err)
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	return s.importFinalityProof(signedCommitment)
+}
+
+// ImportJustification imports the BEEFY justification received with the block of the number
+// given. The justification of a block not finalised by GRANDPA yet is verified against the
+// current validator set, and imported once GRANDPA finalises the block.
+func (s *Service) ImportJustification(blockNumber uint, proof VersionedFinalityProof) error {
+	signedCommitment, err := proof.SignedCommitment()
+	if err != nil {
+		return fmt.Errorf("%w: %s", errInvalidSignedCommitment, err)
+	}
+
+	number := signedCommitment.Commitment.BlockNumber
+	if uint(number) != blockNumber {
+		return fmt.Errorf("%w: justification for block %d imported with block %d",
+			errJustificationBlockMismatch, number, blockNumber)
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if number <= s.bestGrandpa {
+		return s.importFinalityProof(signedCommitment)
+	}
+
+	if s.validatorSet == nil {
+		return fmt.Errorf("%w: %w", errStaleMessage, ErrBeefyNotReady)
+	}
+
+	err = s.rounds.verifyFinalityProof(signedCommitment)
+	if err != nil {
+		return err
+	}
+
+	_, ok := s.pendingJustifications[number]
+	if !ok && len(s.pendingJustifications) >= maxPendingJustifications {
+		return fmt.Errorf("%w: %d justifications waiting for grandpa",
+			errTooManyPendingJustifications, len(s.pendingJustifications))
+	}
+	s.pendingJustifications[number] = signedCommitment
+	return nil
+}
+
+// importPendingJustifications imports, in ascending order, the pending justifications
+// of the blocks finalised by GRANDPA.
+func (s *Service) importPendingJustifications() {
+	numbers := make([]uint32, 0, len(s.pendingJustifications))
+	for number := range s.pendingJustifications {
+		if number <= s.bestGrandpa {
+			numbers = append(numbers, number)
+		}
+	}
+	sort.Slice(numbers, func(i, j int) bool { return numbers[i] < numbers[j] })
+
+	for _, number := range numbers {
+		signedCommitment := s.pendingJustifications[number]
+		delete(s.pendingJustifications, number)
+
+		err := s.importFinalityProof(signedCommitment)
+		if err != nil {
+			logger.Debugf("failed to import justification of block %d: %s", number, err)
+		}
+	}
+}
+
+// importFinalityProof verifies and imports the signed commitment of a finality proof,
+// the lock being held.
+func (s *Service) importFinalityProof(signedCommitment SignedCommitment) error {
+	err := s.checkMessageBlock(signedCommitment.Commitment.BlockNumber)
+	if err != nil {
+		return err
+	}
+
+	err = s.rounds.verifyFinalityProof(signedCommitment)
+	if err != nil {
+		return err
+	}
+
+	return s.finalise(signedCommitment, false)
+}
+
+// checkMessageBlock returns errStaleMessage if the block of a message received cannot
+// be finalised, either because it is already finalised by BEEFY or not yet by GRANDPA.
+func (s *Service) checkMessageBlock(number uint32) error {
+	if s.validatorSet == nil {
+		return fmt.Errorf("%w: %w", errStaleMessage, ErrBeefyNotReady)
+	}
+
+	if number <= s.bestBeefy {
+		return fmt.Errorf("%w: block %d is already finalised", errStaleMessage, number)
+	}
+
+	if number > s.bestGrandpa {
+		return fmt.Errorf("%w: block %d is not finalised by grandpa yet", errStaleMessage, number)
+	}
+	return nil
+}
+
+// finalise stores the finality proof of the signed commitment as the BEEFY justification
+// of its block, and notifies the justification subscribers. The finality proofs built from
+// the votes are gossiped, the ones received being propagated by the network.
+func (s *Service) finalise(signedCommitment SignedCommitment, gossip bool) error {
+	number := signedCommitment.Commitment.BlockNumber
+	header, err := s.blockState.GetHeaderByNumber(uint(number))
+	if err != nil {
+		return fmt.Errorf("getting header of block %d: %w", number, err)
+	}
+	hash := header.Hash()
+
+	proof := NewVersionedFinalityProof(signedCommitment)
+	encoded, err := scale.Marshal(proof)
+	if err != nil {
+		return fmt.Errorf("encoding finality proof: %w", err)
+	}
+
+	err = s.blockState.SetBeefyJustification(hash, encoded)
+	if err != nil {
+		return fmt.Errorf("setting beefy justification: %w", err)
+	}
+
+	err = s.blockState.SetBestBeefyBlockHash(hash)
+	if err != nil {
+		return fmt.Errorf("setting best beefy block hash: %w", err)
+	}
+
+	s.bestBeefy = number
+	s.rounds.prune(number)
+	logger.Infof("🥩 finalised block %d with hash %s", number, hash)
+
+	s.notifyJustification(encoded)
+	if gossip {
+		s.gossip(proof)
+	}
+
+	// the blocks voted on get further apart as BEEFY finalises blocks.
+	return s.vote()
+}
+
+// BestBeefyBlockHash returns the hash of the best block finalised by BEEFY
+func (s *Service) BestBeefyBlockHash() (common.Hash, error) {
+	hash, err := s.blockState.GetBestBeefyBlockHash()
+	if errors.Is(err, database.ErrNotFound) {
+		return common.Hash{}, ErrBeefyNotReady
+	}
+	return hash, err
+}
+
+// GetJustificationsNotifierChannel returns a channel receiving the SCALE encoded
+// finality proofs of the blocks finalised by BEEFY
+func (s *Service) GetJustificationsNotifierChannel() chan []byte {
+	s.justificationsLock.Lock()
+	defer s.justificationsLock.Unlock()
+
+	ch := make(chan []byte, justificationsBufferSize)
+	s.justifications[ch] = struct{}{}
+	return ch
+}
+
+// FreeJustificationsNotifierChannel frees the justifications notifier channel
+func (s *Service) FreeJustificationsNotifierChannel(ch chan []byte) {
+	s.justificationsLock.Lock()
+	defer s.justificationsLock.Unlock()
+
+	delete(s.justifications, ch)
+}
+
+func (s *Service) notifyJustification(justification []byte) {
+	s.justificationsLock.RLock()
+	defer s.justificationsLock.RUnlock()
+
+	for ch := range s.justifications {
+		go func(ch chan []byte) {
+			select {
+			case ch <- justification:
+			default:
+			}
+		}(ch)
+	}
+}
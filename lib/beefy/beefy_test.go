@@ -0,0 +1,220 @@
+// Copyright 2024 ChainSafe Systems (ON)
+// SPDX-License-Identifier: LGPL-3.0-only
+
+package beefy
+
+import (
+	"testing"
+
+	"github.com/ChainSafe/gossamer/dot/types"
+	"github.com/ChainSafe/gossamer/pkg/scale"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func Test_voteTarget(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		bestGrandpa  uint32
+		bestBeefy    uint32
+		sessionStart uint32
+		target       uint32
+		ok           bool
+	}{
+		"session_start_first": {
+			bestGrandpa:  20,
+			bestBeefy:    3,
+			sessionStart: 10,
+			target:       10,
+			ok:           true,
+		},
+		"session_start_not_finalised": {
+			bestGrandpa:  9,
+			bestBeefy:    3,
+			sessionStart: 10,
+			target:       10,
+		},
+		"min_delta": {
+			bestGrandpa:  12,
+			bestBeefy:    10,
+			sessionStart: 10,
+			target:       14,
+		},
+		"min_delta_reached": {
+			bestGrandpa:  14,
+			bestBeefy:    10,
+			sessionStart: 10,
+			target:       14,
+			ok:           true,
+		},
+		"lagging_behind": {
+			bestGrandpa:  40,
+			bestBeefy:    10,
+			sessionStart: 10,
+			target:       26,
+			ok:           true,
+		},
+		"grandpa_behind_beefy": {
+			bestGrandpa:  9,
+			bestBeefy:    10,
+			sessionStart: 10,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			target, ok := voteTarget(testCase.bestGrandpa, testCase.bestBeefy, testCase.sessionStart, defaultMinBlockDelta)
+			assert.Equal(t, testCase.target, target)
+			assert.Equal(t, testCase.ok, ok)
+		})
+	}
+}
+
+func Test_Service_ImportJustification(t *testing.T) {
+	t.Parallel()
+
+	validatorSet, keypairs := newTestValidators(t, 4)
+
+	newProof := func(t *testing.T, number uint32, signers int) VersionedFinalityProof {
+		t.Helper()
+		commitment := Commitment{
+			Payload:        Payload{{ID: MmrRootID, Data: []byte{1}}},
+			BlockNumber:    number,
+			ValidatorSetID: 1,
+		}
+		signatures := make([]*Signature, len(validatorSet.Validators))
+		for i := 0; i < signers; i++ {
+			signature, err := sign(keypairs[i], commitment)
+			require.NoError(t, err)
+			signatures[i] = &signature
+		}
+		return NewVersionedFinalityProof(SignedCommitment{Commitment: commitment, Signatures: signatures})
+	}
+
+	fullPending := make(map[uint32]SignedCommitment, maxPendingJustifications)
+	for i := uint32(0); i < maxPendingJustifications; i++ {
+		fullPending[100+i] = SignedCommitment{}
+	}
+
+	testCases := map[string]struct {
+		notReady        bool
+		pending         map[uint32]SignedCommitment
+		blockNumber     uint
+		proof           func(t *testing.T) VersionedFinalityProof
+		errWrapped      error
+		expectedPending []uint32
+	}{
+		"block_number_mismatch": {
+			blockNumber: 11,
+			proof:       func(t *testing.T) VersionedFinalityProof { return newProof(t, 12, 3) },
+			errWrapped:  errJustificationBlockMismatch,
+		},
+		"finalised_by_grandpa_not_enough_signatures": {
+			blockNumber: 8,
+			proof:       func(t *testing.T) VersionedFinalityProof { return newProof(t, 8, 2) },
+			errWrapped:  ErrNotEnoughSignatures,
+		},
+		"beefy_not_ready": {
+			notReady:    true,
+			blockNumber: 12,
+			proof:       func(t *testing.T) VersionedFinalityProof { return newProof(t, 12, 3) },
+			errWrapped:  ErrBeefyNotReady,
+		},
+		"not_enough_signatures": {
+			blockNumber: 12,
+			proof:       func(t *testing.T) VersionedFinalityProof { return newProof(t, 12, 2) },
+			errWrapped:  ErrNotEnoughSignatures,
+		},
+		"too_many_pending": {
+			pending:     fullPending,
+			blockNumber: 12,
+			proof:       func(t *testing.T) VersionedFinalityProof { return newProof(t, 12, 3) },
+			errWrapped:  errTooManyPendingJustifications,
+		},
+		"waiting_for_grandpa": {
+			blockNumber:     12,
+			proof:           func(t *testing.T) VersionedFinalityProof { return newProof(t, 12, 3) },
+			expectedPending: []uint32{12},
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			s := &Service{
+				validatorSet:          &validatorSet,
+				rounds:                newRounds(validatorSet),
+				bestGrandpa:           10,
+				pendingJustifications: make(map[uint32]SignedCommitment),
+			}
+			if testCase.notReady {
+				s.validatorSet = nil
+				s.rounds = nil
+			}
+			for number, signedCommitment := range testCase.pending {
+				s.pendingJustifications[number] = signedCommitment
+			}
+
+			err := s.ImportJustification(testCase.blockNumber, testCase.proof(t))
+
+			require.ErrorIs(t, err, testCase.errWrapped)
+			if testCase.pending != nil {
+				assert.Len(t, s.pendingJustifications, len(testCase.pending))
+				return
+			}
+			var pending []uint32
+			for number := range s.pendingJustifications {
+				pending = append(pending, number)
+			}
+			assert.Equal(t, testCase.expectedPending, pending)
+		})
+	}
+}
+
+func Test_Service_importPendingJustifications(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+
+	validatorSet, keypairs := newTestValidators(t, 1)
+	commitment := Commitment{
+		Payload:        Payload{{ID: MmrRootID, Data: []byte{1}}},
+		BlockNumber:    12,
+		ValidatorSetID: 1,
+	}
+	signature, err := sign(keypairs[0], commitment)
+	require.NoError(t, err)
+	proof := NewVersionedFinalityProof(SignedCommitment{Commitment: commitment, Signatures: []*Signature{&signature}})
+
+	header := &types.Header{Number: 12}
+	blockState := NewMockBlockState(ctrl)
+
+	s := &Service{
+		blockState:            blockState,
+		validatorSet:          &validatorSet,
+		rounds:                newRounds(validatorSet),
+		bestGrandpa:           10,
+		pendingJustifications: make(map[uint32]SignedCommitment),
+	}
+
+	err = s.ImportJustification(12, proof)
+	require.NoError(t, err)
+
+	s.importPendingJustifications()
+	assert.Len(t, s.pendingJustifications, 1)
+
+	blockState.EXPECT().GetHeaderByNumber(uint(12)).Return(header, nil)
+	blockState.EXPECT().SetBeefyJustification(header.Hash(), scale.MustMarshal(proof)).Return(nil)
+	blockState.EXPECT().SetBestBeefyBlockHash(header.Hash()).Return(nil)
+
+	s.bestGrandpa = 12
+	s.importPendingJustifications()
+	assert.Empty(t, s.pendingJustifications)
+	assert.Equal(t, uint32(12), s.bestBeefy)
+}
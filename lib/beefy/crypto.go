@@ -0,0 +1,52 @@
+// Copyright 2024 ChainSafe Systems (ON)
+// SPDX-License-Identifier: LGPL-3.0-only
+
+package beefy
+
+import (
+	"fmt"
+
+	"github.com/ChainSafe/gossamer/dot/types"
+	"github.com/ChainSafe/gossamer/lib/crypto/secp256k1"
+)
+
+// sign signs the commitment with the BEEFY key given.
+func sign(kp *secp256k1.Keypair, commitment Commitment) (signature Signature, err error) {
+	hash, err := commitment.hash()
+	if err != nil {
+		return signature, err
+	}
+
+	sig, err := kp.Sign(hash[:])
+	if err != nil {
+		return signature, fmt.Errorf("signing commitment: %w", err)
+	}
+
+	copy(signature[:], sig)
+	return signature, nil
+}
+
+// verify verifies that the commitment was signed by the authority given.
+func verify(id types.BeefyAuthorityID, commitment Commitment, signature Signature) error {
+	hash, err := commitment.hash()
+	if err != nil {
+		return err
+	}
+
+	// the public key recovery modifies the recovery byte of the signature, which is copied.
+	signer, err := secp256k1.RecoverPublicKeyCompressed(hash[:], signature[:])
+	if err != nil {
+		return fmt.Errorf("%w: %s", ErrInvalidSignature, err)
+	}
+
+	if types.BeefyAuthorityID(signer) != id {
+		return fmt.Errorf("%w: signed by 0x%x instead of 0x%x", ErrInvalidSignature, signer, id)
+	}
+	return nil
+}
+
+// authorityID returns the BEEFY authority id of the key given.
+func authorityID(kp *secp256k1.Keypair) (id types.BeefyAuthorityID) {
+	copy(id[:], kp.Public().Encode())
+	return id
+}
@@ -0,0 +1,33 @@
+// Copyright 2024 ChainSafe Systems (ON)
+// SPDX-License-Identifier: LGPL-3.0-only
+
+package beefy
+
+import "errors"
+
+var (
+	// ErrInvalidSignature is returned when a vote or a finality proof has an invalid signature
+	ErrInvalidSignature = errors.New("invalid signature")
+
+	// ErrValidatorSetIDMismatch is returned when a vote or a finality proof is not for the current validator set
+	ErrValidatorSetIDMismatch = errors.New("validator set id mismatch")
+
+	// ErrNotValidator is returned when a vote is from an authority which is not in the validator set
+	ErrNotValidator = errors.New("not a validator of the validator set")
+
+	// ErrNotEnoughSignatures is returned when a finality proof is not signed by enough validators
+	ErrNotEnoughSignatures = errors.New("not enough signatures")
+
+	// ErrNilKeypair is returned when the service is an authority without a BEEFY key
+	ErrNilKeypair = errors.New("cannot create beefy authority service with nil keypair")
+
+	// ErrBeefyNotReady is returned when BEEFY has not finalized any block yet
+	ErrBeefyNotReady = errors.New("beefy has not finalized any block yet")
+
+	errInvalidSignedCommitment = errors.New("invalid signed commitment")
+	errStaleMessage            = errors.New("stale message")
+	errInvalidMessageType      = errors.New("invalid message type")
+
+	errJustificationBlockMismatch   = errors.New("justification block number mismatch")
+	errTooManyPendingJustifications = errors.New("too many pending justifications")
+)
@@ -0,0 +1,6 @@
+// Copyright 2024 ChainSafe Systems (ON)
+// SPDX-License-Identifier: LGPL-3.0-only
+
+package beefy
+
+//go:generate mockgen -destination=mocks_test.go -package=$GOPACKAGE . BlockState
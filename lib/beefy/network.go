@@ -0,0 +1,158 @@
+// Copyright 2024 ChainSafe Systems (ON)
+// SPDX-License-Identifier: LGPL-3.0-only
+
+package beefy
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/libp2p/go-libp2p/core/protocol"
+
+	"github.com/ChainSafe/gossamer/dot/network"
+	"github.com/ChainSafe/gossamer/lib/common"
+	"github.com/ChainSafe/gossamer/pkg/scale"
+)
+
+const beefyID2 = "beefy/2"
+
+// legacyBeefyProtocolID is the protocol id used by peers which do not
+// support the genesis hash prefixed protocol id.
+const legacyBeefyProtocolID = "/paritytech/beefy/2"
+
+// Handshake is exchanged by nodes that are beginning the BEEFY gossip protocol
+type Handshake struct {
+	Role common.NetworkRole
+}
+
+// String formats a Handshake as a string
+func (hs *Handshake) String() string {
+	return fmt.Sprintf("BeefyHandshake NetworkRole=%d", hs.Role)
+}
+
+// Encode encodes a Handshake message using SCALE
+func (hs *Handshake) Encode() ([]byte, error) {
+	return scale.Marshal(*hs)
+}
+
+// Decode the message into a Handshake
+func (hs *Handshake) Decode(in []byte) error {
+	return scale.Unmarshal(in, hs)
+}
+
+// IsValid return if it is a valid handshake.
+func (hs *Handshake) IsValid() bool {
+	switch hs.Role {
+	case common.AuthorityRole, common.FullNodeRole:
+		return true
+	default:
+		return false
+	}
+}
+
+func (s *Service) registerProtocol() error {
+	genesisHash := s.blockState.GenesisHash().String()
+	genesisHash = strings.TrimPrefix(genesisHash, "0x")
+	beefyProtocolID := fmt.Sprintf("/%s/%s", genesisHash, beefyID2)
+	if s.forkID != "" {
+		beefyProtocolID = fmt.Sprintf("/%s/%s/%s", genesisHash, s.forkID, beefyID2)
+	}
+
+	return s.network.RegisterNotificationsProtocol(
+		protocol.ID(beefyProtocolID),
+		network.BeefyMsgType,
+		s.getHandshake,
+		decodeHandshake,
+		validateHandshake,
+		decodeMessage,
+		s.handleNetworkMessage,
+		nil,
+		network.MaxBeefyNotificationSize,
+		legacyBeefyProtocolID,
+	)
+}
+
+func (s *Service) getHandshake() (network.Handshake, error) {
+	role := common.FullNodeRole
+	if s.keypair != nil {
+		role = common.AuthorityRole
+	}
+
+	return &Handshake{
+		Role: role,
+	}, nil
+}
+
+func decodeHandshake(in []byte) (network.Handshake, error) {
+	hs := new(Handshake)
+	err := hs.Decode(in)
+	return hs, err
+}
+
+func validateHandshake(_ peer.ID, _ network.Handshake) error {
+	return nil
+}
+
+func decodeMessage(in []byte) (network.NotificationsMessage, error) {
+	msg := new(network.BeefyMessage)
+	err := msg.Decode(in)
+	return msg, err
+}
+
+// handleNetworkMessage handles a vote or a finality proof gossiped by a peer,
+// returning true if the message is new and valid so that it is propagated.
+func (s *Service) handleNetworkMessage(from peer.ID, msg network.NotificationsMessage) (bool, error) {
+	bm, ok := msg.(*network.BeefyMessage)
+	if !ok {
+		return false, errInvalidMessageType
+	}
+
+	var gm gossipMessage
+	err := scale.Unmarshal(bm.Data, &gm)
+	if err != nil {
+		return false, fmt.Errorf("decoding beefy message: %w", err)
+	}
+
+	value, err := gm.Value()
+	if err != nil {
+		return false, fmt.Errorf("getting beefy message value: %w", err)
+	}
+
+	switch value := value.(type) {
+	case VoteMessage:
+		err = s.handleVote(value)
+	case VersionedFinalityProof:
+		err = s.handleFinalityProof(value)
+	default:
+		return false, errInvalidMessageType
+	}
+
+	if err != nil {
+		if errors.Is(err, errStaleMessage) {
+			return false, nil
+		}
+		logger.Debugf("invalid beefy message from peer %s: %s", from, err)
+		return false, err
+	}
+	return true, nil
+}
+
+// gossip gossips the vote or finality proof to our peers.
+func (s *Service) gossip(value any) {
+	var gm gossipMessage
+	err := gm.SetValue(value)
+	if err != nil {
+		logger.Errorf("setting beefy message value: %s", err)
+		return
+	}
+
+	data, err := scale.Marshal(gm)
+	if err != nil {
+		logger.Errorf("encoding beefy message: %s", err)
+		return
+	}
+
+	s.network.GossipMessage(&network.BeefyMessage{Data: data})
+}
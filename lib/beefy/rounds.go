@@ -0,0 +1,158 @@
+// Copyright 2024 ChainSafe Systems (ON)
+// SPDX-License-Identifier: LGPL-3.0-only
+
+package beefy
+
+import (
+	"fmt"
+
+	"github.com/ChainSafe/gossamer/dot/types"
+	"github.com/ChainSafe/gossamer/lib/common"
+)
+
+// rounds collects the votes of the validators of a validator set on the commitments of blocks.
+type rounds struct {
+	validatorSet types.BeefyValidatorSet
+	// commitments maps the hash of the commitments voted on to their votes. The validators
+	// which do not agree on the payload of a block vote on different commitments.
+	commitments map[common.Hash]*commitmentVotes
+	// voters records, for each block number, the index of the validators which voted,
+	// so that only the first vote of a validator on a block is counted.
+	voters map[uint32]map[int]struct{}
+}
+
+type commitmentVotes struct {
+	commitment Commitment
+	signatures map[int]Signature
+}
+
+func newRounds(validatorSet types.BeefyValidatorSet) *rounds {
+	return &rounds{
+		validatorSet: validatorSet,
+		commitments:  make(map[common.Hash]*commitmentVotes),
+		voters:       make(map[uint32]map[int]struct{}),
+	}
+}
+
+// threshold returns the number of signatures required to finalize a block,
+// which is more than two thirds of the validators.
+func (r *rounds) threshold() int {
+	validators := len(r.validatorSet.Validators)
+	faulty := (validators - 1) / 3
+	return validators - faulty
+}
+
+func (r *rounds) validatorIndex(id types.BeefyAuthorityID) (index int, ok bool) {
+	for i, validator := range r.validatorSet.Validators {
+		if validator == id {
+			return i, true
+		}
+	}
+	return 0, false
+}
+
+// addVote verifies and adds the vote, and returns the signed commitment of the commitment voted on
+// once it is signed by enough validators.
+func (r *rounds) addVote(vote VoteMessage) (*SignedCommitment, error) {
+	if vote.Commitment.ValidatorSetID != r.validatorSet.ID {
+		return nil, fmt.Errorf("%w: vote for set %d, current set is %d",
+			ErrValidatorSetIDMismatch, vote.Commitment.ValidatorSetID, r.validatorSet.ID)
+	}
+
+	index, ok := r.validatorIndex(vote.ID)
+	if !ok {
+		return nil, fmt.Errorf("%w: 0x%x", ErrNotValidator, vote.ID)
+	}
+
+	err := verify(vote.ID, vote.Commitment, vote.Signature)
+	if err != nil {
+		return nil, err
+	}
+
+	number := vote.Commitment.BlockNumber
+	voters, has := r.voters[number]
+	if !has {
+		voters = make(map[int]struct{})
+		r.voters[number] = voters
+	}
+	if _, voted := voters[index]; voted {
+		return nil, nil
+	}
+	voters[index] = struct{}{}
+
+	hash, err := vote.Commitment.hash()
+	if err != nil {
+		return nil, err
+	}
+
+	votes, has := r.commitments[hash]
+	if !has {
+		votes = &commitmentVotes{
+			commitment: vote.Commitment,
+			signatures: make(map[int]Signature),
+		}
+		r.commitments[hash] = votes
+	}
+	votes.signatures[index] = vote.Signature
+
+	if len(votes.signatures) < r.threshold() {
+		return nil, nil
+	}
+
+	signedCommitment := &SignedCommitment{
+		Commitment: votes.commitment,
+		Signatures: make([]*Signature, len(r.validatorSet.Validators)),
+	}
+	for i, signature := range votes.signatures {
+		signature := signature
+		signedCommitment.Signatures[i] = &signature
+	}
+	return signedCommitment, nil
+}
+
+// verifyFinalityProof verifies that the signed commitment is signed by enough validators.
+func (r *rounds) verifyFinalityProof(signedCommitment SignedCommitment) error {
+	commitment := signedCommitment.Commitment
+	if commitment.ValidatorSetID != r.validatorSet.ID {
+		return fmt.Errorf("%w: proof for set %d, current set is %d",
+			ErrValidatorSetIDMismatch, commitment.ValidatorSetID, r.validatorSet.ID)
+	}
+
+	if len(signedCommitment.Signatures) != len(r.validatorSet.Validators) {
+		return fmt.Errorf("%w: %d signatures for %d validators", errInvalidSignedCommitment,
+			len(signedCommitment.Signatures), len(r.validatorSet.Validators))
+	}
+
+	var signatures int
+	for i, signature := range signedCommitment.Signatures {
+		if signature == nil {
+			continue
+		}
+
+		err := verify(r.validatorSet.Validators[i], commitment, *signature)
+		if err != nil {
+			return err
+		}
+		signatures++
+	}
+
+	if signatures < r.threshold() {
+		return fmt.Errorf("%w: %d signatures, %d required", ErrNotEnoughSignatures, signatures, r.threshold())
+	}
+	return nil
+}
+
+// prune removes the votes on the blocks up to the block number given.
+func (r *rounds) prune(number uint32) {
+	for hash, votes := range r.commitments {
+		if votes.commitment.BlockNumber <= number {
+			delete(r.commitments, hash)
+		}
+	}
+
+	for blockNumber := range r.voters {
+		if blockNumber <= number {
+			delete(r.voters, blockNumber)
+		}
+	}
+}
@@ -0,0 +1,191 @@
+// Copyright 2024 ChainSafe Systems (ON)
+// SPDX-License-Identifier: LGPL-3.0-only
+
+package beefy
+
+import (
+	"testing"
+
+	"github.com/ChainSafe/gossamer/dot/types"
+	"github.com/ChainSafe/gossamer/lib/crypto/secp256k1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestValidators(t *testing.T, n int) (types.BeefyValidatorSet, []*secp256k1.Keypair) {
+	t.Helper()
+
+	validatorSet := types.BeefyValidatorSet{ID: 1}
+	keypairs := make([]*secp256k1.Keypair, n)
+	for i := range keypairs {
+		kp, err := secp256k1.GenerateKeypair()
+		require.NoError(t, err)
+		keypairs[i] = kp
+		validatorSet.Validators = append(validatorSet.Validators, authorityID(kp))
+	}
+	return validatorSet, keypairs
+}
+
+func newTestVote(t *testing.T, kp *secp256k1.Keypair, commitment Commitment) VoteMessage {
+	t.Helper()
+
+	signature, err := sign(kp, commitment)
+	require.NoError(t, err)
+	return VoteMessage{
+		Commitment: commitment,
+		ID:         authorityID(kp),
+		Signature:  signature,
+	}
+}
+
+func Test_rounds_threshold(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[int]int{
+		1:  1,
+		2:  2,
+		3:  3,
+		4:  3,
+		7:  5,
+		10: 7,
+	}
+
+	for validators, threshold := range testCases {
+		validatorSet := types.BeefyValidatorSet{
+			Validators: make([]types.BeefyAuthorityID, validators),
+		}
+		assert.Equal(t, threshold, newRounds(validatorSet).threshold(), "%d validators", validators)
+	}
+}
+
+func Test_rounds_addVote(t *testing.T) {
+	t.Parallel()
+
+	validatorSet, keypairs := newTestValidators(t, 4)
+	outsider, err := secp256k1.GenerateKeypair()
+	require.NoError(t, err)
+
+	commitment := Commitment{
+		Payload:        Payload{{ID: MmrRootID, Data: []byte{1}}},
+		BlockNumber:    8,
+		ValidatorSetID: 1,
+	}
+	otherCommitment := Commitment{
+		Payload:        Payload{{ID: MmrRootID, Data: []byte{2}}},
+		BlockNumber:    8,
+		ValidatorSetID: 1,
+	}
+
+	r := newRounds(validatorSet)
+
+	_, err = r.addVote(newTestVote(t, outsider, commitment))
+	assert.ErrorIs(t, err, ErrNotValidator)
+
+	wrongSet := commitment
+	wrongSet.ValidatorSetID = 2
+	_, err = r.addVote(newTestVote(t, keypairs[0], wrongSet))
+	assert.ErrorIs(t, err, ErrValidatorSetIDMismatch)
+
+	forged := newTestVote(t, keypairs[0], commitment)
+	forged.ID = authorityID(keypairs[1])
+	_, err = r.addVote(forged)
+	assert.ErrorIs(t, err, ErrInvalidSignature)
+
+	signed, err := r.addVote(newTestVote(t, keypairs[0], commitment))
+	require.NoError(t, err)
+	assert.Nil(t, signed)
+
+	// a validator only votes once on a block.
+	signed, err = r.addVote(newTestVote(t, keypairs[0], otherCommitment))
+	require.NoError(t, err)
+	assert.Nil(t, signed)
+
+	signed, err = r.addVote(newTestVote(t, keypairs[1], otherCommitment))
+	require.NoError(t, err)
+	assert.Nil(t, signed)
+
+	signed, err = r.addVote(newTestVote(t, keypairs[2], commitment))
+	require.NoError(t, err)
+	assert.Nil(t, signed)
+
+	signed, err = r.addVote(newTestVote(t, keypairs[3], commitment))
+	require.NoError(t, err)
+	require.NotNil(t, signed)
+	assert.Equal(t, commitment, signed.Commitment)
+	require.Len(t, signed.Signatures, 4)
+	assert.NotNil(t, signed.Signatures[0])
+	assert.Nil(t, signed.Signatures[1])
+	assert.NotNil(t, signed.Signatures[2])
+	assert.NotNil(t, signed.Signatures[3])
+
+	err = r.verifyFinalityProof(*signed)
+	assert.NoError(t, err)
+
+	r.prune(8)
+	assert.Empty(t, r.commitments)
+	assert.Empty(t, r.voters)
+}
+
+func Test_rounds_verifyFinalityProof(t *testing.T) {
+	t.Parallel()
+
+	validatorSet, keypairs := newTestValidators(t, 4)
+	commitment := Commitment{BlockNumber: 8, ValidatorSetID: 1}
+
+	signatures := make([]*Signature, len(keypairs))
+	for i, kp := range keypairs {
+		signature, err := sign(kp, commitment)
+		require.NoError(t, err)
+		signatures[i] = &signature
+	}
+
+	testCases := map[string]struct {
+		signedCommitment SignedCommitment
+		errWrapped       error
+	}{
+		"valid_proof": {
+			signedCommitment: SignedCommitment{
+				Commitment: commitment,
+				Signatures: []*Signature{signatures[0], nil, signatures[2], signatures[3]},
+			},
+		},
+		"not_enough_signatures": {
+			signedCommitment: SignedCommitment{
+				Commitment: commitment,
+				Signatures: []*Signature{signatures[0], nil, nil, signatures[3]},
+			},
+			errWrapped: ErrNotEnoughSignatures,
+		},
+		"signatures_out_of_order": {
+			signedCommitment: SignedCommitment{
+				Commitment: commitment,
+				Signatures: []*Signature{signatures[1], signatures[0], signatures[2], signatures[3]},
+			},
+			errWrapped: ErrInvalidSignature,
+		},
+		"wrong_validator_set_length": {
+			signedCommitment: SignedCommitment{
+				Commitment: commitment,
+				Signatures: signatures[:3],
+			},
+			errWrapped: errInvalidSignedCommitment,
+		},
+		"wrong_validator_set": {
+			signedCommitment: SignedCommitment{
+				Commitment: Commitment{BlockNumber: 8, ValidatorSetID: 2},
+				Signatures: signatures,
+			},
+			errWrapped: ErrValidatorSetIDMismatch,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := newRounds(validatorSet).verifyFinalityProof(testCase.signedCommitment)
+			assert.ErrorIs(t, err, testCase.errWrapped)
+		})
+	}
+}
@@ -0,0 +1,54 @@
+// Copyright 2024 ChainSafe Systems (ON)
+// SPDX-License-Identifier: LGPL-3.0-only
+
+package beefy
+
+import (
+	"sync"
+
+	"github.com/libp2p/go-libp2p/core/protocol"
+
+	"github.com/ChainSafe/gossamer/dot/network"
+	"github.com/ChainSafe/gossamer/dot/types"
+	"github.com/ChainSafe/gossamer/lib/common"
+	"github.com/ChainSafe/gossamer/lib/runtime"
+	rtstorage "github.com/ChainSafe/gossamer/lib/runtime/storage"
+)
+
+// BlockState is the interface required by BEEFY into the block state
+type BlockState interface {
+	GenesisHash() common.Hash
+	GetHeader(hash common.Hash) (*types.Header, error)
+	GetHeaderByNumber(num uint) (*types.Header, error)
+	GetHighestFinalisedHeader() (*types.Header, error)
+	GetFinalisedNotifierChannel() chan *types.FinalisationInfo
+	FreeFinalisedNotifierChannel(ch chan *types.FinalisationInfo)
+	GetRuntime(blockHash common.Hash) (instance runtime.Instance, err error)
+	SetBeefyJustification(hash common.Hash, data []byte) error
+	GetBeefyJustification(hash common.Hash) ([]byte, error)
+	SetBestBeefyBlockHash(hash common.Hash) error
+	GetBestBeefyBlockHash() (common.Hash, error)
+}
+
+// StorageState is the interface required by BEEFY into the storage state,
+// to call the runtime at the state of the finalized blocks
+type StorageState interface {
+	TrieState(root *common.Hash) (*rtstorage.TrieState, error)
+	sync.Locker
+}
+
+// Network is the interface required by BEEFY for the network
+type Network interface {
+	GossipMessage(msg network.NotificationsMessage)
+	RegisterNotificationsProtocol(sub protocol.ID,
+		messageID network.MessageType,
+		handshakeGetter network.HandshakeGetter,
+		handshakeDecoder network.HandshakeDecoder,
+		handshakeValidator network.HandshakeValidator,
+		messageDecoder network.MessageDecoder,
+		messageHandler network.NotificationsMessageHandler,
+		batchHandler network.NotificationsMessageBatchHandler,
+		maxSize uint64,
+		fallbackProtocolIDs ...protocol.ID,
+	) error
+}
@@ -0,0 +1,314 @@
+// Copyright 2024 ChainSafe Systems (ON)
+// SPDX-License-Identifier: LGPL-3.0-only
+
+package beefy
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+
+	"github.com/ChainSafe/gossamer/dot/types"
+	"github.com/ChainSafe/gossamer/lib/common"
+	"github.com/ChainSafe/gossamer/lib/crypto/secp256k1"
+	"github.com/ChainSafe/gossamer/pkg/scale"
+)
+
+// SignatureLength is the length of a BEEFY signature, a recoverable ECDSA signature.
+const SignatureLength = secp256k1.SignatureLengthRecovery
+
+// Signature is the ECDSA signature of a commitment by a BEEFY authority.
+type Signature [SignatureLength]byte
+
+// PayloadID is the id of a payload item, identifying the kind of data signed by the authorities.
+type PayloadID [2]byte
+
+// MmrRootID is the id of the payload item holding the MMR root hash.
+var MmrRootID = PayloadID{'m', 'h'}
+
+// PayloadItem is a SCALE encoded piece of data of a commitment payload.
+type PayloadItem struct {
+	ID   PayloadID
+	Data []byte
+}
+
+// Payload is the data signed by the BEEFY authorities, its items sorted by id.
+type Payload []PayloadItem
+
+// Commitment is the commitment of the BEEFY authorities to the payload of a finalized block.
+type Commitment struct {
+	Payload        Payload
+	BlockNumber    uint32
+	ValidatorSetID uint64
+}
+
+// hash returns the keccak-256 hash of the SCALE encoded commitment, which is the signed message.
+func (c Commitment) hash() (common.Hash, error) {
+	encoded, err := scale.Marshal(c)
+	if err != nil {
+		return common.Hash{}, fmt.Errorf("encoding commitment: %w", err)
+	}
+	return common.Keccak256(encoded)
+}
+
+// VoteMessage is the vote of a BEEFY authority on a commitment.
+type VoteMessage struct {
+	Commitment Commitment
+	ID         types.BeefyAuthorityID
+	Signature  Signature
+}
+
+// SignedCommitment is a commitment with the signatures of the validator set, ordered as the
+// validators, a nil signature standing for a validator which did not sign the commitment.
+type SignedCommitment struct {
+	Commitment Commitment
+	Signatures []*Signature
+}
+
+// compactSignedCommitment is the SCALE representation of a signed commitment, where the
+// signatures present are flagged in a bitfield instead of being encoded as options.
+type compactSignedCommitment struct {
+	Commitment        Commitment
+	SignaturesFrom    []byte
+	ValidatorSetLen   uint32
+	SignaturesCompact []Signature
+}
+
+// MarshalSCALE encodes the signed commitment in its compact form.
+func (sc SignedCommitment) MarshalSCALE() ([]byte, error) {
+	compact := compactSignedCommitment{
+		Commitment:      sc.Commitment,
+		SignaturesFrom:  make([]byte, len(sc.Signatures)/8+1),
+		ValidatorSetLen: uint32(len(sc.Signatures)),
+	}
+
+	// the bitfield has the most significant bit of each byte first.
+	for i, signature := range sc.Signatures {
+		if signature == nil {
+			continue
+		}
+		compact.SignaturesFrom[i/8] |= 1 << (7 - i%8)
+		compact.SignaturesCompact = append(compact.SignaturesCompact, *signature)
+	}
+
+	return scale.Marshal(compact)
+}
+
+// UnmarshalSCALE decodes the signed commitment from its compact form.
+func (sc *SignedCommitment) UnmarshalSCALE(reader io.Reader) error {
+	var compact compactSignedCommitment
+	err := scale.NewDecoder(reader).Decode(&compact)
+	if err != nil {
+		return err
+	}
+
+	if uint64(len(compact.SignaturesFrom))*8 < uint64(compact.ValidatorSetLen) {
+		return fmt.Errorf("%w: %d bitfield bytes for %d validators",
+			errInvalidSignedCommitment, len(compact.SignaturesFrom), compact.ValidatorSetLen)
+	}
+
+	signatures := make([]*Signature, compact.ValidatorSetLen)
+	remaining := compact.SignaturesCompact
+	for i := range signatures {
+		if compact.SignaturesFrom[i/8]&(1<<(7-i%8)) == 0 {
+			continue
+		}
+		if len(remaining) == 0 {
+			return fmt.Errorf("%w: missing signatures", errInvalidSignedCommitment)
+		}
+		signature := remaining[0]
+		signatures[i] = &signature
+		remaining = remaining[1:]
+	}
+	if len(remaining) != 0 {
+		return fmt.Errorf("%w: %d extra signatures", errInvalidSignedCommitment, len(remaining))
+	}
+
+	sc.Commitment = compact.Commitment
+	sc.Signatures = signatures
+	return nil
+}
+
+// VersionedFinalityProof is the BEEFY justification of a block.
+type VersionedFinalityProof struct {
+	inner any
+}
+
+// NewVersionedFinalityProof returns a version 1 finality proof of the signed commitment.
+func NewVersionedFinalityProof(signedCommitment SignedCommitment) VersionedFinalityProof {
+	return VersionedFinalityProof{inner: signedCommitment}
+}
+
+// SetValue sets the value of the finality proof
+func (vfp *VersionedFinalityProof) SetValue(value any) (err error) {
+	switch value := value.(type) {
+	case SignedCommitment:
+		vfp.inner = value
+		return
+	default:
+		return fmt.Errorf("unsupported type")
+	}
+}
+
+// IndexValue returns the index and the value of the finality proof
+func (vfp VersionedFinalityProof) IndexValue() (index uint, value any, err error) {
+	switch vfp.inner.(type) {
+	case SignedCommitment:
+		return 1, vfp.inner, nil
+	}
+	return 0, nil, scale.ErrUnsupportedVaryingDataTypeValue
+}
+
+// Value returns the value of the finality proof
+func (vfp VersionedFinalityProof) Value() (value any, err error) {
+	_, value, err = vfp.IndexValue()
+	return
+}
+
+// ValueAt returns the value of the finality proof at the index given
+func (VersionedFinalityProof) ValueAt(index uint) (value any, err error) {
+	switch index {
+	case 1:
+		return SignedCommitment{}, nil
+	}
+	return nil, scale.ErrUnknownVaryingDataTypeValue
+}
+
+// SignedCommitment returns the signed commitment of the finality proof.
+func (vfp VersionedFinalityProof) SignedCommitment() (SignedCommitment, error) {
+	value, err := vfp.Value()
+	if err != nil {
+		return SignedCommitment{}, err
+	}
+	return value.(SignedCommitment), nil
+}
+
+type gossipMessages interface {
+	VoteMessage | VersionedFinalityProof
+}
+
+// gossipMessage is a message of the BEEFY gossip protocol, a vote or a finality proof.
+type gossipMessage struct {
+	inner any
+}
+
+func setGossipMessage[Value gossipMessages](mvdt *gossipMessage, value Value) {
+	mvdt.inner = value
+}
+
+func (mvdt *gossipMessage) SetValue(value any) (err error) {
+	switch value := value.(type) {
+	case VoteMessage:
+		setGossipMessage(mvdt, value)
+		return
+	case VersionedFinalityProof:
+		setGossipMessage(mvdt, value)
+		return
+	default:
+		return fmt.Errorf("unsupported type")
+	}
+}
+
+func (mvdt gossipMessage) IndexValue() (index uint, value any, err error) {
+	switch mvdt.inner.(type) {
+	case VoteMessage:
+		return 0, mvdt.inner, nil
+	case VersionedFinalityProof:
+		return 1, mvdt.inner, nil
+	}
+	return 0, nil, scale.ErrUnsupportedVaryingDataTypeValue
+}
+
+func (mvdt gossipMessage) Value() (value any, err error) {
+	_, value, err = mvdt.IndexValue()
+	return
+}
+
+func (gossipMessage) ValueAt(index uint) (value any, err error) {
+	switch index {
+	case 0:
+		return VoteMessage{}, nil
+	case 1:
+		return VersionedFinalityProof{}, nil
+	}
+	return nil, scale.ErrUnknownVaryingDataTypeValue
+}
+
+// consensusLog is a BEEFY consensus digest of a block header. Only the authorities change
+// and MMR root logs are used, the authority disabling log is decoded to be skipped.
+type consensusLog struct {
+	inner any
+}
+
+type authoritiesChange types.BeefyValidatorSet
+
+type onDisabled uint32
+
+type mmrRoot common.Hash
+
+func (mvdt *consensusLog) SetValue(value any) (err error) {
+	switch value := value.(type) {
+	case authoritiesChange, onDisabled, mmrRoot:
+		mvdt.inner = value
+		return
+	default:
+		return fmt.Errorf("unsupported type")
+	}
+}
+
+func (mvdt consensusLog) IndexValue() (index uint, value any, err error) {
+	switch mvdt.inner.(type) {
+	case authoritiesChange:
+		return 1, mvdt.inner, nil
+	case onDisabled:
+		return 2, mvdt.inner, nil
+	case mmrRoot:
+		return 3, mvdt.inner, nil
+	}
+	return 0, nil, scale.ErrUnsupportedVaryingDataTypeValue
+}
+
+func (mvdt consensusLog) Value() (value any, err error) {
+	_, value, err = mvdt.IndexValue()
+	return
+}
+
+func (consensusLog) ValueAt(index uint) (value any, err error) {
+	switch index {
+	case 1:
+		return authoritiesChange{}, nil
+	case 2:
+		return onDisabled(0), nil
+	case 3:
+		return mmrRoot{}, nil
+	}
+	return nil, scale.ErrUnknownVaryingDataTypeValue
+}
+
+// consensusLogs returns the values of the BEEFY consensus logs of the header.
+func consensusLogs(header *types.Header) (values []any, err error) {
+	for _, item := range header.Digest {
+		itemValue, err := item.Value()
+		if err != nil {
+			return nil, fmt.Errorf("getting digest item value: %w", err)
+		}
+
+		digest, ok := itemValue.(types.ConsensusDigest)
+		if !ok || !bytes.Equal(digest.ConsensusEngineID[:], types.BeefyEngineID[:]) {
+			continue
+		}
+
+		var log consensusLog
+		err = scale.Unmarshal(digest.Data, &log)
+		if err != nil {
+			return nil, fmt.Errorf("decoding beefy consensus log: %w", err)
+		}
+
+		value, err := log.Value()
+		if err != nil {
+			return nil, fmt.Errorf("getting beefy consensus log value: %w", err)
+		}
+		values = append(values, value)
+	}
+	return values, nil
+}
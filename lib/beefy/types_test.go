@@ -0,0 +1,99 @@
+// Copyright 2024 ChainSafe Systems (ON)
+// SPDX-License-Identifier: LGPL-3.0-only
+
+package beefy
+
+import (
+	"testing"
+
+	"github.com/ChainSafe/gossamer/dot/types"
+	"github.com/ChainSafe/gossamer/pkg/scale"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSignedCommitment_EncodeDecode(t *testing.T) {
+	t.Parallel()
+
+	first := Signature{1}
+	tenth := Signature{10}
+	signedCommitment := SignedCommitment{
+		Commitment: Commitment{
+			Payload:        Payload{{ID: MmrRootID, Data: []byte{1, 2, 3}}},
+			BlockNumber:    5,
+			ValidatorSetID: 2,
+		},
+		Signatures: []*Signature{&first, nil, nil, nil, nil, nil, nil, nil, nil, &tenth},
+	}
+
+	encoded, err := scale.Marshal(signedCommitment)
+	require.NoError(t, err)
+
+	commitment, err := scale.Marshal(signedCommitment.Commitment)
+	require.NoError(t, err)
+	// the bitfield flags the first and the tenth validators, its length prefixed.
+	expectedPrefix := append(commitment, 0x08, 0b1000_0000, 0b0100_0000, 10, 0, 0, 0, 0x08) //nolint:gocritic
+	assert.Equal(t, expectedPrefix, encoded[:len(expectedPrefix)])
+	assert.Len(t, encoded, len(expectedPrefix)+2*SignatureLength)
+
+	var decoded SignedCommitment
+	err = scale.Unmarshal(encoded, &decoded)
+	require.NoError(t, err)
+	assert.Equal(t, signedCommitment, decoded)
+}
+
+func TestSignedCommitment_UnmarshalSCALE_missingSignatures(t *testing.T) {
+	t.Parallel()
+
+	encoded, err := scale.Marshal(compactSignedCommitment{
+		SignaturesFrom:  []byte{0b1100_0000},
+		ValidatorSetLen: 2,
+		SignaturesCompact: []Signature{
+			{1},
+		},
+	})
+	require.NoError(t, err)
+
+	var decoded SignedCommitment
+	err = scale.Unmarshal(encoded, &decoded)
+	assert.ErrorIs(t, err, errInvalidSignedCommitment)
+}
+
+func TestGossipMessage_EncodeDecode(t *testing.T) {
+	t.Parallel()
+
+	signature := Signature{3}
+	testCases := map[string]any{
+		"vote": VoteMessage{
+			Commitment: Commitment{BlockNumber: 1, ValidatorSetID: 1},
+			ID:         types.BeefyAuthorityID{2},
+			Signature:  Signature{1},
+		},
+		"finality_proof": NewVersionedFinalityProof(SignedCommitment{
+			Commitment: Commitment{BlockNumber: 1, ValidatorSetID: 1},
+			Signatures: []*Signature{nil, &signature},
+		}),
+	}
+
+	for name, value := range testCases {
+		value := value
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var message gossipMessage
+			err := message.SetValue(value)
+			require.NoError(t, err)
+
+			encoded, err := scale.Marshal(message)
+			require.NoError(t, err)
+
+			var decoded gossipMessage
+			err = scale.Unmarshal(encoded, &decoded)
+			require.NoError(t, err)
+
+			decodedValue, err := decoded.Value()
+			require.NoError(t, err)
+			assert.Equal(t, value, decodedValue)
+		})
+	}
+}
@@ -4,6 +4,8 @@
 package common
 
 import (
+	"time"
+
 	ma "github.com/multiformats/go-multiaddr"
 )
 
@@ -18,6 +20,18 @@ type Health struct {
 type NetworkState struct {
 	PeerID     string
 	Multiaddrs []ma.Multiaddr
+	Bandwidth  []ProtocolBandwidth
+}
+
+// ProtocolBandwidth is the number of bytes and messages exchanged using a network
+// protocol with the peers of a given role
+type ProtocolBandwidth struct {
+	Protocol    string `json:"protocol"`
+	Role        string `json:"role"`
+	BytesIn     uint64 `json:"bytesIn"`
+	BytesOut    uint64 `json:"bytesOut"`
+	MessagesIn  uint64 `json:"messagesIn"`
+	MessagesOut uint64 `json:"messagesOut"`
 }
 
 // PeerInfo is network information about peers needed for the rpc server
@@ -28,6 +42,12 @@ type PeerInfo struct {
 	BestNumber uint64
 }
 
+// BannedPeerInfo is network information about banned peers needed for the rpc server
+type BannedPeerInfo struct {
+	PeerID string
+	Until  time.Time
+}
+
 // NetworkRole is the type of node.
 type NetworkRole byte
 
@@ -18,6 +18,7 @@ type Genesis struct {
 	Bootnodes          []string               `json:"bootNodes"`
 	TelemetryEndpoints []interface{}          `json:"telemetryEndpoints"`
 	ProtocolID         string                 `json:"protocolId"`
+	ForkID             string                 `json:"forkId,omitempty"`
 	Genesis            Fields                 `json:"genesis"`
 	Properties         map[string]interface{} `json:"properties"`
 	ForkBlocks         []string               `json:"forkBlocks"`
@@ -34,6 +35,7 @@ type Data struct {
 	Bootnodes          [][]byte
 	TelemetryEndpoints []*TelemetryEndpoint
 	ProtocolID         string
+	ForkID             string
 	Properties         map[string]interface{}
 	ForkBlocks         []string
 	BadBlocks          []string
@@ -41,6 +43,10 @@ type Data struct {
 	CodeSubstitutes    map[string]string
 }
 
+// AuraConsensusEngine is the consensus engine of the chain specs of the chains producing
+// blocks with Aura. The chains producing blocks with BABE leave the consensus engine empty.
+const AuraConsensusEngine = "aura"
+
 // TelemetryEndpoint struct to hold telemetry endpoint information
 type TelemetryEndpoint struct {
 	Endpoint  string `mapstructure:",squash"`
@@ -211,6 +217,7 @@ func (g *Genesis) GenesisData() *Data {
 		Bootnodes:          common.StringArrayToBytes(g.Bootnodes),
 		TelemetryEndpoints: interfaceToTelemetryEndpoint(g.TelemetryEndpoints),
 		ProtocolID:         g.ProtocolID,
+		ForkID:             g.ForkID,
 		Properties:         g.Properties,
 		ForkBlocks:         g.ForkBlocks,
 		BadBlocks:          g.BadBlocks,
@@ -219,6 +226,11 @@ func (g *Genesis) GenesisData() *Data {
 	}
 }
 
+// IsAura returns whether the chain produces blocks with Aura.
+func (g *Genesis) IsAura() bool {
+	return g.ConsensusEngine == AuraConsensusEngine
+}
+
 // GenesisFields returns the genesis fields including genesis raw data
 func (g *Genesis) GenesisFields() Fields {
 	return g.Genesis
@@ -0,0 +1,848 @@
+// Copyright 2024 ChainSafe Systems (ON)
+// SPDX-License-Identifier: LGPL-3.0-only
+
+package grandpa
+
+import (
+	"errors"
+	"fmt"
+	"math/rand"
+	"sync"
+	"time"
+
+	"github.com/ChainSafe/gossamer/dot/state"
+	"github.com/ChainSafe/gossamer/dot/types"
+	"github.com/ChainSafe/gossamer/internal/primitives/core/hash"
+	"github.com/ChainSafe/gossamer/lib/common"
+	"github.com/ChainSafe/gossamer/lib/crypto/ed25519"
+	"github.com/ChainSafe/gossamer/pkg/scale"
+	"github.com/libp2p/go-libp2p/core/peer"
+
+	finality_grandpa "github.com/ChainSafe/gossamer/pkg/finality-grandpa"
+)
+
+// the finality-grandpa voter is instantiated with the H256 hash type, 32 bits block numbers,
+// ed25519 signatures and the encoded authority keys as voter ids.
+type (
+	finalityMessage            = finality_grandpa.Message[hash.H256, uint32]
+	finalitySignedMessage      = finality_grandpa.SignedMessage[hash.H256, uint32, [64]byte, string]
+	finalitySignedMessageError = finality_grandpa.SignedMessageError[hash.H256, uint32, [64]byte, string]
+	finalityCommit             = finality_grandpa.Commit[hash.H256, uint32, [64]byte, string]
+	finalityHistoricalVotes    = finality_grandpa.HistoricalVotes[hash.H256, uint32, [64]byte, string]
+	finalityRoundData          = finality_grandpa.RoundData[hash.H256, uint32, [64]byte, string]
+	finalityVoter              = finality_grandpa.Voter[hash.H256, uint32, [64]byte, string]
+)
+
+// maxPendingRoundsAhead is the number of rounds ahead of the highest round started for which
+// the votes received are kept until the round starts.
+const maxPendingRoundsAhead = 1
+
+// environment backs the finality-grandpa voter of an authority set with the block and
+// grandpa states, the GRANDPA network protocol and the authority keypair of the service.
+type environment struct {
+	service *Service
+	setID   uint64
+	// voterID is the id of our authority if it is in the authority set.
+	voterID *string
+	// authorityKeys is the set of the encoded keys of the authority set.
+	authorityKeys map[string]struct{}
+	globalIn      chan finality_grandpa.GlobalInItem
+
+	sync.Mutex
+	rounds       map[uint64]*roundInput
+	pending      map[uint64][]finalitySignedMessage
+	highestRound uint64
+	done         chan struct{}
+}
+
+// roundInput is the input stream of the votes of a round.
+type roundInput struct {
+	incoming finality_grandpa.Input[hash.H256, uint32, [64]byte, string]
+	done     chan struct{}
+	// deliveries is the number of votes being delivered to the round.
+	deliveries sync.WaitGroup
+}
+
+func newEnvironment(service *Service, setID uint64, authorities []types.GrandpaVoter) *environment {
+	env := &environment{
+		service:       service,
+		setID:         setID,
+		authorityKeys: make(map[string]struct{}, len(authorities)),
+		globalIn:      make(chan finality_grandpa.GlobalInItem),
+		rounds:        make(map[uint64]*roundInput),
+		pending:       make(map[uint64][]finalitySignedMessage),
+		done:          make(chan struct{}),
+	}
+
+	for _, authority := range authorities {
+		env.authorityKeys[string(authority.Key.Encode())] = struct{}{}
+	}
+
+	if service.authority {
+		id := string(service.keypair.Public().Encode())
+		if _, ok := env.authorityKeys[id]; ok {
+			env.voterID = &id
+		}
+	}
+
+	return env
+}
+
+// stop stops routing messages to and from the voter, once the voter is stopped.
+func (e *environment) stop() {
+	e.Lock()
+	defer e.Unlock()
+
+	close(e.done)
+	for number, round := range e.rounds {
+		close(round.done)
+		delete(e.rounds, number)
+	}
+}
+
+// Ancestry returns the ancestry of the block up to but not including the base block,
+// from the parent of the block.
+func (e *environment) Ancestry(base, block hash.H256) ([]hash.H256, error) {
+	baseHeader, err := e.service.blockState.GetHeader(toCommonHash(base))
+	if err != nil {
+		return nil, fmt.Errorf("getting base header: %w", err)
+	}
+
+	header, err := e.service.blockState.GetHeader(toCommonHash(block))
+	if err != nil {
+		return nil, fmt.Errorf("getting block header: %w", err)
+	}
+
+	var ancestry []hash.H256
+	for header.Number > baseHeader.Number+1 {
+		ancestry = append(ancestry, toH256(header.ParentHash))
+		header, err = e.service.blockState.GetHeader(header.ParentHash)
+		if err != nil {
+			return nil, fmt.Errorf("getting ancestor header: %w", err)
+		}
+	}
+
+	if header.Number != baseHeader.Number+1 || header.ParentHash != baseHeader.Hash() {
+		return nil, fmt.Errorf("%w: block %s, base %s", errNotDescendant, block, base)
+	}
+	return ancestry, nil
+}
+
+// IsEqualOrDescendantOf returns true if the block is the base block or one of its descendants.
+func (e *environment) IsEqualOrDescendantOf(base, block hash.H256) bool {
+	if base == block {
+		return true
+	}
+
+	isDescendant, err := e.service.blockState.IsDescendantOf(toCommonHash(base), toCommonHash(block))
+	if err != nil {
+		logger.Debugf("checking if block %s is a descendant of %s: %s", block, base, err)
+		return false
+	}
+	return isDescendant
+}
+
+// BestChainContaining returns the best block containing the base block, limited to the
+// block of the next authority set change since the votes of a set cannot go past it, and
+// restricted by the voting rules.
+func (e *environment) BestChainContaining(base hash.H256) finality_grandpa.BestChain[hash.H256, uint32] {
+	bestChain := make(finality_grandpa.BestChain[hash.H256, uint32], 1)
+
+	target, err := e.bestChainContaining(toCommonHash(base))
+	if err != nil {
+		bestChain <- finality_grandpa.BestChainOutput[hash.H256, uint32]{Error: err}
+		return bestChain
+	}
+
+	var value *finality_grandpa.HashNumber[hash.H256, uint32]
+	if target != nil {
+		value = &finality_grandpa.HashNumber[hash.H256, uint32]{
+			Hash:   toH256(target.Hash()),
+			Number: uint32(target.Number), //nolint:gosec
+		}
+	}
+	bestChain <- finality_grandpa.BestChainOutput[hash.H256, uint32]{Value: value}
+	return bestChain
+}
+
+func (e *environment) bestChainContaining(base common.Hash) (*types.Header, error) {
+	has, err := e.service.blockState.HasHeader(base)
+	if err != nil {
+		return nil, fmt.Errorf("checking for base header: %w", err)
+	}
+	if !has {
+		return nil, nil
+	}
+
+	target, err := e.service.blockState.BestBlockHeader()
+	if err != nil {
+		return nil, fmt.Errorf("getting best block header: %w", err)
+	}
+
+	isDescendant, err := e.service.blockState.IsDescendantOf(base, target.Hash())
+	if err != nil {
+		return nil, fmt.Errorf("checking if best block descends from base: %w", err)
+	}
+	if !isDescendant {
+		return e.service.blockState.GetHeader(base)
+	}
+
+	best := target
+	nextChange, err := e.service.grandpaState.NextGrandpaAuthorityChange(target.Hash(), target.Number)
+	if err != nil && !errors.Is(err, state.ErrNoNextAuthorityChange) {
+		return nil, fmt.Errorf("getting next grandpa authority change: %w", err)
+	}
+
+	if err == nil {
+		for target.Number > nextChange && target.Hash() != base {
+			target, err = e.service.blockState.GetHeader(target.ParentHash)
+			if err != nil {
+				return nil, fmt.Errorf("getting ancestor header: %w", err)
+			}
+		}
+	}
+
+	if len(e.service.votingRules) == 0 {
+		return target, nil
+	}
+
+	baseHeader, err := e.service.blockState.GetHeader(base)
+	if err != nil {
+		return nil, fmt.Errorf("getting base header: %w", err)
+	}
+	return e.service.restrictVoteTarget(baseHeader, best, target)
+}
+
+// RoundData starts routing the votes of the round: the votes received from the network
+// and the votes of our authority, signed and gossiped, are fed to the voter.
+func (e *environment) RoundData(round uint64, outgoing finality_grandpa.Output[hash.H256, uint32]) finalityRoundData {
+	input := &roundInput{
+		incoming: make(finality_grandpa.Input[hash.H256, uint32, [64]byte, string]),
+		done:     make(chan struct{}),
+	}
+
+	e.Lock()
+	if previous, ok := e.rounds[round]; ok {
+		close(previous.done)
+	}
+	e.rounds[round] = input
+
+	if round > e.highestRound {
+		e.highestRound = round
+		e.service.setRound(round)
+	}
+
+	for number, votes := range e.pending {
+		if number > round {
+			continue
+		}
+		if number == round {
+			for _, vote := range votes {
+				e.deliver(input, vote)
+			}
+		}
+		delete(e.pending, number)
+	}
+	e.Unlock()
+
+	go e.handleOutgoing(round, outgoing, input)
+
+	interval := e.service.interval
+	return finalityRoundData{
+		VoterID:        e.voterID,
+		PrevoteTimer:   finality_grandpa.NewTimer(2 * interval),
+		PrecommitTimer: finality_grandpa.NewTimer(4 * interval),
+		Incoming:       input.incoming,
+	}
+}
+
+// handleOutgoing signs and gossips the votes of our authority in the round, and feeds them
+// back to the voter. The votes are dropped if we are not an authority of the set.
+func (e *environment) handleOutgoing(round uint64, outgoing finality_grandpa.Output[hash.H256, uint32],
+	input *roundInput) {
+	for {
+		select {
+		case message := <-outgoing:
+			if e.voterID == nil {
+				continue
+			}
+
+			signed, voteMessage, err := e.signOwnMessage(round, message)
+			if err != nil {
+				logger.Warnf("signing vote of round %d: %s", round, err)
+				continue
+			}
+
+			consensusMessage, err := voteMessage.ToConsensusMessage()
+			if err != nil {
+				logger.Warnf("encoding vote message of round %d: %s", round, err)
+				continue
+			}
+			e.service.network.GossipMessage(consensusMessage)
+
+			e.Lock()
+			select {
+			case <-input.done:
+			default:
+				e.deliver(input, signed)
+			}
+			e.Unlock()
+		case <-input.done:
+			return
+		}
+	}
+}
+
+// deliver sends the vote to the round without blocking, the votes being consumed by the
+// voter as it polls the round. It must be called with the environment locked.
+func (e *environment) deliver(input *roundInput, vote finalitySignedMessage) {
+	input.deliveries.Add(1)
+	go func() {
+		defer input.deliveries.Done()
+		select {
+		case input.incoming <- finalitySignedMessageError{SignedMessage: vote}:
+		case <-input.done:
+		}
+	}()
+}
+
+// signMessage signs the message of our authority in the round.
+func (e *environment) signMessage(round uint64, message finalityMessage) (
+	finalitySignedMessage, *VoteMessage, error) {
+	stage, vote, err := fromFinalityMessage(message)
+	if err != nil {
+		return finalitySignedMessage{}, nil, err
+	}
+
+	encoded, err := scale.Marshal(FullVote{
+		Stage: stage,
+		Vote:  vote,
+		Round: round,
+		SetID: e.setID,
+	})
+	if err != nil {
+		return finalitySignedMessage{}, nil, fmt.Errorf("encoding full vote: %w", err)
+	}
+
+	signature, err := e.service.keypair.Sign(encoded)
+	if err != nil {
+		return finalitySignedMessage{}, nil, fmt.Errorf("signing full vote: %w", err)
+	}
+
+	voteMessage := &VoteMessage{
+		Round: round,
+		SetID: e.setID,
+		Message: SignedMessage{
+			Stage:       stage,
+			BlockHash:   vote.Hash,
+			Number:      vote.Number,
+			Signature:   ed25519.NewSignatureBytes(signature),
+			AuthorityID: e.service.publicKeyBytes(),
+		},
+	}
+
+	signed := finalitySignedMessage{
+		Message:   message,
+		Signature: voteMessage.Message.Signature,
+		ID:        *e.voterID,
+	}
+	return signed, voteMessage, nil
+}
+
+// signOwnMessage signs the message of our authority in the round and persists the vote before
+// it is sent. If we already voted in the subround before a restart, the vote persisted is
+// returned instead, so that we do not equivocate.
+func (e *environment) signOwnMessage(round uint64, message finalityMessage) (
+	finalitySignedMessage, *VoteMessage, error) {
+	stage, _, err := fromFinalityMessage(message)
+	if err != nil {
+		return finalitySignedMessage{}, nil, err
+	}
+
+	signedVote, err := e.service.loadOwnVote(round, e.setID, stage)
+	if err != nil {
+		return finalitySignedMessage{}, nil, err
+	}
+
+	if signedVote != nil {
+		logger.Debugf("resuming %s vote for block %s in round %d, voted before restarting",
+			stage, signedVote.Vote.Hash, round)
+		message, err = toFinalityMessage(stage, signedVote.Vote)
+		if err != nil {
+			return finalitySignedMessage{}, nil, err
+		}
+		signed := finalitySignedMessage{
+			Message:   message,
+			Signature: signedVote.Signature,
+			ID:        *e.voterID,
+		}
+		return signed, newOwnVoteMessage(round, e.setID, stage, signedVote), nil
+	}
+
+	signed, voteMessage, err := e.signMessage(round, message)
+	if err != nil {
+		return finalitySignedMessage{}, nil, err
+	}
+
+	err = e.service.storeOwnVote(round, e.setID, stage, &SignedVote{
+		Vote:        *NewVote(voteMessage.Message.BlockHash, voteMessage.Message.Number),
+		Signature:   voteMessage.Message.Signature,
+		AuthorityID: voteMessage.Message.AuthorityID,
+	})
+	if err != nil {
+		return finalitySignedMessage{}, nil, err
+	}
+	return signed, voteMessage, nil
+}
+
+// handleVoteMessage validates the vote message received from the network and routes it to
+// its round. The votes for blocks we have not imported yet are tracked until the blocks are.
+func (e *environment) handleVoteMessage(from peer.ID, m *VoteMessage) error {
+	if m.SetID != e.setID {
+		return fmt.Errorf("%w: vote for set id %d, current set id is %d", ErrSetIDMismatch, m.SetID, e.setID)
+	}
+
+	publicKey, err := ed25519.NewPublicKey(m.Message.AuthorityID[:])
+	if err != nil {
+		return fmt.Errorf("creating public key: %w", err)
+	}
+
+	id := string(publicKey.Encode())
+	if _, ok := e.authorityKeys[id]; !ok {
+		return fmt.Errorf("%w: %s", ErrVoterNotFound, publicKey.Hex())
+	}
+
+	err = validateMessageSignature(publicKey, m)
+	if err != nil {
+		return fmt.Errorf("validating message signature: %w", err)
+	}
+
+	has, err := e.service.blockState.HasHeader(m.Message.BlockHash)
+	if err != nil {
+		return fmt.Errorf("checking for vote block: %w", err)
+	}
+	if !has {
+		e.service.tracker.addVote(from, m)
+		return fmt.Errorf("%w: %s", ErrBlockDoesNotExist, m.Message.BlockHash)
+	}
+
+	message, err := toFinalityMessage(m.Message.Stage, *NewVote(m.Message.BlockHash, m.Message.Number))
+	if err != nil {
+		return err
+	}
+
+	vote := finalitySignedMessage{
+		Message:   message,
+		Signature: m.Message.Signature,
+		ID:        id,
+	}
+
+	e.Lock()
+	defer e.Unlock()
+
+	input, ok := e.rounds[m.Round]
+	switch {
+	case ok:
+		e.deliver(input, vote)
+	case m.Round > e.highestRound && m.Round <= e.highestRound+maxPendingRoundsAhead &&
+		len(e.pending[m.Round]) < 3*len(e.authorityKeys):
+		e.pending[m.Round] = append(e.pending[m.Round], vote)
+	default:
+		return fmt.Errorf("%w: round %d is not running", errRoundOutOfBounds, m.Round)
+	}
+	return nil
+}
+
+// handleCommitMessage validates the signatures of the commit message received from the network
+// and sends it to the voter, which validates the commit and finalises its target.
+func (e *environment) handleCommitMessage(commitMessage *CommitMessage) error {
+	if commitMessage.SetID != e.setID {
+		return fmt.Errorf("%w: commit for set id %d, current set id is %d",
+			ErrSetIDMismatch, commitMessage.SetID, e.setID)
+	}
+
+	if len(commitMessage.Precommits) != len(commitMessage.AuthData) {
+		return fmt.Errorf("%w: precommits len: %d, authorities len: %d",
+			ErrPrecommitSignatureMismatch, len(commitMessage.Precommits), len(commitMessage.AuthData))
+	}
+
+	blocks := append([]Vote{commitMessage.Vote}, commitMessage.Precommits...)
+	for _, block := range blocks {
+		has, err := e.service.blockState.HasHeader(block.Hash)
+		if err != nil {
+			return fmt.Errorf("checking for commit block: %w", err)
+		}
+		if !has {
+			e.service.tracker.addCommit(commitMessage)
+			return fmt.Errorf("%w: %s", ErrBlockDoesNotExist, block.Hash)
+		}
+	}
+
+	compactCommit := finality_grandpa.CompactCommit[hash.H256, uint32, [64]byte, string]{
+		TargetHash:   toH256(commitMessage.Vote.Hash),
+		TargetNumber: commitMessage.Vote.Number,
+		Precommits:   make([]finality_grandpa.Precommit[hash.H256, uint32], len(commitMessage.Precommits)),
+		AuthData:     make(finality_grandpa.MultiAuthData[[64]byte, string], len(commitMessage.AuthData)),
+	}
+	for i, precommitVote := range commitMessage.Precommits {
+		signedVote := &SignedVote{
+			Vote:        precommitVote,
+			Signature:   commitMessage.AuthData[i].Signature,
+			AuthorityID: commitMessage.AuthData[i].AuthorityID,
+		}
+		err := verifyJustification(signedVote, commitMessage.Round, e.setID, precommit, e.authorityKeys)
+		if err != nil {
+			return fmt.Errorf("verifying precommit: %w", err)
+		}
+
+		compactCommit.Precommits[i] = finality_grandpa.Precommit[hash.H256, uint32]{
+			TargetHash:   toH256(precommitVote.Hash),
+			TargetNumber: precommitVote.Number,
+		}
+		compactCommit.AuthData[i].Signature = signedVote.Signature
+		compactCommit.AuthData[i].ID = string(signedVote.AuthorityID[:])
+	}
+
+	round := commitMessage.Round
+	item := finality_grandpa.GlobalInItem{
+		CommunicationIn: finality_grandpa.NewCommunicationIn[hash.H256, uint32, [64]byte, string](
+			finality_grandpa.CommunicationInCommit[hash.H256, uint32, [64]byte, string]{
+				Number:        round,
+				CompactCommit: compactCommit,
+				Callback: func(outcome finality_grandpa.CommitProcessingOutcome) {
+					logger.Debugf("processed commit of round %d: %+v", round, outcome)
+				},
+			}),
+	}
+
+	go func() {
+		select {
+		case e.globalIn <- item:
+		case <-e.done:
+		}
+	}()
+	return nil
+}
+
+// handleCatchUpResponse validates the signatures of the votes of the catch up response and sends
+// it to the voter, which skips ahead to the round after the round of the response if the votes
+// complete it.
+func (e *environment) handleCatchUpResponse(response *CatchUpResponse) error {
+	if response.SetID != e.setID {
+		return fmt.Errorf("%w: catch up for set id %d, current set id is %d",
+			ErrSetIDMismatch, response.SetID, e.setID)
+	}
+
+	catchUp := finality_grandpa.CatchUp[hash.H256, uint32, [64]byte, string]{
+		RoundNumber: response.Round,
+		Prevotes: make([]finality_grandpa.SignedPrevote[hash.H256, uint32, [64]byte, string],
+			len(response.PreVoteJustification)),
+		Precommits: make([]finality_grandpa.SignedPrecommit[hash.H256, uint32, [64]byte, string],
+			len(response.PreCommitJustification)),
+		BaseHash:   toH256(response.Hash),
+		BaseNumber: response.Number,
+	}
+	for i := range response.PreVoteJustification {
+		signedVote := &response.PreVoteJustification[i]
+		err := e.verifyCatchUpVote(signedVote, response.Round, prevote)
+		if err != nil {
+			return fmt.Errorf("verifying prevote: %w", err)
+		}
+
+		catchUp.Prevotes[i] = finality_grandpa.SignedPrevote[hash.H256, uint32, [64]byte, string]{
+			Prevote: finality_grandpa.Prevote[hash.H256, uint32]{
+				TargetHash:   toH256(signedVote.Vote.Hash),
+				TargetNumber: signedVote.Vote.Number,
+			},
+			Signature: signedVote.Signature,
+			ID:        string(signedVote.AuthorityID[:]),
+		}
+	}
+	for i := range response.PreCommitJustification {
+		signedVote := &response.PreCommitJustification[i]
+		err := e.verifyCatchUpVote(signedVote, response.Round, precommit)
+		if err != nil {
+			return fmt.Errorf("verifying precommit: %w", err)
+		}
+
+		catchUp.Precommits[i] = finality_grandpa.SignedPrecommit[hash.H256, uint32, [64]byte, string]{
+			Precommit: finality_grandpa.Precommit[hash.H256, uint32]{
+				TargetHash:   toH256(signedVote.Vote.Hash),
+				TargetNumber: signedVote.Vote.Number,
+			},
+			Signature: signedVote.Signature,
+			ID:        string(signedVote.AuthorityID[:]),
+		}
+	}
+
+	round := response.Round
+	item := finality_grandpa.GlobalInItem{
+		CommunicationIn: finality_grandpa.NewCommunicationIn[hash.H256, uint32, [64]byte, string](
+			finality_grandpa.CommunicationInCatchUp[hash.H256, uint32, [64]byte, string]{
+				CatchUp: catchUp,
+				Callback: func(outcome finality_grandpa.CatchUpProcessingOutcome) {
+					logger.Debugf("processed catch up of round %d: %+v", round, outcome)
+				},
+			}),
+	}
+
+	go func() {
+		select {
+		case e.globalIn <- item:
+		case <-e.done:
+		}
+	}()
+	return nil
+}
+
+// verifyCatchUpVote verifies the signature of the vote of a catch up response, and that we
+// have imported the block voted for.
+func (e *environment) verifyCatchUpVote(signedVote *SignedVote, round uint64, stage Subround) error {
+	has, err := e.service.blockState.HasHeader(signedVote.Vote.Hash)
+	if err != nil {
+		return fmt.Errorf("checking for vote block: %w", err)
+	}
+	if !has {
+		return fmt.Errorf("%w: %s", ErrBlockDoesNotExist, signedVote.Vote.Hash)
+	}
+
+	return verifyJustification(signedVote, round, e.setID, stage, e.authorityKeys)
+}
+
+// handleGlobalOut gossips the commits of the voter until the voter is stopped.
+func (e *environment) handleGlobalOut(globalOut chan finality_grandpa.CommunicationOut) {
+	for out := range globalOut {
+		commit, ok := out.Variant().(finality_grandpa.CommunicationOutCommit[hash.H256, uint32, [64]byte, string])
+		if !ok {
+			continue
+		}
+
+		precommits, authData := justificationToCompact(toSignedPrecommits(commit.Commit))
+		commitMessage := &CommitMessage{
+			Round:      commit.Number,
+			SetID:      e.setID,
+			Vote:       *NewVote(toCommonHash(commit.Commit.TargetHash), commit.Commit.TargetNumber),
+			Precommits: precommits,
+			AuthData:   authData,
+		}
+
+		consensusMessage, err := commitMessage.ToConsensusMessage()
+		if err != nil {
+			logger.Warnf("encoding commit message of round %d: %s", commit.Number, err)
+			continue
+		}
+
+		logger.Debugf("sending commit message %s", commitMessage)
+		e.service.network.GossipMessage(consensusMessage)
+	}
+}
+
+// RoundCommitTimer returns a timer of a random delay under a second, to lower the number of
+// commit messages sent by the voters.
+func (*environment) RoundCommitTimer() finality_grandpa.Timer {
+	delay := time.Duration(rand.Int63n(int64(time.Second))) //nolint:gosec
+	return finality_grandpa.NewTimer(delay)
+}
+
+// Proposed notes that we made the primary proposal of the round.
+func (*environment) Proposed(round uint64, propose finality_grandpa.PrimaryPropose[hash.H256, uint32]) error {
+	logger.Debugf("proposed block %s in round %d", propose.TargetHash, round)
+	return nil
+}
+
+// Prevoted notes that we prevoted in the round.
+func (*environment) Prevoted(round uint64, prevote finality_grandpa.Prevote[hash.H256, uint32]) error {
+	logger.Debugf("prevoted block %s in round %d", prevote.TargetHash, round)
+	return nil
+}
+
+// Precommitted notes that we precommitted in the round.
+func (*environment) Precommitted(round uint64, precommit finality_grandpa.Precommit[hash.H256, uint32]) error {
+	logger.Debugf("precommitted block %s in round %d", precommit.TargetHash, round)
+	return nil
+}
+
+// Completed stores the latest round and the votes of the completed round, which are used
+// to answer the catch up requests.
+func (e *environment) Completed(round uint64, _ finality_grandpa.RoundState[hash.H256, uint32],
+	_ finality_grandpa.HashNumber[hash.H256, uint32], votes finalityHistoricalVotes) error {
+	logger.Debugf("completed round %d", round)
+
+	err := e.storeVotes(round, votes)
+	if err != nil {
+		return err
+	}
+
+	err = e.service.grandpaState.SetLatestRound(round)
+	if err != nil {
+		return fmt.Errorf("setting latest round: %w", err)
+	}
+	return nil
+}
+
+// Concluded stores the votes of the concluded round and stops routing votes to the round.
+func (e *environment) Concluded(round uint64, _ finality_grandpa.RoundState[hash.H256, uint32],
+	_ finality_grandpa.HashNumber[hash.H256, uint32], votes finalityHistoricalVotes) error {
+	logger.Debugf("concluded round %d", round)
+
+	err := e.storeVotes(round, votes)
+	if err != nil {
+		return err
+	}
+
+	e.Lock()
+	defer e.Unlock()
+
+	input, ok := e.rounds[round]
+	if !ok {
+		return nil
+	}
+	delete(e.rounds, round)
+	close(input.done)
+	go func() {
+		input.deliveries.Wait()
+		close(input.incoming)
+	}()
+	return nil
+}
+
+func (e *environment) storeVotes(round uint64, votes finalityHistoricalVotes) error {
+	var prevotes, precommits []SignedVote
+	for _, seen := range votes.Seen() {
+		stage, vote, err := fromFinalityMessage(seen.Message)
+		if err != nil {
+			return err
+		}
+
+		signedVote := SignedVote{
+			Vote:      vote,
+			Signature: seen.Signature,
+		}
+		copy(signedVote.AuthorityID[:], seen.ID)
+
+		switch stage {
+		case prevote:
+			prevotes = append(prevotes, signedVote)
+		case precommit:
+			precommits = append(precommits, signedVote)
+		}
+	}
+
+	err := e.service.grandpaState.SetPrevotes(round, e.setID, prevotes)
+	if err != nil {
+		return fmt.Errorf("setting prevotes: %w", err)
+	}
+
+	err = e.service.grandpaState.SetPrecommits(round, e.setID, precommits)
+	if err != nil {
+		return fmt.Errorf("setting precommits: %w", err)
+	}
+	return nil
+}
+
+// FinalizeBlock finalises the block with the justification of the commit.
+func (e *environment) FinalizeBlock(hash hash.H256, number uint32, round uint64, commit finalityCommit) error {
+	blockHash := toCommonHash(hash)
+	logger.Debugf("finalising block %s with number %d in round %d", blockHash, number, round)
+
+	precommits := toSignedPrecommits(commit)
+	justification, err := scale.Marshal(*newJustification(round, blockHash, number, precommits))
+	if err != nil {
+		return fmt.Errorf("encoding justification: %w", err)
+	}
+
+	err = e.service.blockState.SetJustification(blockHash, justification)
+	if err != nil {
+		return fmt.Errorf("setting justification: %w", err)
+	}
+
+	err = e.service.grandpaState.SetPrecommits(round, e.setID, precommits)
+	if err != nil {
+		return fmt.Errorf("setting precommits: %w", err)
+	}
+
+	err = e.service.blockState.SetFinalisedHash(blockHash, round, e.setID)
+	if err != nil {
+		return fmt.Errorf("setting finalised hash: %w", err)
+	}
+
+	header, err := e.service.blockState.GetHeader(blockHash)
+	if err != nil {
+		return fmt.Errorf("getting finalised header: %w", err)
+	}
+	e.service.setHead(header)
+	return nil
+}
+
+// PrevoteEquivocation notes an equivocation in the prevotes of the round.
+func (*environment) PrevoteEquivocation(round uint64,
+	equivocation finality_grandpa.Equivocation[string, finality_grandpa.Prevote[hash.H256, uint32], [64]byte]) {
+	logger.Warnf("prevote equivocation in round %d by authority 0x%x", round, equivocation.Identity)
+}
+
+// PrecommitEquivocation notes an equivocation in the precommits of the round.
+func (*environment) PrecommitEquivocation(round uint64,
+	equivocation finality_grandpa.Equivocation[string, finality_grandpa.Precommit[hash.H256, uint32], [64]byte]) {
+	logger.Warnf("precommit equivocation in round %d by authority 0x%x", round, equivocation.Identity)
+}
+
+// toFinalityMessage converts a vote of the subround to a finality-grandpa message.
+func toFinalityMessage(stage Subround, vote Vote) (finalityMessage, error) {
+	target := toH256(vote.Hash)
+	switch stage {
+	case prevote:
+		return finality_grandpa.NewMessage[hash.H256, uint32](finality_grandpa.Prevote[hash.H256, uint32]{
+			TargetHash: target, TargetNumber: vote.Number}), nil
+	case precommit:
+		return finality_grandpa.NewMessage[hash.H256, uint32](finality_grandpa.Precommit[hash.H256, uint32]{
+			TargetHash: target, TargetNumber: vote.Number}), nil
+	case primaryProposal:
+		return finality_grandpa.NewMessage[hash.H256, uint32](finality_grandpa.PrimaryPropose[hash.H256, uint32]{
+			TargetHash: target, TargetNumber: vote.Number}), nil
+	default:
+		return finalityMessage{}, fmt.Errorf("%w: %s", ErrUnsupportedSubround, stage)
+	}
+}
+
+// fromFinalityMessage returns the subround and the vote of a finality-grandpa message.
+func fromFinalityMessage(message finalityMessage) (Subround, Vote, error) {
+	value, err := message.Value()
+	if err != nil {
+		return 0, Vote{}, fmt.Errorf("%w: %s", errUnknownMessage, err)
+	}
+
+	target := message.Target()
+	vote := *NewVote(toCommonHash(target.Hash), target.Number)
+	switch value.(type) {
+	case finality_grandpa.Prevote[hash.H256, uint32]:
+		return prevote, vote, nil
+	case finality_grandpa.Precommit[hash.H256, uint32]:
+		return precommit, vote, nil
+	case finality_grandpa.PrimaryPropose[hash.H256, uint32]:
+		return primaryProposal, vote, nil
+	default:
+		return 0, Vote{}, fmt.Errorf("%w: %T", errUnknownMessage, value)
+	}
+}
+
+// toSignedPrecommits returns the signed precommits of the commit.
+func toSignedPrecommits(commit finalityCommit) []SignedVote {
+	precommits := make([]SignedVote, len(commit.Precommits))
+	for i, signedPrecommit := range commit.Precommits {
+		precommits[i] = SignedVote{
+			Vote: *NewVote(toCommonHash(signedPrecommit.Precommit.TargetHash),
+				signedPrecommit.Precommit.TargetNumber),
+			Signature: signedPrecommit.Signature,
+		}
+		copy(precommits[i].AuthorityID[:], signedPrecommit.ID)
+	}
+	return precommits
+}
+
+func toH256(h common.Hash) hash.H256 {
+	return hash.H256(h.ToBytes())
+}
+
+func toCommonHash(h hash.H256) common.Hash {
+	return common.NewHash(h.Bytes())
+}
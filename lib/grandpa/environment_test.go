@@ -0,0 +1,250 @@
+// Copyright 2024 ChainSafe Systems (ON)
+// SPDX-License-Identifier: LGPL-3.0-only
+
+package grandpa
+
+import (
+	"testing"
+
+	"github.com/ChainSafe/gossamer/dot/types"
+	"github.com/ChainSafe/gossamer/internal/primitives/core/hash"
+	"github.com/ChainSafe/gossamer/lib/common"
+	"github.com/ChainSafe/gossamer/lib/crypto/ed25519"
+	"github.com/ChainSafe/gossamer/lib/keystore"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	finality_grandpa "github.com/ChainSafe/gossamer/pkg/finality-grandpa"
+)
+
+func Test_toFinalityMessage(t *testing.T) {
+	t.Parallel()
+
+	vote := *NewVote(common.Hash{1}, 2)
+	target := hash.H256(common.Hash{1}.ToBytes())
+
+	testCases := map[string]struct {
+		stage      Subround
+		message    finalityMessage
+		errWrapped error
+	}{
+		"prevote": {
+			stage: prevote,
+			message: finality_grandpa.NewMessage[hash.H256, uint32](
+				finality_grandpa.Prevote[hash.H256, uint32]{TargetHash: target, TargetNumber: 2}),
+		},
+		"precommit": {
+			stage: precommit,
+			message: finality_grandpa.NewMessage[hash.H256, uint32](
+				finality_grandpa.Precommit[hash.H256, uint32]{TargetHash: target, TargetNumber: 2}),
+		},
+		"primary_proposal": {
+			stage: primaryProposal,
+			message: finality_grandpa.NewMessage[hash.H256, uint32](
+				finality_grandpa.PrimaryPropose[hash.H256, uint32]{TargetHash: target, TargetNumber: 2}),
+		},
+		"unsupported_subround": {
+			stage:      Subround(3),
+			errWrapped: ErrUnsupportedSubround,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			message, err := toFinalityMessage(testCase.stage, vote)
+			require.ErrorIs(t, err, testCase.errWrapped)
+			if testCase.errWrapped != nil {
+				return
+			}
+			assert.Equal(t, testCase.message, message)
+
+			stage, messageVote, err := fromFinalityMessage(message)
+			require.NoError(t, err)
+			assert.Equal(t, testCase.stage, stage)
+			assert.Equal(t, vote, messageVote)
+		})
+	}
+}
+
+func Test_environment_Ancestry(t *testing.T) {
+	t.Parallel()
+
+	headers := make([]*types.Header, 4)
+	headers[0] = types.NewEmptyHeader()
+	for i := 1; i < len(headers); i++ {
+		headers[i] = types.NewHeader(headers[i-1].Hash(), common.Hash{}, common.Hash{}, uint(i), nil)
+	}
+	fork := types.NewHeader(common.Hash{9}, common.Hash{}, common.Hash{}, 3, nil)
+
+	testCases := map[string]struct {
+		base       *types.Header
+		block      *types.Header
+		ancestry   []hash.H256
+		errWrapped error
+	}{
+		"child": {
+			base:  headers[0],
+			block: headers[1],
+		},
+		"descendant": {
+			base:     headers[0],
+			block:    headers[3],
+			ancestry: []hash.H256{toH256(headers[2].Hash()), toH256(headers[1].Hash())},
+		},
+		"same_block": {
+			base:       headers[1],
+			block:      headers[1],
+			errWrapped: errNotDescendant,
+		},
+		"fork": {
+			base:       headers[2],
+			block:      fork,
+			errWrapped: errNotDescendant,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+
+			blockState := NewMockBlockState(ctrl)
+			all := append([]*types.Header{fork}, headers...)
+			for _, header := range all {
+				blockState.EXPECT().GetHeader(header.Hash()).Return(header, nil).AnyTimes()
+			}
+			env := &environment{service: &Service{blockState: blockState}}
+
+			ancestry, err := env.Ancestry(toH256(testCase.base.Hash()), toH256(testCase.block.Hash()))
+
+			require.ErrorIs(t, err, testCase.errWrapped)
+			assert.Equal(t, testCase.ancestry, ancestry)
+		})
+	}
+}
+
+func Test_environment_handleCatchUpResponse(t *testing.T) {
+	t.Parallel()
+
+	kr, err := keystore.NewEd25519Keyring()
+	require.NoError(t, err)
+	alice := kr.Alice().(*ed25519.Keypair)
+	authorities := []types.GrandpaVoter{{Key: *alice.Public().(*ed25519.PublicKey)}}
+
+	block := common.Hash{1}
+	unknownBlock := common.Hash{2}
+	const round, setID = uint64(3), uint64(1)
+
+	signedVote := func(t *testing.T, stage Subround, vote Vote) SignedVote {
+		t.Helper()
+		env := newEnvironment(&Service{keypair: alice, authority: true}, setID, authorities)
+		message, err := toFinalityMessage(stage, vote)
+		require.NoError(t, err)
+		_, voteMessage, err := env.signMessage(round, message)
+		require.NoError(t, err)
+		return SignedVote{
+			Vote:        vote,
+			Signature:   voteMessage.Message.Signature,
+			AuthorityID: voteMessage.Message.AuthorityID,
+		}
+	}
+
+	vote := *NewVote(block, 1)
+	validResponse := func(t *testing.T) *CatchUpResponse {
+		return &CatchUpResponse{
+			SetID:                  setID,
+			Round:                  round,
+			PreVoteJustification:   []SignedVote{signedVote(t, prevote, vote)},
+			PreCommitJustification: []SignedVote{signedVote(t, precommit, vote)},
+			Hash:                   block,
+			Number:                 1,
+		}
+	}
+
+	testCases := map[string]struct {
+		response     func(t *testing.T) *CatchUpResponse
+		errWrapped   error
+		errMessage   string
+		expectedItem bool
+	}{
+		"set_id_mismatch": {
+			response: func(*testing.T) *CatchUpResponse {
+				return &CatchUpResponse{SetID: setID + 1, Round: round}
+			},
+			errWrapped: ErrSetIDMismatch,
+			errMessage: "set IDs do not match: catch up for set id 2, current set id is 1",
+		},
+		"unknown_block": {
+			response: func(t *testing.T) *CatchUpResponse {
+				response := validResponse(t)
+				response.PreVoteJustification = []SignedVote{signedVote(t, prevote, *NewVote(unknownBlock, 1))}
+				return response
+			},
+			errWrapped: ErrBlockDoesNotExist,
+			errMessage: "verifying prevote: block does not exist: " + unknownBlock.String(),
+		},
+		"invalid_signature": {
+			response: func(t *testing.T) *CatchUpResponse {
+				response := validResponse(t)
+				// a prevote signature does not sign the precommit
+				response.PreCommitJustification = []SignedVote{signedVote(t, prevote, vote)}
+				return response
+			},
+			errWrapped: ErrInvalidSignature,
+		},
+		"valid_response": {
+			response:     validResponse,
+			expectedItem: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+
+			blockState := NewMockBlockState(ctrl)
+			blockState.EXPECT().HasHeader(block).Return(true, nil).AnyTimes()
+			blockState.EXPECT().HasHeader(unknownBlock).Return(false, nil).AnyTimes()
+			env := newEnvironment(&Service{blockState: blockState}, setID, authorities)
+
+			response := testCase.response(t)
+			err := env.handleCatchUpResponse(response)
+
+			require.ErrorIs(t, err, testCase.errWrapped)
+			if testCase.errMessage != "" {
+				assert.EqualError(t, err, testCase.errMessage)
+			}
+			if !testCase.expectedItem {
+				return
+			}
+
+			item := <-env.globalIn
+			catchUp, ok := item.CommunicationIn.Variant().(finality_grandpa.CommunicationInCatchUp[hash.H256, uint32, [64]byte, string])
+			require.True(t, ok)
+			id := string(alice.Public().Encode())
+			expected := finality_grandpa.CatchUp[hash.H256, uint32, [64]byte, string]{
+				RoundNumber: round,
+				Prevotes: []finality_grandpa.SignedPrevote[hash.H256, uint32, [64]byte, string]{{
+					Prevote:   finality_grandpa.Prevote[hash.H256, uint32]{TargetHash: toH256(block), TargetNumber: 1},
+					Signature: response.PreVoteJustification[0].Signature,
+					ID:        id,
+				}},
+				Precommits: []finality_grandpa.SignedPrecommit[hash.H256, uint32, [64]byte, string]{{
+					Precommit: finality_grandpa.Precommit[hash.H256, uint32]{TargetHash: toH256(block), TargetNumber: 1},
+					Signature: response.PreCommitJustification[0].Signature,
+					ID:        id,
+				}},
+				BaseHash:   toH256(block),
+				BaseNumber: 1,
+			}
+			assert.Equal(t, expected, catchUp.CatchUp)
+		})
+	}
+}
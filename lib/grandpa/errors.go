@@ -63,7 +63,7 @@
 	ErrInvalidCatchUpRound = errors.New("catch up request is for future round")
 
 	// ErrInvalidCatchUpResponseRound is returned when a catch-up response is received with an invalid round
-	ErrInvalidCatchUpResponseRound = errors.New("catch up response is not for previous round")
+	ErrInvalidCatchUpResponseRound = errors.New("catch up response is not for a later round")
 
 	// ErrGHOSTlessCatchUp is returned when a catch up response
 	// does not contain a valid grandpa-GHOST (ie. finalised block)
@@ -89,4 +89,6 @@
 	errRoundOutOfBounds         = errors.New("round out of bounds")
 	errRoundsMismatch           = errors.New("rounds mismatch")
 	errInvalidEquivocationStage = errors.New("invalid stage for equivocating")
+	errNotDescendant            = errors.New("block is not a descendant of base")
+	errUnknownMessage           = errors.New("unknown voter message")
 )
@@ -0,0 +1,218 @@
+// Copyright 2024 ChainSafe Systems (ON)
+// SPDX-License-Identifier: LGPL-3.0-only
+
+package grandpa
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/ChainSafe/gossamer/dot/types"
+	"github.com/ChainSafe/gossamer/internal/primitives/core/hash"
+
+	finality_grandpa "github.com/ChainSafe/gossamer/pkg/finality-grandpa"
+)
+
+// runFinalityVoter votes with the finality-grandpa voter instead of the round logic of the
+// service, restarting the voter with the new authorities whenever the authority set changes.
+func (s *Service) runFinalityVoter() error {
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	for {
+		voter, env, err := s.newFinalityVoter()
+		if err != nil {
+			return fmt.Errorf("creating voter: %w", err)
+		}
+
+		voterErr := make(chan error, 1)
+		go func() {
+			voterErr <- voter.Start()
+		}()
+
+		setChanged, err := s.waitForSetChange(env.setID, ticker.C, voterErr)
+		if err != nil {
+			env.stop()
+			s.setFinalityEnvironment(nil)
+			return err
+		}
+
+		err = voter.Stop()
+		env.stop()
+		s.setFinalityEnvironment(nil)
+		if err != nil {
+			return fmt.Errorf("stopping voter: %w", err)
+		}
+
+		if !setChanged {
+			return nil
+		}
+	}
+}
+
+// waitForSetChange waits until the current authority set changes, returning true, or the
+// service is stopped, returning false. It returns an error if the voter fails.
+func (s *Service) waitForSetChange(setID uint64, tick <-chan time.Time, voterErr <-chan error) (
+	setChanged bool, err error) {
+	for {
+		select {
+		case <-s.ctx.Done():
+			return false, nil
+		case err := <-voterErr:
+			return false, fmt.Errorf("running voter: %w", err)
+		case <-tick:
+			currentSetID, err := s.grandpaState.GetCurrentSetID()
+			if err != nil {
+				return false, fmt.Errorf("getting current set id: %w", err)
+			}
+			if currentSetID != setID {
+				logger.Debugf("authority set changed from set id %d to %d, restarting voter", setID, currentSetID)
+				return true, nil
+			}
+		}
+	}
+}
+
+// newFinalityVoter creates a voter of the current authority set, starting after the latest
+// round completed in the set.
+func (s *Service) newFinalityVoter() (*finalityVoter, *environment, error) {
+	setID, err := s.grandpaState.GetCurrentSetID()
+	if err != nil {
+		return nil, nil, fmt.Errorf("getting current set id: %w", err)
+	}
+
+	authorities, err := s.grandpaState.GetAuthorities(setID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("getting authorities for set id %d: %w", setID, err)
+	}
+
+	var lastRound uint64
+	highestRound, highestSetID, err := s.blockState.GetHighestRoundAndSetID()
+	if err != nil {
+		return nil, nil, fmt.Errorf("getting highest round and set id: %w", err)
+	}
+	if highestSetID == setID {
+		lastRound = highestRound
+		latestRound, err := s.grandpaState.GetLatestRound()
+		if err != nil {
+			return nil, nil, fmt.Errorf("getting latest round: %w", err)
+		}
+		if latestRound > lastRound {
+			lastRound = latestRound
+		}
+	}
+
+	finalised, err := s.blockState.GetHighestFinalisedHeader()
+	if err != nil {
+		return nil, nil, fmt.Errorf("getting highest finalised header: %w", err)
+	}
+
+	s.roundLock.Lock()
+	s.state.voters = authorities
+	s.state.setID = setID
+	s.state.round = lastRound
+	s.roundLock.Unlock()
+	roundGauge.Set(float64(lastRound))
+	s.setHead(finalised)
+
+	lastRoundVotes, err := s.lastRoundVotes(lastRound, setID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	idWeights := make([]finality_grandpa.IDWeight[string], len(authorities))
+	for i, authority := range authorities {
+		idWeights[i] = finality_grandpa.IDWeight[string]{
+			ID:     string(authority.Key.Encode()),
+			Weight: 1,
+		}
+	}
+	voters := finality_grandpa.NewVoterSet(idWeights)
+	if voters == nil {
+		return nil, nil, fmt.Errorf("%w: set id %d", ErrAuthorityNotInSet, setID)
+	}
+
+	env := newEnvironment(s, setID, authorities)
+	base := finality_grandpa.HashNumber[hash.H256, uint32]{
+		Hash:   toH256(finalised.Hash()),
+		Number: uint32(finalised.Number), //nolint:gosec
+	}
+
+	logger.Infof("starting voter for set id %d after round %d, with %d authorities and base %s",
+		setID, lastRound, len(authorities), finalised.Hash())
+
+	voter, globalOut := finality_grandpa.NewVoter[hash.H256, uint32, [64]byte, string](
+		env, *voters, env.globalIn, lastRound, lastRoundVotes, base, base)
+	go env.handleGlobalOut(globalOut)
+
+	s.setFinalityEnvironment(env)
+	return voter, env, nil
+}
+
+// lastRoundVotes returns the votes stored for the round, for the voter to resume it.
+// The votes of the round may not have been stored if the node stopped while running it.
+func (s *Service) lastRoundVotes(round, setID uint64) ([]finalitySignedMessage, error) {
+	if round == 0 {
+		return nil, nil
+	}
+
+	prevotes, err := s.grandpaState.GetPrevotes(round, setID)
+	if err != nil {
+		logger.Debugf("getting prevotes of round %d: %s", round, err)
+	}
+
+	precommits, err := s.grandpaState.GetPrecommits(round, setID)
+	if err != nil {
+		logger.Debugf("getting precommits of round %d: %s", round, err)
+	}
+
+	votes := make([]finalitySignedMessage, 0, len(prevotes)+len(precommits))
+	votes, err = appendFinalityMessages(votes, prevote, prevotes)
+	if err != nil {
+		return nil, err
+	}
+	return appendFinalityMessages(votes, precommit, precommits)
+}
+
+func appendFinalityMessages(messages []finalitySignedMessage, stage Subround, signedVotes []SignedVote) (
+	[]finalitySignedMessage, error) {
+	for _, signedVote := range signedVotes {
+		message, err := toFinalityMessage(stage, signedVote.Vote)
+		if err != nil {
+			return nil, err
+		}
+		messages = append(messages, finalitySignedMessage{
+			Message:   message,
+			Signature: signedVote.Signature,
+			ID:        string(signedVote.AuthorityID[:]),
+		})
+	}
+	return messages, nil
+}
+
+func (s *Service) setFinalityEnvironment(env *environment) {
+	s.envLock.Lock()
+	defer s.envLock.Unlock()
+	s.env = env
+}
+
+// finalityEnvironment returns the environment of the running finality-grandpa voter,
+// or nil if the voter is not running.
+func (s *Service) finalityEnvironment() *environment {
+	s.envLock.Lock()
+	defer s.envLock.Unlock()
+	return s.env
+}
+
+func (s *Service) setRound(round uint64) {
+	s.roundLock.Lock()
+	defer s.roundLock.Unlock()
+	s.state.round = round
+	roundGauge.Set(float64(round))
+}
+
+func (s *Service) setHead(head *types.Header) {
+	s.chanLock.Lock()
+	defer s.chanLock.Unlock()
+	s.head = head
+}
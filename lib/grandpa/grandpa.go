@@ -54,12 +54,18 @@ type Service struct {
 	mapLock        sync.Mutex
 	chanLock       sync.Mutex
 	roundLock      sync.Mutex
+	roundStateLock sync.Mutex    // serialises the updates of the persisted round state
 	authority      bool          // run the service as an authority (ie participate in voting)
 	paused         atomic.Value  // the service will be paused if it is waiting for catch up responses
 	resumed        chan struct{} // this channel will be closed when the service resumes
 	messageHandler *MessageHandler
 	network        Network
+	forkID         string
 	interval       time.Duration
+	finalityVoter  bool        // vote with the finality-grandpa voter instead of the round logic below
+	votingRules    VotingRules // restrict the target of our prevotes
+	envLock        sync.Mutex
+	env            *environment // environment of the running finality-grandpa voter
 
 	// current state information
 	state *State // current state
@@ -96,6 +102,11 @@ type Config struct {
 	Authority    bool
 	Interval     time.Duration
 	Telemetry    Telemetry
+	ForkID       string // fork id of the chain, set in the protocol id after the genesis hash
+	// FinalityVoter makes the authority vote with the finality-grandpa voter.
+	FinalityVoter bool
+	// VotingRules restrict the target of the prevotes of the authority.
+	VotingRules VotingRules
 }
 
 // NewService returns a new GRANDPA Service instance.
@@ -153,8 +164,11 @@ func NewService(cfg *Config) (*Service, error) {
 		head:               head,
 		resumed:            make(chan struct{}),
 		network:            cfg.Network,
+		forkID:             cfg.ForkID,
 		finalisedCh:        finalisedCh,
 		interval:           cfg.Interval,
+		finalityVoter:      cfg.FinalityVoter,
+		votingRules:        cfg.VotingRules,
 		telemetry:          cfg.Telemetry,
 		neighborMsgChan:    neighborMsgChan,
 	}
@@ -183,6 +197,16 @@ func (s *Service) Start() error {
 
 	s.tracker.start()
 
+	if s.finalityVoter {
+		go func() {
+			err := s.runFinalityVoter()
+			if err != nil {
+				panic(fmt.Sprintf("running grandpa voter: %s", err))
+			}
+		}()
+		return nil
+	}
+
 	go func() {
 		err := s.initiate()
 		if err != nil {
@@ -387,7 +411,7 @@ func (s *Service) handleIsPrimary() (bool, error) {
 	}
 
 	// send primary prevote message to network
-	spv, primProposal, err := s.createSignedVoteAndVoteMessage(pv, primaryProposal)
+	spv, primProposal, err := s.signOwnVote(pv, primaryProposal)
 	if err != nil {
 		return false, fmt.Errorf("failed to create primary proposal message: %w", err)
 	}
@@ -579,13 +603,11 @@ func (s *Service) determinePreVote() (*Vote, error) {
 	}
 
 	nextChange, err := s.grandpaState.NextGrandpaAuthorityChange(bestBlockHeader.Hash(), bestBlockHeader.Number)
-	if errors.Is(err, state.ErrNoNextAuthorityChange) {
-		return vote, nil
-	} else if err != nil {
+	if err != nil && !errors.Is(err, state.ErrNoNextAuthorityChange) {
 		return nil, fmt.Errorf("cannot get next grandpa authority change: %w", err)
 	}
 
-	if uint(vote.Number) > nextChange {
+	if err == nil && uint(vote.Number) > nextChange {
 		header, err := s.blockState.GetHeaderByNumber(nextChange)
 		if err != nil {
 			return nil, err
@@ -593,7 +615,20 @@ func (s *Service) determinePreVote() (*Vote, error) {
 		vote = NewVoteFromHeader(header)
 	}
 
-	return vote, nil
+	if len(s.votingRules) == 0 {
+		return vote, nil
+	}
+
+	target, err := s.blockState.GetHeader(vote.Hash)
+	if err != nil {
+		return nil, fmt.Errorf("cannot get vote target header: %w", err)
+	}
+
+	target, err = s.restrictVoteTarget(s.head, bestBlockHeader, target)
+	if err != nil {
+		return nil, err
+	}
+	return NewVoteFromHeader(target), nil
 }
 
 // determinePreCommit determines what block is our pre-committed block for the current round
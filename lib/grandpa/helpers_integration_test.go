@@ -92,6 +92,7 @@ func (*testNetwork) RegisterNotificationsProtocol(
 	_ network.NotificationsMessageHandler,
 	_ network.NotificationsMessageBatchHandler,
 	_ uint64,
+	_ ...protocol.ID,
 ) error {
 	return nil
 }
@@ -12,7 +12,6 @@
 	"github.com/ChainSafe/gossamer/internal/database"
 	"github.com/ChainSafe/gossamer/internal/primitives/core/hash"
 	"github.com/ChainSafe/gossamer/internal/primitives/runtime"
-	"github.com/ChainSafe/gossamer/lib/blocktree"
 	"github.com/ChainSafe/gossamer/lib/common"
 	"github.com/ChainSafe/gossamer/lib/crypto/ed25519"
 
@@ -46,12 +45,28 @@ func (h *MessageHandler) handleMessage(from peer.ID, m GrandpaMessage) (network.
 
 	switch msg := m.(type) {
 	case *VoteMessage:
+		if env := h.grandpa.finalityEnvironment(); env != nil {
+			err := env.handleVoteMessage(from, msg)
+			if err != nil {
+				return nil, fmt.Errorf("handling vote message: %w", err)
+			}
+			return nil, nil //nolint:nilnil
+		}
+
 		err := h.grandpa.handleVoteMessage(from, msg)
 		if err != nil {
 			return nil, fmt.Errorf("handling vote message: %w", err)
 		}
 		return nil, nil //nolint:nilnil
 	case *CommitMessage:
+		if env := h.grandpa.finalityEnvironment(); env != nil {
+			err := env.handleCommitMessage(msg)
+			if err != nil {
+				return nil, fmt.Errorf("handling commit message: %w", err)
+			}
+			return nil, nil //nolint:nilnil
+		}
+
 		err := h.grandpa.handleCommitMessage(msg)
 		if err != nil {
 			return nil, fmt.Errorf("handling commit message: %w", err)
@@ -64,15 +79,7 @@ func (h *MessageHandler) handleMessage(from peer.ID, m GrandpaMessage) (network.
 	case *CatchUpRequest:
 		return h.handleCatchUpRequest(msg)
 	case *CatchUpResponse:
-		err := h.handleCatchUpResponse(msg)
-		if errors.Is(err, blocktree.ErrNodeNotFound) {
-			// TODO: we are adding these messages to reprocess them again, but we
-			// haven't added code to reprocess them. Do that.
-			// Also, revisit if we need to add these message in synchronous manner
-			// or not. If not, change catchUpResponseMessages to a normal map.  #1531
-			h.grandpa.tracker.addCatchUpResponse(msg)
-		}
-		return nil, err
+		return nil, h.handleCatchUpResponse(from, msg)
 	default:
 		return nil, ErrInvalidMessageType
 	}
@@ -114,7 +121,10 @@ func (h *MessageHandler) handleCatchUpRequest(msg *CatchUpRequest) (*ConsensusMe
 	return resp.ToConsensusMessage()
 }
 
-func (h *MessageHandler) handleCatchUpResponse(msg *CatchUpResponse) error {
+// handleCatchUpResponse imports the catch up response answering our catch up request, skipping
+// ahead to the round after the round of the response. Only the finality voter can skip rounds,
+// the rounds of the legacy voting loop are not interrupted by catch up responses.
+func (h *MessageHandler) handleCatchUpResponse(from peer.ID, msg *CatchUpResponse) error {
 	if !h.grandpa.authority {
 		return nil
 	}
@@ -123,92 +133,99 @@ func (h *MessageHandler) handleCatchUpResponse(msg *CatchUpResponse) error {
 		"received catch up response with hash %s for round %d and set id %d",
 		msg.Hash, msg.Round, msg.SetID)
 
-	err := verifyBlockHashAgainstBlockNumber(h.blockState, msg.Hash, uint(msg.Number))
-	if err != nil {
-		if errors.Is(err, database.ErrNotFound) {
-			h.grandpa.tracker.addCatchUpResponse(msg)
-			logger.Infof("we might not have synced to the given block %s yet: %s", msg.Hash, err)
-			return nil
-		}
-		return err
-	}
-
-	// TODO: re-add catch-up logic (#1531)
-	if true {
+	if !h.grandpa.neighborTracker.catchUpResponded(from, msg) {
+		logger.Debugf("ignoring catch up response from peer %s which we did not request", from.ShortString())
 		return nil
 	}
 
-	// if we aren't currently expecting a catch up response, return
-	if !h.grandpa.paused.Load().(bool) {
-		logger.Debug("not currently paused, ignoring catch up response")
-		return nil
-	}
+	return h.importCatchUpResponse(msg)
+}
 
-	if msg.SetID != h.grandpa.state.setID {
+// importCatchUpResponse sends the catch up response to the finality voter, or imports it
+// in the default voting loop if the finality voter is not running. If we have not imported
+// the blocks of the response yet, the response is kept by the tracker and imported again
+// once we import a block.
+func (h *MessageHandler) importCatchUpResponse(msg *CatchUpResponse) error {
+	if msg.SetID != h.grandpa.GetSetID() {
 		return ErrSetIDMismatch
 	}
 
-	if msg.Round != h.grandpa.state.round-1 {
+	if msg.Round <= h.grandpa.GetRound() {
 		return ErrInvalidCatchUpResponseRound
 	}
 
-	prevote, err := h.verifyPreVoteJustification(msg)
+	err := verifyBlockHashAgainstBlockNumber(h.blockState, msg.Hash, uint(msg.Number))
 	if err != nil {
+		if errors.Is(err, database.ErrNotFound) {
+			h.grandpa.tracker.addCatchUpResponse(msg)
+			logger.Debugf("we might not have synced to the given block %s yet: %s", msg.Hash, err)
+			return nil
+		}
 		return err
 	}
 
-	if err = h.verifyPreCommitJustification(msg); err != nil {
-		return err
+	env := h.grandpa.finalityEnvironment()
+	if env == nil {
+		err = h.importLegacyCatchUpResponse(msg)
+	} else {
+		err = env.handleCatchUpResponse(msg)
 	}
+	if errors.Is(err, ErrBlockDoesNotExist) {
+		h.grandpa.tracker.addCatchUpResponse(msg)
+		logger.Debugf("we might not have synced to the blocks voted for yet: %s", err)
+		return nil
+	}
+	return err
+}
 
+// importLegacyCatchUpResponse verifies the prevotes and precommits of the catch up response,
+// stores them and finalises the block of the response in its round. The running round of the
+// default voting loop then completes, and the loop continues with the round after the one
+// of the response.
+func (h *MessageHandler) importLegacyCatchUpResponse(msg *CatchUpResponse) error {
 	if msg.Hash.IsEmpty() || msg.Number == 0 {
 		return ErrGHOSTlessCatchUp
 	}
 
-	if err = h.verifyCatchUpResponseCompletability(prevote, msg.Hash); err != nil {
-		return err
-	}
-
-	// set prevotes and precommits in db
-	if err = h.grandpa.grandpaState.SetPrevotes(msg.Round, msg.SetID, msg.PreVoteJustification); err != nil {
-		return err
+	for _, votes := range [][]SignedVote{msg.PreVoteJustification, msg.PreCommitJustification} {
+		for _, vote := range votes {
+			has, err := h.blockState.HasHeader(vote.Vote.Hash)
+			if err != nil {
+				return fmt.Errorf("checking if block %s is imported: %w", vote.Vote.Hash, err)
+			}
+			if !has {
+				return fmt.Errorf("%w: %s", ErrBlockDoesNotExist, vote.Vote.Hash)
+			}
+		}
 	}
 
-	if err = h.grandpa.grandpaState.SetPrecommits(msg.Round, msg.SetID, msg.PreCommitJustification); err != nil {
-		return err
+	_, err := h.verifyPreVoteJustification(msg)
+	if err != nil {
+		return fmt.Errorf("verifying prevote justification: %w", err)
 	}
 
-	// update state and signal to grandpa we are ready to initiate
-	head, err := h.grandpa.blockState.GetHeader(msg.Hash)
+	err = h.verifyPreCommitJustification(msg)
 	if err != nil {
-		return err
+		return fmt.Errorf("verifying precommit justification: %w", err)
 	}
 
-	h.grandpa.head = head
-	h.grandpa.state.round = msg.Round
-	close(h.grandpa.resumed)
-	h.grandpa.resumed = make(chan struct{})
-	h.grandpa.paused.Store(false)
-	logger.Debugf("caught up to round; unpaused service and grandpa state round is %d", h.grandpa.state.round)
-	return nil
-}
-
-// verifyCatchUpResponseCompletability verifies that the pre-commit block is a descendant of, or is, the pre-voted block
-func (h *MessageHandler) verifyCatchUpResponseCompletability(prevote, precommit common.Hash) error {
-	if prevote == precommit {
-		return nil
+	err = h.grandpa.grandpaState.SetPrevotes(msg.Round, msg.SetID, msg.PreVoteJustification)
+	if err != nil {
+		return fmt.Errorf("storing prevotes: %w", err)
 	}
 
-	// check if the current block is a descendant of prevoted block
-	isDescendant, err := h.grandpa.blockState.IsDescendantOf(prevote, precommit)
+	err = h.grandpa.grandpaState.SetPrecommits(msg.Round, msg.SetID, msg.PreCommitJustification)
 	if err != nil {
-		return err
+		return fmt.Errorf("storing precommits: %w", err)
 	}
 
-	if !isDescendant {
-		return ErrCatchUpResponseNotCompletable
+	err = h.blockState.SetFinalisedHash(msg.Hash, msg.Round, msg.SetID)
+	if err != nil {
+		return fmt.Errorf("finalising block %s: %w", msg.Hash, err)
 	}
 
+	logger.Debugf("imported catch up response for round %d and set id %d, finalised block %s",
+		msg.Round, msg.SetID, msg.Hash)
 	return nil
 }
 
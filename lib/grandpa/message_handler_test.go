@@ -7,10 +7,16 @@
 	"testing"
 
 	"github.com/ChainSafe/gossamer/dot/types"
+	"github.com/ChainSafe/gossamer/internal/database"
+	"github.com/ChainSafe/gossamer/internal/primitives/core/hash"
 	"github.com/ChainSafe/gossamer/lib/common"
 	"github.com/ChainSafe/gossamer/lib/crypto/ed25519"
+	"github.com/ChainSafe/gossamer/lib/keystore"
+	"github.com/libp2p/go-libp2p/core/peer"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
+
+	finality_grandpa "github.com/ChainSafe/gossamer/pkg/finality-grandpa"
 )
 
 func TestVerify_WestendBlock512_Justification(t *testing.T) {
@@ -61,3 +67,192 @@ func TestVerify_WestendBlock512_Justification(t *testing.T) {
 	require.Equal(t, uint64(0), setID)
 	require.Equal(t, uint64(713), round)
 }
+
+func Test_MessageHandler_handleCatchUpResponse(t *testing.T) {
+	t.Parallel()
+
+	kr, err := keystore.NewEd25519Keyring()
+	require.NoError(t, err)
+	keypairs := []*ed25519.Keypair{
+		kr.Alice().(*ed25519.Keypair),
+		kr.Bob().(*ed25519.Keypair),
+		kr.Charlie().(*ed25519.Keypair),
+	}
+	authorities := make([]types.GrandpaVoter, len(keypairs))
+	for i, keypair := range keypairs {
+		authorities[i] = types.GrandpaVoter{Key: *keypair.Public().(*ed25519.PublicKey), ID: uint64(i)}
+	}
+
+	const round, setID = uint64(3), uint64(1)
+	block := common.Hash{1}
+	header := &types.Header{Number: 1}
+	finalisedHeader := &types.Header{}
+	const from = peer.ID("testPeer")
+
+	signedVote := func(t *testing.T, keypair *ed25519.Keypair, stage Subround, vote Vote) SignedVote {
+		t.Helper()
+		env := newEnvironment(&Service{keypair: keypair, authority: true}, setID, authorities)
+		message, err := toFinalityMessage(stage, vote)
+		require.NoError(t, err)
+		_, voteMessage, err := env.signMessage(round, message)
+		require.NoError(t, err)
+		return SignedVote{
+			Vote:        vote,
+			Signature:   voteMessage.Message.Signature,
+			AuthorityID: voteMessage.Message.AuthorityID,
+		}
+	}
+	vote := *NewVote(block, 1)
+	var prevotes, precommits []SignedVote
+	for _, keypair := range keypairs {
+		prevotes = append(prevotes, signedVote(t, keypair, prevote, vote))
+		precommits = append(precommits, signedVote(t, keypair, precommit, vote))
+	}
+	response := &CatchUpResponse{
+		SetID:                  setID,
+		Round:                  round,
+		PreVoteJustification:   prevotes,
+		PreCommitJustification: precommits,
+		Hash:                   block,
+		Number:                 1,
+	}
+	responseWithoutSupermajority := &CatchUpResponse{
+		SetID:                  setID,
+		Round:                  round,
+		PreVoteJustification:   prevotes,
+		PreCommitJustification: precommits[:1],
+		Hash:                   block,
+		Number:                 1,
+	}
+	pending := &pendingCatchUp{
+		peer:    from,
+		request: CatchUpRequest{Round: round, SetID: setID},
+	}
+
+	testCases := map[string]struct {
+		legacyVoter     bool
+		response        *CatchUpResponse
+		pending         *pendingCatchUp
+		round           uint64
+		blockImported   bool
+		votesImported   bool
+		errWrapped      error
+		expectedPending *pendingCatchUp
+		expectedTracked bool
+		expectedItem    bool
+		expectedFinal   bool
+	}{
+		"legacy_voter_votes_not_imported": {
+			legacyVoter:     true,
+			pending:         pending,
+			blockImported:   true,
+			expectedTracked: true,
+		},
+		"legacy_voter_without_supermajority": {
+			legacyVoter:   true,
+			response:      responseWithoutSupermajority,
+			pending:       pending,
+			blockImported: true,
+			votesImported: true,
+			errWrapped:    ErrMinVotesNotMet,
+		},
+		"legacy_voter_imported": {
+			legacyVoter:   true,
+			pending:       pending,
+			blockImported: true,
+			votesImported: true,
+			expectedFinal: true,
+		},
+		"not_requested": {},
+		"round_passed": {
+			pending:    pending,
+			round:      round,
+			errWrapped: ErrInvalidCatchUpResponseRound,
+		},
+		"block_not_imported": {
+			pending:         pending,
+			expectedTracked: true,
+		},
+		"votes_not_imported": {
+			pending:         pending,
+			blockImported:   true,
+			expectedTracked: true,
+		},
+		"imported": {
+			pending:       pending,
+			blockImported: true,
+			votesImported: true,
+			expectedItem:  true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+
+			response := response
+			if testCase.response != nil {
+				response = testCase.response
+			}
+
+			blockState := NewMockBlockState(ctrl)
+			if testCase.blockImported {
+				blockState.EXPECT().GetHeader(block).Return(header, nil).MinTimes(1)
+			} else {
+				blockState.EXPECT().GetHeader(block).Return(nil, database.ErrNotFound).AnyTimes()
+			}
+			blockState.EXPECT().HasHeader(block).Return(testCase.votesImported, nil).AnyTimes()
+			if testCase.legacyVoter && testCase.votesImported {
+				blockState.EXPECT().GetHighestFinalisedHeader().Return(finalisedHeader, nil)
+				blockState.EXPECT().IsDescendantOf(finalisedHeader.Hash(), block).Return(true, nil)
+			}
+			grandpaState := NewMockGrandpaState(ctrl)
+			if testCase.expectedFinal {
+				grandpaState.EXPECT().SetPrevotes(round, setID, response.PreVoteJustification).Return(nil)
+				grandpaState.EXPECT().SetPrecommits(round, setID, response.PreCommitJustification).Return(nil)
+				blockState.EXPECT().SetFinalisedHash(block, round, setID).Return(nil)
+			}
+
+			service := &Service{
+				authority:    true,
+				keypair:      keypairs[0],
+				blockState:   blockState,
+				grandpaState: grandpaState,
+				state:        &State{setID: setID, round: 1, voters: authorities},
+			}
+			if testCase.round != 0 {
+				service.state.round = testCase.round
+			}
+			var env *environment
+			if !testCase.legacyVoter {
+				env = newEnvironment(service, setID, authorities)
+				service.env = env
+				t.Cleanup(env.stop)
+			}
+			handler := &MessageHandler{grandpa: service, blockState: blockState}
+			service.neighborTracker = &neighborTracker{grandpa: service, catchUp: testCase.pending}
+			service.tracker = &tracker{
+				handler:                 handler,
+				catchUpResponseMessages: make(map[uint64]*CatchUpResponse),
+			}
+
+			err := handler.handleCatchUpResponse(from, response)
+			require.ErrorIs(t, err, testCase.errWrapped)
+
+			require.Equal(t, testCase.expectedPending, service.neighborTracker.catchUp)
+			if testCase.expectedTracked {
+				require.Equal(t, map[uint64]*CatchUpResponse{round: response}, service.tracker.catchUpResponseMessages)
+			} else {
+				require.Empty(t, service.tracker.catchUpResponseMessages)
+			}
+			if testCase.expectedItem {
+				item := <-env.globalIn
+				variant := item.CommunicationIn.Variant()
+				_, ok := variant.(finality_grandpa.CommunicationInCatchUp[hash.H256, uint32, [64]byte, string])
+				require.True(t, ok)
+			}
+		})
+	}
+}
@@ -64,11 +64,10 @@ func (t *tracker) addCommit(cm *CommitMessage) {
 	t.commits.add(cm)
 }
 
-func (t *tracker) addCatchUpResponse(_ *CatchUpResponse) {
+func (t *tracker) addCatchUpResponse(cr *CatchUpResponse) {
 	t.catchUpResponseMessageMutex.Lock()
 	defer t.catchUpResponseMessageMutex.Unlock()
-	// uncomment when usage is setup properly, see #1531
-	// t.catchUpResponseMessages[cr.Round] = cr
+	t.catchUpResponseMessages[cr.Round] = cr
 }
 
 func (t *tracker) handleBlocks() {
@@ -115,6 +114,24 @@ func (t *tracker) handleBlock(b *types.Block) {
 
 		t.commits.delete(h)
 	}
+
+	t.handleCatchUpResponses()
+}
+
+// handleCatchUpResponses imports again the catch up responses waiting for their blocks. The
+// responses whose blocks are still missing are added back to the tracker.
+func (t *tracker) handleCatchUpResponses() {
+	t.catchUpResponseMessageMutex.Lock()
+	responses := t.catchUpResponseMessages
+	t.catchUpResponseMessages = make(map[uint64]*CatchUpResponse)
+	t.catchUpResponseMessageMutex.Unlock()
+
+	for _, cr := range responses {
+		err := t.handler.importCatchUpResponse(cr)
+		if err != nil {
+			logger.Debugf("failed to import catch up response for round %d: %s", cr.Round, err)
+		}
+	}
 }
 
 func (t *tracker) handleTick() {
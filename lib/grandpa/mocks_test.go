@@ -491,6 +491,21 @@ func (mr *MockGrandpaStateMockRecorder) GetPrevotes(arg0, arg1 any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPrevotes", reflect.TypeOf((*MockGrandpaState)(nil).GetPrevotes), arg0, arg1)
 }
 
+// GetRoundState mocks base method.
+func (m *MockGrandpaState) GetRoundState() (types.GrandpaRoundState, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRoundState")
+	ret0, _ := ret[0].(types.GrandpaRoundState)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRoundState indicates an expected call of GetRoundState.
+func (mr *MockGrandpaStateMockRecorder) GetRoundState() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRoundState", reflect.TypeOf((*MockGrandpaState)(nil).GetRoundState))
+}
+
 // GetSetIDByBlockNumber mocks base method.
 func (m *MockGrandpaState) GetSetIDByBlockNumber(arg0 uint) (uint64, error) {
 	m.ctrl.T.Helper()
@@ -563,6 +578,20 @@ func (mr *MockGrandpaStateMockRecorder) SetPrevotes(arg0, arg1, arg2 any) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetPrevotes", reflect.TypeOf((*MockGrandpaState)(nil).SetPrevotes), arg0, arg1, arg2)
 }
 
+// SetRoundState mocks base method.
+func (m *MockGrandpaState) SetRoundState(arg0 types.GrandpaRoundState) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetRoundState", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetRoundState indicates an expected call of SetRoundState.
+func (mr *MockGrandpaStateMockRecorder) SetRoundState(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetRoundState", reflect.TypeOf((*MockGrandpaState)(nil).SetRoundState), arg0)
+}
+
 // MockNetwork is a mock of Network interface.
 type MockNetwork struct {
 	ctrl     *gomock.Controller
@@ -599,17 +628,22 @@ func (mr *MockNetworkMockRecorder) GossipMessage(arg0 any) *gomock.Call {
 }
 
 // RegisterNotificationsProtocol mocks base method.
-func (m *MockNetwork) RegisterNotificationsProtocol(arg0 protocol.ID, arg1 network.MessageType, arg2 func() (network.Handshake, error), arg3 func([]byte) (network.Handshake, error), arg4 func(peer.ID, network.Handshake) error, arg5 func([]byte) (network.NotificationsMessage, error), arg6 func(peer.ID, network.NotificationsMessage) (bool, error), arg7 func(peer.ID, network.NotificationsMessage), arg8 uint64) error {
+func (m *MockNetwork) RegisterNotificationsProtocol(arg0 protocol.ID, arg1 network.MessageType, arg2 func() (network.Handshake, error), arg3 func([]byte) (network.Handshake, error), arg4 func(peer.ID, network.Handshake) error, arg5 func([]byte) (network.NotificationsMessage, error), arg6 func(peer.ID, network.NotificationsMessage) (bool, error), arg7 func(peer.ID, network.NotificationsMessage), arg8 uint64, arg9 ...protocol.ID) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "RegisterNotificationsProtocol", arg0, arg1, arg2, arg3, arg4, arg5, arg6, arg7, arg8)
+	varargs := []any{arg0, arg1, arg2, arg3, arg4, arg5, arg6, arg7, arg8}
+	for _, a := range arg9 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RegisterNotificationsProtocol", varargs...)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // RegisterNotificationsProtocol indicates an expected call of RegisterNotificationsProtocol.
-func (mr *MockNetworkMockRecorder) RegisterNotificationsProtocol(arg0, arg1, arg2, arg3, arg4, arg5, arg6, arg7, arg8 any) *gomock.Call {
+func (mr *MockNetworkMockRecorder) RegisterNotificationsProtocol(arg0, arg1, arg2, arg3, arg4, arg5, arg6, arg7, arg8 any, arg9 ...any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterNotificationsProtocol", reflect.TypeOf((*MockNetwork)(nil).RegisterNotificationsProtocol), arg0, arg1, arg2, arg3, arg4, arg5, arg6, arg7, arg8)
+	varargs := append([]any{arg0, arg1, arg2, arg3, arg4, arg5, arg6, arg7, arg8}, arg9...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterNotificationsProtocol", reflect.TypeOf((*MockNetwork)(nil).RegisterNotificationsProtocol), varargs...)
 }
 
 // SendMessage mocks base method.
@@ -15,6 +15,15 @@
 // How often neighbour messages should be rebroadcast in the case where no new packets are created
 const neighbourBroadcastPeriod = time.Minute * 2
 
+const (
+	// catchUpThreshold is the number of rounds a peer of our authority set must be ahead of us
+	// for us to request a catch up from it.
+	catchUpThreshold = 2
+	// catchUpRequestTimeout is how long a catch up request waits for its response before
+	// another catch up request can be sent.
+	catchUpRequestTimeout = 45 * time.Second
+)
+
 type neighborData struct {
 	peer        peer.ID
 	neighborMsg *NeighbourPacketV1
@@ -26,6 +35,13 @@ type neighborState struct {
 	highestFinalized uint32
 }
 
+// pendingCatchUp is a catch up request sent to a peer and waiting for its response.
+type pendingCatchUp struct {
+	peer    peer.ID
+	request CatchUpRequest
+	sentAt  time.Time
+}
+
 type neighborTracker struct {
 	sync.Mutex
 	grandpa *Service
@@ -34,6 +50,8 @@ type neighborTracker struct {
 	currentSetID     uint64
 	currentRound     uint64
 	highestFinalized uint32
+	// catchUp is the catch up request waiting for its response, if any.
+	catchUp *pendingCatchUp
 
 	finalizationCha chan *types.FinalisationInfo
 	neighborMsgChan chan neighborData
@@ -98,6 +116,10 @@ func (nt *neighborTracker) run() {
 					neighborData.neighborMsg.Number,
 				)
 			}
+			err := nt.requestCatchUp(neighborData.peer, neighborData.neighborMsg)
+			if err != nil {
+				logger.Warnf("requesting catch up: %s", err)
+			}
 		case <-nt.stoppedNeighbor:
 			logger.Info("stopping neighbour tracker")
 			return
@@ -127,6 +149,63 @@ func (nt *neighborTracker) getPeer(p peer.ID) neighborState {
 	return nt.peerview[p]
 }
 
+// requestCatchUp sends a catch up request to the peer if it is more than catchUpThreshold rounds
+// ahead of us in our authority set. Only one catch up request waits for its response at a time.
+func (nt *neighborTracker) requestCatchUp(p peer.ID, packet *NeighbourPacketV1) error {
+	if !nt.grandpa.authority {
+		return nil
+	}
+
+	setID := nt.grandpa.GetSetID()
+	round := nt.grandpa.GetRound()
+	if packet.SetID != setID || packet.Round <= round+catchUpThreshold {
+		return nil
+	}
+
+	// the peer completed the round before the one it is in, and can justify it.
+	request := newCatchUpRequest(packet.Round-1, setID)
+	cm, err := request.ToConsensusMessage()
+	if err != nil {
+		return fmt.Errorf("converting CatchUpRequest to network message: %w", err)
+	}
+
+	nt.Lock()
+	if nt.catchUp != nil && time.Since(nt.catchUp.sentAt) < catchUpRequestTimeout {
+		nt.Unlock()
+		return nil
+	}
+	nt.catchUp = &pendingCatchUp{
+		peer:    p,
+		request: *request,
+		sentAt:  time.Now(),
+	}
+	nt.Unlock()
+
+	logger.Debugf("peer %s is in round %d and we are in round %d, sending %s",
+		p.ShortString(), packet.Round, round, request)
+	err = nt.grandpa.network.SendMessage(p, cm)
+	if err != nil {
+		nt.Lock()
+		nt.catchUp = nil
+		nt.Unlock()
+		return fmt.Errorf("sending catch up request to peer %s: %w", p, err)
+	}
+	return nil
+}
+
+// catchUpResponded returns true if the catch up response from the peer answers the catch up
+// request waiting for its response, which then no longer waits.
+func (nt *neighborTracker) catchUpResponded(from peer.ID, response *CatchUpResponse) bool {
+	nt.Lock()
+	defer nt.Unlock()
+
+	if nt.catchUp == nil || nt.catchUp.peer != from || nt.catchUp.request.SetID != response.SetID {
+		return false
+	}
+	nt.catchUp = nil
+	return true
+}
+
 func (nt *neighborTracker) BroadcastNeighborMsg() error {
 	packet := NeighbourPacketV1{
 		Round:  nt.currentRound,
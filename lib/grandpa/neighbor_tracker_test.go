@@ -242,3 +242,173 @@ func TestNeighbourTracker_UpdatePeer_viaChannel(t *testing.T) {
 
 	nt.Stop()
 }
+
+func TestNeighbourTracker_RequestCatchUp(t *testing.T) {
+	request := newCatchUpRequest(9, 1)
+	cm, err := request.ToConsensusMessage()
+	require.NoError(t, err)
+
+	tests := []struct {
+		name            string
+		authority       bool
+		legacyVoter     bool
+		pending         *pendingCatchUp
+		packet          *NeighbourPacketV1
+		sendErr         error
+		expectedSent    bool
+		expectedPending bool
+		expectedErr     string
+	}{
+		{
+			name:   "not_authority",
+			packet: &NeighbourPacketV1{Round: 10, SetID: 1},
+		},
+		{
+			name:            "legacy_voter",
+			authority:       true,
+			legacyVoter:     true,
+			packet:          &NeighbourPacketV1{Round: 10, SetID: 1},
+			expectedSent:    true,
+			expectedPending: true,
+		},
+		{
+			name:      "other_set",
+			authority: true,
+			packet:    &NeighbourPacketV1{Round: 10, SetID: 2},
+		},
+		{
+			name:      "peer_within_threshold",
+			authority: true,
+			packet:    &NeighbourPacketV1{Round: 7, SetID: 1},
+		},
+		{
+			name:      "request_waiting_for_response",
+			authority: true,
+			pending: &pendingCatchUp{
+				peer:   "otherPeer",
+				sentAt: time.Now(),
+			},
+			packet:          &NeighbourPacketV1{Round: 10, SetID: 1},
+			expectedPending: true,
+		},
+		{
+			name:      "request_timed_out",
+			authority: true,
+			pending: &pendingCatchUp{
+				peer:   "otherPeer",
+				sentAt: time.Now().Add(-catchUpRequestTimeout),
+			},
+			packet:          &NeighbourPacketV1{Round: 10, SetID: 1},
+			expectedSent:    true,
+			expectedPending: true,
+		},
+		{
+			name:            "peer_ahead",
+			authority:       true,
+			packet:          &NeighbourPacketV1{Round: 10, SetID: 1},
+			expectedSent:    true,
+			expectedPending: true,
+		},
+		{
+			name:         "send_error",
+			authority:    true,
+			packet:       &NeighbourPacketV1{Round: 10, SetID: 1},
+			sendErr:      fmt.Errorf("test error"),
+			expectedSent: true,
+			expectedErr:  "sending catch up request to peer " + peer.ID("testPeer").String() + ": test error",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockNetwork := NewMockNetwork(ctrl)
+			if tt.expectedSent {
+				mockNetwork.EXPECT().SendMessage(peer.ID("testPeer"), cm).Return(tt.sendErr)
+			}
+
+			grandpaService := &Service{
+				network:   mockNetwork,
+				authority: tt.authority,
+				state: &State{
+					setID: 1,
+					round: 7,
+				},
+			}
+			if !tt.legacyVoter {
+				grandpaService.env = &environment{}
+			}
+			nt := &neighborTracker{
+				grandpa: grandpaService,
+				catchUp: tt.pending,
+			}
+
+			err := nt.requestCatchUp("testPeer", tt.packet)
+			if tt.expectedErr != "" {
+				require.EqualError(t, err, tt.expectedErr)
+			} else {
+				require.NoError(t, err)
+			}
+
+			if !tt.expectedPending {
+				require.Equal(t, tt.pending, nt.catchUp)
+				return
+			}
+			require.NotNil(t, nt.catchUp)
+			if tt.expectedSent {
+				require.Equal(t, peer.ID("testPeer"), nt.catchUp.peer)
+				require.Equal(t, *request, nt.catchUp.request)
+			}
+		})
+	}
+}
+
+func TestNeighbourTracker_CatchUpResponded(t *testing.T) {
+	pending := &pendingCatchUp{
+		peer:    "testPeer",
+		request: CatchUpRequest{Round: 9, SetID: 1},
+	}
+
+	tests := []struct {
+		name            string
+		pending         *pendingCatchUp
+		from            peer.ID
+		response        *CatchUpResponse
+		expected        bool
+		expectedPending *pendingCatchUp
+	}{
+		{
+			name:     "no_request",
+			from:     "testPeer",
+			response: &CatchUpResponse{Round: 9, SetID: 1},
+		},
+		{
+			name:            "other_peer",
+			pending:         pending,
+			from:            "otherPeer",
+			response:        &CatchUpResponse{Round: 9, SetID: 1},
+			expectedPending: pending,
+		},
+		{
+			name:            "other_set",
+			pending:         pending,
+			from:            "testPeer",
+			response:        &CatchUpResponse{Round: 9, SetID: 2},
+			expectedPending: pending,
+		},
+		{
+			name:     "requested",
+			pending:  pending,
+			from:     "testPeer",
+			response: &CatchUpResponse{Round: 9, SetID: 1},
+			expected: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			nt := &neighborTracker{catchUp: tt.pending}
+			responded := nt.catchUpResponded(tt.from, tt.response)
+			require.Equal(t, tt.expected, responded)
+			require.Equal(t, tt.expectedPending, nt.catchUp)
+		})
+	}
+}
@@ -4,10 +4,13 @@
 package grandpa
 
 import (
+	"errors"
 	"fmt"
 	"strings"
 
 	"github.com/ChainSafe/gossamer/dot/network"
+	"github.com/ChainSafe/gossamer/internal/database"
+	"github.com/ChainSafe/gossamer/lib/blocktree"
 	"github.com/ChainSafe/gossamer/lib/common"
 	"github.com/ChainSafe/gossamer/pkg/scale"
 
@@ -17,6 +20,10 @@
 
 const grandpaID1 = "grandpa/1"
 
+// legacyGrandpaProtocolID is the protocol id used by peers which do not
+// support the genesis hash prefixed protocol id.
+const legacyGrandpaProtocolID = "/paritytech/grandpa/1"
+
 // NotificationsMessage is an alias for network.NotificationsMessage
 type NotificationsMessage = network.NotificationsMessage
 
@@ -57,6 +64,9 @@ func (s *Service) registerProtocol() error {
 	genesisHash := s.blockState.GenesisHash().String()
 	genesisHash = strings.TrimPrefix(genesisHash, "0x")
 	grandpaProtocolID := fmt.Sprintf("/%s/%s", genesisHash, grandpaID1)
+	if s.forkID != "" {
+		grandpaProtocolID = fmt.Sprintf("/%s/%s/%s", genesisHash, s.forkID, grandpaID1)
+	}
 
 	return s.network.RegisterNotificationsProtocol(
 		protocol.ID(grandpaProtocolID),
@@ -68,6 +78,7 @@ func (s *Service) registerProtocol() error {
 		s.handleNetworkMessage,
 		nil,
 		network.MaxGrandpaNotificationSize,
+		legacyGrandpaProtocolID,
 	)
 }
 
@@ -122,13 +133,22 @@ func (s *Service) handleNetworkMessage(from peer.ID, msg NotificationsMessage) (
 
 	resp, err := s.messageHandler.handleMessage(from, m)
 	if err != nil {
+		if isRoutineMessageError(err) {
+			// returning the error would reset the stream and lose the next messages of the peer.
+			logger.Debugf("ignoring message from peer %s: %s", from, err)
+			return false, nil
+		}
 		return false, err
 	}
 
 	switch r := resp.(type) {
 	case *ConsensusMessage:
+		// the only response is the catch up response, sent to the peer requesting it
 		if r != nil {
-			s.network.GossipMessage(resp)
+			err = s.network.SendMessage(from, r)
+			if err != nil {
+				return false, fmt.Errorf("sending response to peer %s: %w", from, err)
+			}
 		}
 	case nil:
 	default:
@@ -145,6 +165,19 @@ func (s *Service) handleNetworkMessage(from peer.ID, msg NotificationsMessage) (
 	return true, nil
 }
 
+// isRoutineMessageError returns true if the error is expected from honest peers, such as
+// messages for blocks we have not imported yet or for rounds we are not running.
+func isRoutineMessageError(err error) bool {
+	return errors.Is(err, ErrBlockDoesNotExist) ||
+		errors.Is(err, database.ErrNotFound) ||
+		errors.Is(err, blocktree.ErrDescendantNotFound) ||
+		errors.Is(err, blocktree.ErrEndNodeNotFound) ||
+		errors.Is(err, blocktree.ErrStartNodeNotFound) ||
+		errors.Is(err, errRoundOutOfBounds) ||
+		errors.Is(err, errRoundsMismatch) ||
+		errors.Is(err, errVoteFromSelf)
+}
+
 // decodeMessage decodes a network-level consensus message into a GRANDPA VoteMessage or CommitMessage
 func decodeMessage(cm *network.ConsensusMessage) (m GrandpaMessage, err error) {
 	msg := newGrandpaMessage()
@@ -0,0 +1,113 @@
+// Copyright 2024 ChainSafe Systems (ON)
+// SPDX-License-Identifier: LGPL-3.0-only
+
+package grandpa
+
+import (
+	"testing"
+
+	"github.com/ChainSafe/gossamer/dot/types"
+	"github.com/ChainSafe/gossamer/lib/common"
+	"github.com/ChainSafe/gossamer/lib/crypto/ed25519"
+	"github.com/ChainSafe/gossamer/lib/keystore"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func Test_Service_handleNetworkMessage_errors(t *testing.T) {
+	t.Parallel()
+
+	kr, err := keystore.NewEd25519Keyring()
+	require.NoError(t, err)
+	alice := kr.Alice().(*ed25519.Keypair)
+	bob := kr.Bob().(*ed25519.Keypair)
+	authorities := []types.GrandpaVoter{{Key: *alice.Public().(*ed25519.PublicKey)}}
+
+	block := common.Hash{1}
+	unknownBlock := common.Hash{2}
+	const setID = uint64(1)
+
+	voteMessage := func(t *testing.T, keypair *ed25519.Keypair, round uint64, hash common.Hash) *VoteMessage {
+		t.Helper()
+		voters := []types.GrandpaVoter{{Key: *keypair.Public().(*ed25519.PublicKey)}}
+		env := newEnvironment(&Service{keypair: keypair, authority: true}, setID, voters)
+		message, err := toFinalityMessage(prevote, *NewVote(hash, 1))
+		require.NoError(t, err)
+		_, voteMessage, err := env.signMessage(round, message)
+		require.NoError(t, err)
+		return voteMessage
+	}
+
+	testCases := map[string]struct {
+		message    func(t *testing.T) GrandpaMessage
+		errWrapped error
+	}{
+		"vote_for_unknown_block": {
+			message: func(t *testing.T) GrandpaMessage {
+				return voteMessage(t, alice, 1, unknownBlock)
+			},
+		},
+		"vote_for_round_not_running": {
+			message: func(t *testing.T) GrandpaMessage {
+				return voteMessage(t, alice, 10, block)
+			},
+		},
+		"vote_with_invalid_signature": {
+			message: func(t *testing.T) GrandpaMessage {
+				message := voteMessage(t, alice, 1, block)
+				message.Message.Signature[0]++
+				return message
+			},
+			errWrapped: ErrInvalidSignature,
+		},
+		"vote_from_non_authority": {
+			message: func(t *testing.T) GrandpaMessage {
+				return voteMessage(t, bob, 1, block)
+			},
+			errWrapped: ErrVoterNotFound,
+		},
+		"commit_without_signatures": {
+			message: func(*testing.T) GrandpaMessage {
+				return &CommitMessage{
+					Round:      1,
+					SetID:      setID,
+					Vote:       *NewVote(block, 1),
+					Precommits: []Vote{*NewVote(block, 1)},
+				}
+			},
+			errWrapped: ErrPrecommitSignatureMismatch,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+
+			blockState := NewMockBlockState(ctrl)
+			blockState.EXPECT().HasHeader(block).Return(true, nil).AnyTimes()
+			blockState.EXPECT().HasHeader(unknownBlock).Return(false, nil).AnyTimes()
+
+			s := &Service{
+				blockState: blockState,
+				tracker: &tracker{
+					votes:   newVotesTracker(1),
+					commits: newCommitsTracker(1),
+				},
+			}
+			s.messageHandler = &MessageHandler{grandpa: s, blockState: blockState}
+			s.setFinalityEnvironment(newEnvironment(s, setID, authorities))
+
+			message := testCase.message(t)
+			cm, err := message.ToConsensusMessage()
+			require.NoError(t, err)
+
+			propagate, err := s.handleNetworkMessage(peer.ID("peer"), cm)
+
+			require.ErrorIs(t, err, testCase.errWrapped)
+			require.False(t, propagate)
+		})
+	}
+}
@@ -0,0 +1,118 @@
+// Copyright 2024 ChainSafe Systems (ON)
+// SPDX-License-Identifier: LGPL-3.0-only
+
+package grandpa
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/ChainSafe/gossamer/dot/types"
+	"github.com/ChainSafe/gossamer/internal/database"
+)
+
+// loadOwnVote returns our signed vote of the subround in the round of the set, persisted before
+// it was sent. It returns nil if we have not voted in the subround.
+func (s *Service) loadOwnVote(round, setID uint64, stage Subround) (*SignedVote, error) {
+	roundState, err := s.grandpaState.GetRoundState()
+	if errors.Is(err, database.ErrNotFound) {
+		return nil, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("getting round state: %w", err)
+	}
+
+	if roundState.Round != round || roundState.SetID != setID {
+		return nil, nil
+	}
+
+	switch stage {
+	case primaryProposal:
+		return roundState.PrimaryProposal, nil
+	case prevote:
+		return roundState.Prevote, nil
+	case precommit:
+		return roundState.Precommit, nil
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrUnsupportedSubround, stage)
+	}
+}
+
+// storeOwnVote persists our signed vote of the subround in the round of the set, so that we
+// never sign another vote in the subround, even after a restart. The votes of the previous
+// rounds are dropped.
+func (s *Service) storeOwnVote(round, setID uint64, stage Subround, signedVote *SignedVote) error {
+	s.roundStateLock.Lock()
+	defer s.roundStateLock.Unlock()
+
+	roundState, err := s.grandpaState.GetRoundState()
+	if err != nil && !errors.Is(err, database.ErrNotFound) {
+		return fmt.Errorf("getting round state: %w", err)
+	}
+
+	if roundState.Round != round || roundState.SetID != setID {
+		roundState = types.GrandpaRoundState{
+			Round: round,
+			SetID: setID,
+		}
+	}
+
+	switch stage {
+	case primaryProposal:
+		roundState.PrimaryProposal = signedVote
+	case prevote:
+		roundState.Prevote = signedVote
+	case precommit:
+		roundState.Precommit = signedVote
+	default:
+		return fmt.Errorf("%w: %s", ErrUnsupportedSubround, stage)
+	}
+
+	err = s.grandpaState.SetRoundState(roundState)
+	if err != nil {
+		return fmt.Errorf("setting round state: %w", err)
+	}
+	return nil
+}
+
+// signOwnVote signs our vote of the subround in the current round and persists it before it is
+// sent. If we already voted in the subround before a restart, the vote persisted is returned
+// instead, so that we do not equivocate.
+func (s *Service) signOwnVote(vote *Vote, stage Subround) (*SignedVote, *VoteMessage, error) {
+	round, setID := s.state.round, s.state.setID
+	signedVote, err := s.loadOwnVote(round, setID, stage)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if signedVote != nil {
+		logger.Debugf("resuming %s vote for block %s in round %d, voted before restarting",
+			stage, signedVote.Vote.Hash, round)
+		return signedVote, newOwnVoteMessage(round, setID, stage, signedVote), nil
+	}
+
+	signedVote, voteMessage, err := s.createSignedVoteAndVoteMessage(vote, stage)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	err = s.storeOwnVote(round, setID, stage, signedVote)
+	if err != nil {
+		return nil, nil, err
+	}
+	return signedVote, voteMessage, nil
+}
+
+// newOwnVoteMessage returns the vote message of our signed vote of the subround.
+func newOwnVoteMessage(round, setID uint64, stage Subround, signedVote *SignedVote) *VoteMessage {
+	return &VoteMessage{
+		Round: round,
+		SetID: setID,
+		Message: SignedMessage{
+			Stage:       stage,
+			BlockHash:   signedVote.Vote.Hash,
+			Number:      signedVote.Vote.Number,
+			Signature:   signedVote.Signature,
+			AuthorityID: signedVote.AuthorityID,
+		},
+	}
+}
@@ -0,0 +1,197 @@
+// Copyright 2024 ChainSafe Systems (ON)
+// SPDX-License-Identifier: LGPL-3.0-only
+
+package grandpa
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ChainSafe/gossamer/dot/types"
+	"github.com/ChainSafe/gossamer/internal/database"
+	"github.com/ChainSafe/gossamer/lib/common"
+	"github.com/ChainSafe/gossamer/lib/crypto/ed25519"
+	"github.com/ChainSafe/gossamer/lib/keystore"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func Test_Service_storeOwnVote(t *testing.T) {
+	t.Parallel()
+
+	errTest := errors.New("test error")
+	previousVote := &SignedVote{Vote: *NewVote(common.Hash{1}, 1)}
+	signedVote := &SignedVote{Vote: *NewVote(common.Hash{2}, 2)}
+
+	testCases := map[string]struct {
+		storedState   types.GrandpaRoundState
+		getErr        error
+		stage         Subround
+		expectedState *types.GrandpaRoundState
+		setErr        error
+		errWrapped    error
+		errMessage    string
+	}{
+		"get_error": {
+			getErr:     errTest,
+			stage:      prevote,
+			errWrapped: errTest,
+			errMessage: "getting round state: test error",
+		},
+		"no_round_state": {
+			getErr: database.ErrNotFound,
+			stage:  prevote,
+			expectedState: &types.GrandpaRoundState{
+				Round:   2,
+				SetID:   1,
+				Prevote: signedVote,
+			},
+		},
+		"same_round": {
+			storedState: types.GrandpaRoundState{
+				Round:   2,
+				SetID:   1,
+				Prevote: previousVote,
+			},
+			stage: precommit,
+			expectedState: &types.GrandpaRoundState{
+				Round:     2,
+				SetID:     1,
+				Prevote:   previousVote,
+				Precommit: signedVote,
+			},
+		},
+		"previous_round": {
+			storedState: types.GrandpaRoundState{
+				Round:   1,
+				SetID:   1,
+				Prevote: previousVote,
+			},
+			stage: primaryProposal,
+			expectedState: &types.GrandpaRoundState{
+				Round:           2,
+				SetID:           1,
+				PrimaryProposal: signedVote,
+			},
+		},
+		"set_error": {
+			getErr: database.ErrNotFound,
+			stage:  precommit,
+			expectedState: &types.GrandpaRoundState{
+				Round:     2,
+				SetID:     1,
+				Precommit: signedVote,
+			},
+			setErr:     errTest,
+			errWrapped: errTest,
+			errMessage: "setting round state: test error",
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+
+			grandpaState := NewMockGrandpaState(ctrl)
+			grandpaState.EXPECT().GetRoundState().Return(testCase.storedState, testCase.getErr)
+			if testCase.expectedState != nil {
+				grandpaState.EXPECT().SetRoundState(*testCase.expectedState).Return(testCase.setErr)
+			}
+			s := &Service{grandpaState: grandpaState}
+
+			err := s.storeOwnVote(2, 1, testCase.stage, signedVote)
+
+			assert.ErrorIs(t, err, testCase.errWrapped)
+			if testCase.errWrapped != nil {
+				assert.EqualError(t, err, testCase.errMessage)
+			}
+		})
+	}
+}
+
+func Test_Service_signOwnVote(t *testing.T) {
+	t.Parallel()
+
+	kr, err := keystore.NewEd25519Keyring()
+	require.NoError(t, err)
+	alice := kr.Alice().(*ed25519.Keypair)
+
+	persistedVote := &SignedVote{
+		Vote:        *NewVote(common.Hash{1}, 1),
+		Signature:   [64]byte{1},
+		AuthorityID: alice.Public().(*ed25519.PublicKey).AsBytes(),
+	}
+	vote := NewVote(common.Hash{2}, 2)
+
+	t.Run("voted_before_restart", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+
+		grandpaState := NewMockGrandpaState(ctrl)
+		grandpaState.EXPECT().GetRoundState().Return(types.GrandpaRoundState{
+			Round:   2,
+			SetID:   1,
+			Prevote: persistedVote,
+		}, nil)
+		s := &Service{
+			grandpaState: grandpaState,
+			keypair:      alice,
+			state:        &State{round: 2, setID: 1},
+		}
+
+		signedVote, voteMessage, err := s.signOwnVote(vote, prevote)
+		require.NoError(t, err)
+
+		assert.Equal(t, persistedVote, signedVote)
+		expectedMessage := &VoteMessage{
+			Round: 2,
+			SetID: 1,
+			Message: SignedMessage{
+				Stage:       prevote,
+				BlockHash:   persistedVote.Vote.Hash,
+				Number:      persistedVote.Vote.Number,
+				Signature:   persistedVote.Signature,
+				AuthorityID: persistedVote.AuthorityID,
+			},
+		}
+		assert.Equal(t, expectedMessage, voteMessage)
+	})
+
+	t.Run("not_voted", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+
+		grandpaState := NewMockGrandpaState(ctrl)
+		// the persisted vote is for the precommit, not the prevote.
+		storedState := types.GrandpaRoundState{
+			Round:     2,
+			SetID:     1,
+			Precommit: persistedVote,
+		}
+		grandpaState.EXPECT().GetRoundState().Return(storedState, nil).Times(2)
+		s := &Service{
+			grandpaState: grandpaState,
+			keypair:      alice,
+			state:        &State{round: 2, setID: 1},
+		}
+		_, expectedMessage, err := s.createSignedVoteAndVoteMessage(vote, prevote)
+		require.NoError(t, err)
+
+		expectedState := storedState
+		expectedState.Prevote = &SignedVote{
+			Vote:        *vote,
+			Signature:   expectedMessage.Message.Signature,
+			AuthorityID: persistedVote.AuthorityID,
+		}
+		grandpaState.EXPECT().SetRoundState(expectedState).Return(nil)
+
+		signedVote, voteMessage, err := s.signOwnVote(vote, prevote)
+		require.NoError(t, err)
+
+		assert.Equal(t, expectedState.Prevote, signedVote)
+		assert.Equal(t, expectedMessage, voteMessage)
+	})
+}
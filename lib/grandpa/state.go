@@ -47,6 +47,8 @@ type GrandpaState interface {
 	GetSetIDByBlockNumber(num uint) (uint64, error)
 	SetLatestRound(round uint64) error
 	GetLatestRound() (uint64, error)
+	SetRoundState(roundState types.GrandpaRoundState) error
+	GetRoundState() (types.GrandpaRoundState, error)
 	SetPrevotes(round, setID uint64, data []SignedVote) error
 	SetPrecommits(round, setID uint64, data []SignedVote) error
 	GetPrevotes(round, setID uint64) ([]SignedVote, error)
@@ -68,5 +70,6 @@ type Network interface {
 		messageHandler network.NotificationsMessageHandler,
 		batchHandler network.NotificationsMessageBatchHandler,
 		maxSize uint64,
+		fallbackProtocolIDs ...protocol.ID,
 	) error
 }
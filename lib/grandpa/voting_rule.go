@@ -0,0 +1,119 @@
+// Copyright 2024 ChainSafe Systems (ON)
+// SPDX-License-Identifier: LGPL-3.0-only
+
+package grandpa
+
+import (
+	"fmt"
+
+	"github.com/ChainSafe/gossamer/dot/types"
+)
+
+// VotingRule restricts the block our authority votes for in a round, to not finalise the
+// unfinalised chain too aggressively.
+type VotingRule interface {
+	// RestrictVote returns the block to vote for instead of the current target, an ancestor of
+	// the current target, given the base block of the round and the best block. It returns nil
+	// if the current target does not need to be restricted.
+	RestrictVote(blockState BlockState, base, best, current *types.Header) (*types.Header, error)
+}
+
+// BeforeBestBlockBy is a voting rule restricting the vote target to be at least the given
+// number of blocks behind the best block.
+type BeforeBestBlockBy uint
+
+// RestrictVote restricts the vote target to the ancestor of the current target which is the
+// given number of blocks behind the best block.
+func (b BeforeBestBlockBy) RestrictVote(blockState BlockState, _, best, current *types.Header) (
+	*types.Header, error) {
+	if current.Number == 0 || best.Number < uint(b) {
+		return nil, nil
+	}
+
+	targetNumber := best.Number - uint(b)
+	if targetNumber >= current.Number {
+		return nil, nil
+	}
+	return findTarget(blockState, targetNumber, current)
+}
+
+// ThreeQuartersOfTheUnfinalizedChain is a voting rule restricting the vote target to be at most
+// three quarters of the way from the base block to the best block.
+type ThreeQuartersOfTheUnfinalizedChain struct{}
+
+// RestrictVote restricts the vote target to the ancestor of the current target which is three
+// quarters of the way from the base block to the best block.
+func (ThreeQuartersOfTheUnfinalizedChain) RestrictVote(blockState BlockState, base, best,
+	current *types.Header) (*types.Header, error) {
+	if best.Number < base.Number {
+		return nil, nil
+	}
+
+	// rounding to the nearest block
+	diff := (3*(best.Number-base.Number) + 2) / 4
+	targetNumber := base.Number + diff
+	if targetNumber >= current.Number {
+		return nil, nil
+	}
+	return findTarget(blockState, targetNumber, current)
+}
+
+// VotingRules applies the voting rules in order, each restricting the target restricted by the
+// rules before it.
+type VotingRules []VotingRule
+
+// RestrictVote returns the target restricted by all the voting rules, or nil if none of them
+// restricts the current target.
+func (rules VotingRules) RestrictVote(blockState BlockState, base, best, current *types.Header) (
+	*types.Header, error) {
+	restricted := current
+	for _, rule := range rules {
+		target, err := rule.RestrictVote(blockState, base, best, restricted)
+		if err != nil {
+			return nil, err
+		}
+		if target != nil && target.Number < restricted.Number {
+			restricted = target
+		}
+	}
+
+	if restricted.Hash() == current.Hash() {
+		return nil, nil
+	}
+	return restricted, nil
+}
+
+// findTarget walks back the chain from the current target to its ancestor with the target
+// number.
+func findTarget(blockState BlockState, targetNumber uint, current *types.Header) (*types.Header, error) {
+	target := current
+	for target.Number > targetNumber {
+		parent, err := blockState.GetHeader(target.ParentHash)
+		if err != nil {
+			return nil, fmt.Errorf("getting parent header of block %s: %w", target.Hash(), err)
+		}
+		target = parent
+	}
+	return target, nil
+}
+
+// restrictVoteTarget restricts the vote target with the voting rules of the service. The target
+// is only restricted to a block between the base block and the target.
+func (s *Service) restrictVoteTarget(base, best, target *types.Header) (*types.Header, error) {
+	if len(s.votingRules) == 0 {
+		return target, nil
+	}
+
+	restricted, err := s.votingRules.RestrictVote(s.blockState, base, best, target)
+	if err != nil {
+		return nil, fmt.Errorf("restricting vote target: %w", err)
+	}
+
+	if restricted == nil || restricted.Number < base.Number || restricted.Number >= target.Number {
+		return target, nil
+	}
+
+	logger.Debugf("voting rules restricted the vote target from block %s to block %s",
+		target.Hash(), restricted.Hash())
+	return restricted, nil
+}
@@ -0,0 +1,201 @@
+// Copyright 2024 ChainSafe Systems (ON)
+// SPDX-License-Identifier: LGPL-3.0-only
+
+package grandpa
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ChainSafe/gossamer/dot/types"
+	"github.com/ChainSafe/gossamer/lib/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+// newTestChain returns the headers of a chain of the given length, from its genesis block.
+func newTestChain(length int) []*types.Header {
+	headers := make([]*types.Header, length)
+	parentHash := common.Hash{}
+	for i := range headers {
+		headers[i] = &types.Header{
+			ParentHash: parentHash,
+			Number:     uint(i),
+		}
+		parentHash = headers[i].Hash()
+	}
+	return headers
+}
+
+func newChainBlockState(ctrl *gomock.Controller, headers []*types.Header) *MockBlockState {
+	blockState := NewMockBlockState(ctrl)
+	for _, header := range headers {
+		blockState.EXPECT().GetHeader(header.Hash()).Return(header, nil).AnyTimes()
+	}
+	return blockState
+}
+
+func Test_VotingRules_RestrictVote(t *testing.T) {
+	t.Parallel()
+
+	chain := newTestChain(21)
+
+	testCases := map[string]struct {
+		rules         VotingRules
+		base          *types.Header
+		best          *types.Header
+		current       *types.Header
+		expectedBlock *types.Header
+	}{
+		"no_rules": {
+			base:    chain[0],
+			best:    chain[20],
+			current: chain[20],
+		},
+		"before_best_block_by": {
+			rules:         VotingRules{BeforeBestBlockBy(2)},
+			base:          chain[0],
+			best:          chain[20],
+			current:       chain[20],
+			expectedBlock: chain[18],
+		},
+		"before_best_block_by_current_behind": {
+			rules:   VotingRules{BeforeBestBlockBy(2)},
+			base:    chain[0],
+			best:    chain[20],
+			current: chain[17],
+		},
+		"before_best_block_by_short_chain": {
+			rules:   VotingRules{BeforeBestBlockBy(2)},
+			base:    chain[0],
+			best:    chain[1],
+			current: chain[1],
+		},
+		"three_quarters": {
+			rules:         VotingRules{ThreeQuartersOfTheUnfinalizedChain{}},
+			base:          chain[4],
+			best:          chain[20],
+			current:       chain[20],
+			expectedBlock: chain[16],
+		},
+		"three_quarters_rounding": {
+			rules:         VotingRules{ThreeQuartersOfTheUnfinalizedChain{}},
+			base:          chain[0],
+			best:          chain[10],
+			current:       chain[10],
+			expectedBlock: chain[8],
+		},
+		"three_quarters_current_behind": {
+			rules:   VotingRules{ThreeQuartersOfTheUnfinalizedChain{}},
+			base:    chain[4],
+			best:    chain[20],
+			current: chain[12],
+		},
+		"most_restrictive_rule": {
+			rules:         VotingRules{BeforeBestBlockBy(2), ThreeQuartersOfTheUnfinalizedChain{}},
+			base:          chain[4],
+			best:          chain[20],
+			current:       chain[20],
+			expectedBlock: chain[16],
+		},
+		"rules_chained": {
+			rules:         VotingRules{ThreeQuartersOfTheUnfinalizedChain{}, BeforeBestBlockBy(8)},
+			base:          chain[4],
+			best:          chain[20],
+			current:       chain[20],
+			expectedBlock: chain[12],
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			blockState := newChainBlockState(ctrl, chain)
+
+			restricted, err := testCase.rules.RestrictVote(blockState,
+				testCase.base, testCase.best, testCase.current)
+
+			require.NoError(t, err)
+			assert.Equal(t, testCase.expectedBlock, restricted)
+		})
+	}
+}
+
+func Test_Service_restrictVoteTarget(t *testing.T) {
+	t.Parallel()
+
+	chain := newTestChain(11)
+	errTest := errors.New("test error")
+
+	testCases := map[string]struct {
+		votingRules    VotingRules
+		base           *types.Header
+		best           *types.Header
+		target         *types.Header
+		getHeaderErr   error
+		expectedTarget *types.Header
+		errWrapped     error
+		errMessage     string
+	}{
+		"no_voting_rules": {
+			base:           chain[0],
+			best:           chain[10],
+			target:         chain[10],
+			expectedTarget: chain[10],
+		},
+		"restricted": {
+			votingRules:    VotingRules{BeforeBestBlockBy(2)},
+			base:           chain[0],
+			best:           chain[10],
+			target:         chain[10],
+			expectedTarget: chain[8],
+		},
+		"not_restricted_below_base": {
+			votingRules:    VotingRules{BeforeBestBlockBy(5)},
+			base:           chain[7],
+			best:           chain[10],
+			target:         chain[10],
+			expectedTarget: chain[10],
+		},
+		"get_header_error": {
+			votingRules:  VotingRules{BeforeBestBlockBy(2)},
+			base:         chain[0],
+			best:         chain[10],
+			target:       chain[10],
+			getHeaderErr: errTest,
+			errWrapped:   errTest,
+			errMessage: "restricting vote target: getting parent header of block " +
+				chain[10].Hash().String() + ": test error",
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+
+			blockState := NewMockBlockState(ctrl)
+			if testCase.getHeaderErr != nil {
+				blockState.EXPECT().GetHeader(chain[9].Hash()).Return(nil, testCase.getHeaderErr)
+			} else {
+				blockState = newChainBlockState(ctrl, chain)
+			}
+			s := &Service{
+				blockState:  blockState,
+				votingRules: testCase.votingRules,
+			}
+
+			target, err := s.restrictVoteTarget(testCase.base, testCase.best, testCase.target)
+
+			assert.ErrorIs(t, err, testCase.errWrapped)
+			if testCase.errWrapped != nil {
+				assert.EqualError(t, err, testCase.errMessage)
+			}
+			assert.Equal(t, testCase.expectedTarget, target)
+		})
+	}
+}
@@ -57,6 +57,13 @@ func DecodeKeyPairFromHex(keystr []byte, keytype crypto.KeyType) (kp KeyPair, er
 		kp, err = sr25519.NewKeypairFromSeed(keystr)
 	case crypto.Ed25519Type:
 		kp, err = ed25519.NewKeypairFromSeed(keystr)
+	case crypto.Secp256k1Type:
+		var priv *secp256k1.PrivateKey
+		priv, err = secp256k1.NewPrivateKey(keystr)
+		if err != nil {
+			return nil, err
+		}
+		kp, err = secp256k1.NewKeypairFromPrivate(priv)
 	default:
 		return nil, errors.New("cannot decode key: invalid key type")
 	}
@@ -288,6 +295,8 @@ func DetermineKeyType(t string) crypto.KeyType {
 	case "acco", "babe", "para", "asgn",
 		"aura", "imon", "audi", "dumy":
 		return crypto.Sr25519Type
+	case "beef":
+		return crypto.Secp256k1Type
 	}
 	return crypto.UnknownType
 }
@@ -307,6 +316,10 @@ func HasKey(pubKeyStr, keyType string, keystore AddressKeypairGetter) (bool, err
 		pubKey, err = sr25519.NewPublicKey(keyBytes)
 	case crypto.Ed25519Type:
 		pubKey, err = ed25519.NewPublicKey(keyBytes)
+	case crypto.Secp256k1Type:
+		secpPubKey := new(secp256k1.PublicKey)
+		err = secpPubKey.Decode(keyBytes)
+		pubKey = secpPubKey
 	default:
 		err = fmt.Errorf("unknown key type: %s", keyType)
 	}
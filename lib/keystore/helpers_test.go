@@ -15,6 +15,7 @@
 	"github.com/ChainSafe/gossamer/lib/common"
 	"github.com/ChainSafe/gossamer/lib/crypto"
 	"github.com/ChainSafe/gossamer/lib/crypto/ed25519"
+	"github.com/ChainSafe/gossamer/lib/crypto/secp256k1"
 	"github.com/ChainSafe/gossamer/lib/crypto/sr25519"
 	"github.com/ChainSafe/gossamer/lib/utils"
 
@@ -51,6 +52,7 @@ func TestLoadKeystore(t *testing.T) {
 	{testType: "imon", expectedType: crypto.Sr25519Type},
 	{testType: "audi", expectedType: crypto.Sr25519Type},
 	{testType: "dumy", expectedType: crypto.Sr25519Type},
+	{testType: "beef", expectedType: crypto.Secp256k1Type},
 	{testType: "xxxx", expectedType: crypto.UnknownType},
 }
 
@@ -394,6 +396,17 @@ func TestDecodeKeyPairFromHex(t *testing.T) {
 	expectedPublic = "0xd3db685ed1f94c195dc3e72803fa3d8549df45381388e313fa8170f0b397895c"
 	require.Equal(t, kp.Public().Hex(), expectedPublic)
 
+	keytype = DetermineKeyType("beef")
+	keyBytes, err = common.HexToBytes("0x33a6f3093f158a7109f679410bef1a0c54168145e0cecb4df006c1c2fffb1f09")
+	require.NoError(t, err)
+
+	kp, err = DecodeKeyPairFromHex(keyBytes, keytype)
+	require.NoError(t, err)
+	require.IsType(t, &secp256k1.Keypair{}, kp)
+
+	expectedPublic = "0x03409094a319b2961660c3ebcc7d206266182c1b3e60d341b5fb17e6851865825c"
+	require.Equal(t, kp.Public().Hex(), expectedPublic)
+
 	_, err = DecodeKeyPairFromHex(nil, "")
 	require.Error(t, err, "cannot decode key: invalid key type")
 }
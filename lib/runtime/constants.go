@@ -30,6 +30,22 @@
 		"westend_runtime-v9111.compact.compressed.wasm?raw=true"
 )
 
+// Runtime API names as listed, blake2b8 hashed, in the runtime version API items.
+const (
+	// TaggedTransactionQueueAPI is the TaggedTransactionQueue runtime API
+	TaggedTransactionQueueAPI = "TaggedTransactionQueue"
+	// TransactionPaymentAPI is the TransactionPaymentApi runtime API
+	TransactionPaymentAPI = "TransactionPaymentApi"
+	// TransactionPaymentCallAPI is the TransactionPaymentCallApi runtime API
+	TransactionPaymentCallAPI = "TransactionPaymentCallApi"
+	// AccountNonceAPI is the AccountNonceApi runtime API
+	AccountNonceAPI = "AccountNonceApi"
+	// BabeAPI is the BabeApi runtime API
+	BabeAPI = "BabeApi"
+	// BeefyAPI is the BeefyApi runtime API
+	BeefyAPI = "BeefyApi"
+)
+
 const (
 	// CoreVersion is the runtime API call Core_version
 	CoreVersion = "Core_version"
@@ -43,6 +59,8 @@
 	TaggedTransactionQueueValidateTransaction = "TaggedTransactionQueue_validate_transaction"
 	// GrandpaAuthorities is the runtime API call GrandpaApi_grandpa_authorities
 	GrandpaAuthorities = "GrandpaApi_grandpa_authorities"
+	// AuthorityDiscoveryAPIAuthorities is the runtime API call AuthorityDiscoveryApi_authorities
+	AuthorityDiscoveryAPIAuthorities = "AuthorityDiscoveryApi_authorities"
 	// BabeAPIGenerateKeyOwnershipProof is the runtime API call BabeApi_generate_key_ownership_proof
 	BabeAPIGenerateKeyOwnershipProof = "BabeApi_generate_key_ownership_proof"
 	// BabeAPISubmitReportEquivocationUnsignedExtrinsic is the runtime API call
@@ -54,12 +72,20 @@
 	GrandpaGenerateKeyOwnershipProof = "GrandpaApi_generate_key_ownership_proof"
 	// BabeAPIConfiguration is the runtime API call BabeApi_configuration
 	BabeAPIConfiguration = "BabeApi_configuration"
+	// AuraAPISlotDuration is the runtime API call AuraApi_slot_duration
+	AuraAPISlotDuration = "AuraApi_slot_duration"
+	// AuraAPIAuthorities is the runtime API call AuraApi_authorities
+	AuraAPIAuthorities = "AuraApi_authorities"
+	// BeefyAPIValidatorSet is the runtime API call BeefyApi_validator_set
+	BeefyAPIValidatorSet = "BeefyApi_validator_set"
 	// BlockBuilderInherentExtrinsics is the runtime API call BlockBuilder_inherent_extrinsics
 	BlockBuilderInherentExtrinsics = "BlockBuilder_inherent_extrinsics"
 	// BlockBuilderApplyExtrinsic is the runtime API call BlockBuilder_apply_extrinsic
 	BlockBuilderApplyExtrinsic = "BlockBuilder_apply_extrinsic"
 	// BlockBuilderFinalizeBlock is the runtime API call BlockBuilder_finalize_block
 	BlockBuilderFinalizeBlock = "BlockBuilder_finalize_block"
+	// BlockBuilderCheckInherents is the runtime API call BlockBuilder_check_inherents
+	BlockBuilderCheckInherents = "BlockBuilder_check_inherents"
 	// DecodeSessionKeys is the runtime API call SessionKeys_decode_session_keys
 	DecodeSessionKeys = "SessionKeys_decode_session_keys"
 	// TransactionPaymentAPIQueryInfo returns information of a given extrinsic
@@ -68,4 +94,6 @@
 	TransactionPaymentCallAPIQueryCallInfo = "TransactionPaymentCallApi_query_call_info"
 	// TransactionPaymentCallAPIQueryCallFeeDetails returns call query call fee details
 	TransactionPaymentCallAPIQueryCallFeeDetails = "TransactionPaymentCallApi_query_call_fee_details"
+	// AccountNonceAPIAccountNonce is the runtime API call AccountNonceApi_account_nonce
+	AccountNonceAPIAccountNonce = "AccountNonceApi_account_nonce"
 )
@@ -24,7 +24,10 @@ type Instance interface {
 	Version() (Version, error)
 	Metadata() (metadata []byte, err error)
 	BabeConfiguration() (*types.BabeConfiguration, error)
+	AuraConfiguration() (*types.AuraConfiguration, error)
 	GrandpaAuthorities() ([]types.Authority, error)
+	AuthorityDiscoveryAuthorities() ([]types.AuthorityID, error)
+	BeefyValidatorSet() (*types.BeefyValidatorSet, error)
 	ValidateTransaction(e types.Extrinsic) (*transaction.Validity, error)
 	InitializeBlock(header *types.Header) error
 	InherentExtrinsics(data []byte) ([]byte, error)
@@ -33,7 +36,8 @@ type Instance interface {
 	ExecuteBlock(block *types.Block) ([]byte, error)
 	DecodeSessionKeys(enc []byte) ([]byte, error)
 	PaymentQueryInfo(ext []byte) (*types.RuntimeDispatchInfo, error)
-	CheckInherents()
+	AccountNonce(accountID []byte) (uint64, error)
+	CheckInherents(block *types.Block, data *types.InherentData) (*types.CheckInherentsResult, error)
 	BabeGenerateKeyOwnershipProof(slot uint64, authorityID [32]byte) (
 		types.OpaqueKeyOwnershipProof, error)
 	BabeSubmitReportEquivocationUnsignedExtrinsic(
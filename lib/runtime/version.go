@@ -33,20 +33,33 @@ type Version struct {
 
 var (
 	ErrDecodingVersionField = errors.New("decoding version field")
+	ErrAPINotFound          = errors.New("runtime API not found")
 )
 
-// TaggedTransactionQueueVersion returns the TaggedTransactionQueue API version
-func (v Version) TaggedTransactionQueueVersion() (txQueueVersion uint32, err error) {
-	encodedTaggedTransactionQueue, err := common.Blake2b8([]byte("TaggedTransactionQueue"))
+// APIVersion returns the version of the runtime API with the given name,
+// for example "TransactionPaymentApi", as listed in the runtime version API items.
+func (v Version) APIVersion(name string) (version uint32, err error) {
+	encodedName, err := common.Blake2b8([]byte(name))
 	if err != nil {
 		return 0, fmt.Errorf("getting blake2b8: %s", err)
 	}
 	for _, apiItem := range v.APIItems {
-		if apiItem.Name == encodedTaggedTransactionQueue {
+		if apiItem.Name == encodedName {
 			return apiItem.Ver, nil
 		}
 	}
-	return 0, errors.New("taggedTransactionQueueAPI not found")
+	return 0, fmt.Errorf("%w: %s", ErrAPINotFound, name)
+}
+
+// HasAPI returns true if the runtime API with the given name is exposed by the runtime.
+func (v Version) HasAPI(name string) bool {
+	_, err := v.APIVersion(name)
+	return err == nil
+}
+
+// TaggedTransactionQueueVersion returns the TaggedTransactionQueue API version
+func (v Version) TaggedTransactionQueueVersion() (txQueueVersion uint32, err error) {
+	return v.APIVersion(TaggedTransactionQueueAPI)
 }
 
 // DecodeVersion scale decodes the encoded version data.
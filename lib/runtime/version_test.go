@@ -205,3 +205,26 @@ func Test_Version_Scale(t *testing.T) {
 		})
 	}
 }
+
+func Test_Version_APIVersion(t *testing.T) {
+	t.Parallel()
+
+	babeAPIName := [8]byte{0xcb, 0xca, 0x25, 0xe3, 0x9f, 0x14, 0x23, 0x87}
+	version := Version{
+		APIItems: []APIItem{
+			{Name: [8]byte{1}, Ver: 1},
+			{Name: babeAPIName, Ver: 2},
+		},
+	}
+
+	apiVersion, err := version.APIVersion(BabeAPI)
+	require.NoError(t, err)
+	assert.Equal(t, uint32(2), apiVersion)
+	assert.True(t, version.HasAPI(BabeAPI))
+
+	apiVersion, err = version.APIVersion(AccountNonceAPI)
+	assert.ErrorIs(t, err, ErrAPINotFound)
+	assert.EqualError(t, err, "runtime API not found: AccountNonceApi")
+	assert.Zero(t, apiVersion)
+	assert.False(t, version.HasAPI(AccountNonceAPI))
+}
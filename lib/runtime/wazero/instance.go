@@ -719,7 +719,12 @@ func NewInstance(code []byte, cfg Config) (instance *Instance, err error) {
 	return instance, nil
 }
 
-var ErrExportFunctionNotFound = errors.New("export function not found")
+var (
+	ErrExportFunctionNotFound = errors.New("export function not found")
+
+	errUnsupportedAPIVersion = errors.New("unsupported runtime API version")
+	errUnexpectedNonceLength = errors.New("unexpected encoded nonce length")
+)
 
 func (i *Instance) Exec(function string, data []byte) ([]byte, error) {
 	i.Lock()
@@ -845,13 +850,89 @@ func (in *Instance) BabeConfiguration() (*types.BabeConfiguration, error) {
 		return nil, err
 	}
 
-	bc := new(types.BabeConfiguration)
-	err = scale.Unmarshal(data, bc)
+	version, err := in.Version()
+	if err != nil {
+		return nil, fmt.Errorf("getting runtime version: %w", err)
+	}
+
+	babeAPIVersion, err := version.APIVersion(runtime.BabeAPI)
+	if err != nil {
+		return nil, err
+	}
+
+	return decodeBabeConfiguration(babeAPIVersion, data)
+}
+
+// AuraConfiguration gets the slot duration and the authorities from the AuraApi runtime calls.
+func (in *Instance) AuraConfiguration() (*types.AuraConfiguration, error) {
+	data, err := in.Exec(runtime.AuraAPISlotDuration, []byte{})
+	if err != nil {
+		return nil, err
+	}
+
+	cfg := &types.AuraConfiguration{}
+	err = scale.Unmarshal(data, &cfg.SlotDuration)
+	if err != nil {
+		return nil, fmt.Errorf("decoding slot duration: %w", err)
+	}
+
+	data, err = in.Exec(runtime.AuraAPIAuthorities, []byte{})
 	if err != nil {
 		return nil, err
 	}
 
-	return bc, nil
+	err = scale.Unmarshal(data, &cfg.Authorities)
+	if err != nil {
+		return nil, fmt.Errorf("decoding authorities: %w", err)
+	}
+
+	return cfg, nil
+}
+
+// babeConfigurationV1 is the BABE configuration returned by the BabeApi version 1,
+// where the allowed slots are a single boolean indicating if secondary slots are enabled.
+type babeConfigurationV1 struct {
+	SlotDuration       uint64
+	EpochLength        uint64
+	C1                 uint64
+	C2                 uint64
+	GenesisAuthorities []types.AuthorityRaw
+	Randomness         [types.RandomnessLength]byte
+	SecondarySlots     bool
+}
+
+func decodeBabeConfiguration(babeAPIVersion uint32, data []byte) (*types.BabeConfiguration, error) {
+	switch babeAPIVersion {
+	case 1:
+		bcV1 := new(babeConfigurationV1)
+		err := scale.Unmarshal(data, bcV1)
+		if err != nil {
+			return nil, err
+		}
+
+		bc := &types.BabeConfiguration{
+			SlotDuration:       bcV1.SlotDuration,
+			EpochLength:        bcV1.EpochLength,
+			C1:                 bcV1.C1,
+			C2:                 bcV1.C2,
+			GenesisAuthorities: bcV1.GenesisAuthorities,
+			Randomness:         bcV1.Randomness,
+		}
+		if bcV1.SecondarySlots {
+			// secondary slots in version 1 are the plain secondary slots
+			bc.SecondarySlots = 1
+		}
+		return bc, nil
+	case 2:
+		bc := new(types.BabeConfiguration)
+		err := scale.Unmarshal(data, bc)
+		if err != nil {
+			return nil, err
+		}
+		return bc, nil
+	default:
+		return nil, fmt.Errorf("%w: %s version %d", errUnsupportedAPIVersion, runtime.BabeAPI, babeAPIVersion)
+	}
 }
 
 // GrandpaAuthorities returns the genesis authorities from the runtime
@@ -870,6 +951,38 @@ func (in *Instance) GrandpaAuthorities() ([]types.Authority, error) {
 	return types.GrandpaAuthoritiesRawToAuthorities(gar)
 }
 
+// BeefyValidatorSet returns the current BEEFY validator set, or nil if BEEFY is not enabled yet.
+func (in *Instance) BeefyValidatorSet() (*types.BeefyValidatorSet, error) {
+	ret, err := in.Exec(runtime.BeefyAPIValidatorSet, []byte{})
+	if err != nil {
+		return nil, err
+	}
+
+	var validatorSet *types.BeefyValidatorSet
+	err = scale.Unmarshal(ret, &validatorSet)
+	if err != nil {
+		return nil, fmt.Errorf("decoding validator set: %w", err)
+	}
+
+	return validatorSet, nil
+}
+
+// AuthorityDiscoveryAuthorities returns the authority discovery keys of the current authority set.
+func (in *Instance) AuthorityDiscoveryAuthorities() ([]types.AuthorityID, error) {
+	ret, err := in.Exec(runtime.AuthorityDiscoveryAPIAuthorities, []byte{})
+	if err != nil {
+		return nil, err
+	}
+
+	var authorities []types.AuthorityID
+	err = scale.Unmarshal(ret, &authorities)
+	if err != nil {
+		return nil, err
+	}
+
+	return authorities, nil
+}
+
 // BabeGenerateKeyOwnershipProof returns the babe key ownership proof from the runtime.
 func (in *Instance) BabeGenerateKeyOwnershipProof(slot uint64, authorityID [32]byte) (
 	types.OpaqueKeyOwnershipProof, error) {
@@ -963,6 +1076,20 @@ func (in *Instance) FinalizeBlock() (*types.Header, error) {
 
 // ExecuteBlock calls runtime function Core_execute_block
 func (in *Instance) ExecuteBlock(block *types.Block) ([]byte, error) {
+	bdEnc, err := encodeUnsealedBlock(block)
+	if err != nil {
+		return nil, err
+	}
+
+	// start an changeset at the beginning of the block execution
+	// then clear prefix can work correctly by ignoring
+	// keys included under current block execution
+	in.Context.Storage.StartTransaction()
+	return in.Exec(runtime.CoreExecuteBlock, bdEnc)
+}
+
+// encodeUnsealedBlock encodes a copy of the block without its seal digest.
+func encodeUnsealedBlock(block *types.Block) ([]byte, error) {
 	// copy block since we're going to modify it
 	b, err := block.DeepCopy()
 	if err != nil {
@@ -988,16 +1115,7 @@ func (in *Instance) ExecuteBlock(block *types.Block) ([]byte, error) {
 		}
 	}
 
-	bdEnc, err := b.Encode()
-	if err != nil {
-		return nil, err
-	}
-
-	// start an changeset at the beginning of the block execution
-	// then clear prefix can work correctly by ignoring
-	// keys included under current block execution
-	in.Context.Storage.StartTransaction()
-	return in.Exec(runtime.CoreExecuteBlock, bdEnc)
+	return b.Encode()
 }
 
 // DecodeSessionKeys decodes the given public session keys. Returns a list of raw public keys including their key type.
@@ -1017,12 +1135,7 @@ func (in *Instance) PaymentQueryInfo(ext []byte) (*types.RuntimeDispatchInfo, er
 		return nil, err
 	}
 
-	dispatchInfo := new(types.RuntimeDispatchInfo)
-	if err = scale.Unmarshal(resBytes, dispatchInfo); err != nil {
-		return nil, err
-	}
-
-	return dispatchInfo, nil
+	return in.decodeRuntimeDispatchInfo(runtime.TransactionPaymentAPI, resBytes)
 }
 
 // QueryCallInfo returns information of a given extrinsic
@@ -1037,12 +1150,94 @@ func (in *Instance) QueryCallInfo(ext []byte) (*types.RuntimeDispatchInfo, error
 		return nil, err
 	}
 
-	dispatchInfo := new(types.RuntimeDispatchInfo)
-	if err = scale.Unmarshal(resBytes, dispatchInfo); err != nil {
+	return in.decodeRuntimeDispatchInfo(runtime.TransactionPaymentCallAPI, resBytes)
+}
+
+// runtimeDispatchInfoV2 is the RuntimeDispatchInfo returned by the transaction payment
+// runtime APIs from version 2, where the weight is made of the compact encoded
+// reference time and proof size.
+type runtimeDispatchInfoV2 struct {
+	Weight struct {
+		RefTime   uint
+		ProofSize uint
+	}
+	Class      int
+	PartialFee *scale.Uint128
+}
+
+// decodeRuntimeDispatchInfo decodes the RuntimeDispatchInfo returned by the transaction
+// payment runtime API given, using the encoding matching the API version of the runtime.
+func (in *Instance) decodeRuntimeDispatchInfo(apiName string, encoded []byte) (*types.RuntimeDispatchInfo, error) {
+	version, err := in.Version()
+	if err != nil {
+		return nil, fmt.Errorf("getting runtime version: %w", err)
+	}
+
+	apiVersion, err := version.APIVersion(apiName)
+	if err != nil {
 		return nil, err
 	}
 
-	return dispatchInfo, nil
+	switch {
+	case apiVersion == 1:
+		dispatchInfo := new(types.RuntimeDispatchInfo)
+		if err = scale.Unmarshal(encoded, dispatchInfo); err != nil {
+			return nil, err
+		}
+		return dispatchInfo, nil
+	case apiVersion >= 2 && apiVersion <= 4:
+		dispatchInfoV2 := new(runtimeDispatchInfoV2)
+		if err = scale.Unmarshal(encoded, dispatchInfoV2); err != nil {
+			return nil, err
+		}
+		return &types.RuntimeDispatchInfo{
+			Weight:     uint64(dispatchInfoV2.Weight.RefTime),
+			Class:      dispatchInfoV2.Class,
+			PartialFee: dispatchInfoV2.PartialFee,
+		}, nil
+	default:
+		return nil, fmt.Errorf("%w: %s version %d", errUnsupportedAPIVersion, apiName, apiVersion)
+	}
+}
+
+// AccountNonce returns the nonce of the given account using the runtime API
+// AccountNonceApi_account_nonce.
+func (in *Instance) AccountNonce(accountID []byte) (nonce uint64, err error) {
+	version, err := in.Version()
+	if err != nil {
+		return 0, fmt.Errorf("getting runtime version: %w", err)
+	}
+
+	apiVersion, err := version.APIVersion(runtime.AccountNonceAPI)
+	if err != nil {
+		return 0, err
+	}
+
+	if apiVersion != 1 {
+		return 0, fmt.Errorf("%w: %s version %d", errUnsupportedAPIVersion, runtime.AccountNonceAPI, apiVersion)
+	}
+
+	resBytes, err := in.Exec(runtime.AccountNonceAPIAccountNonce, accountID)
+	if err != nil {
+		return 0, err
+	}
+
+	// the nonce type is defined by the runtime, it is either an u32 or an u64.
+	switch len(resBytes) {
+	case 4:
+		var nonce32 uint32
+		err = scale.Unmarshal(resBytes, &nonce32)
+		nonce = uint64(nonce32)
+	case 8:
+		err = scale.Unmarshal(resBytes, &nonce)
+	default:
+		return 0, fmt.Errorf("%w: %d bytes", errUnexpectedNonceLength, len(resBytes))
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	return nonce, nil
 }
 
 // QueryCallFeeDetails returns call fee details for given call
@@ -1065,9 +1260,37 @@ func (in *Instance) QueryCallFeeDetails(ext []byte) (*types.FeeDetails, error) {
 	return dispatchInfo, nil
 }
 
-// CheckInherents checks inherents in the block verification process.
-// TODO: use this in block verification process (#1873)
-func (*Instance) CheckInherents() {}
+// CheckInherents checks the inherents of the block without its seal against the inherent data,
+// using the runtime API function BlockBuilder_check_inherents. The changes made to the storage
+// by the check are rolled back.
+func (in *Instance) CheckInherents(block *types.Block, data *types.InherentData) (
+	*types.CheckInherentsResult, error) {
+	bdEnc, err := encodeUnsealedBlock(block)
+	if err != nil {
+		return nil, fmt.Errorf("encoding block: %w", err)
+	}
+
+	dataEnc, err := data.Encode()
+	if err != nil {
+		return nil, fmt.Errorf("encoding inherent data: %w", err)
+	}
+
+	in.Context.Storage.StartTransaction()
+	defer in.Context.Storage.RollbackTransaction()
+
+	ret, err := in.Exec(runtime.BlockBuilderCheckInherents, append(bdEnc, dataEnc...))
+	if err != nil {
+		return nil, err
+	}
+
+	result := new(types.CheckInherentsResult)
+	err = scale.Unmarshal(ret, result)
+	if err != nil {
+		return nil, fmt.Errorf("decoding check inherents result: %w", err)
+	}
+
+	return result, nil
+}
 
 // GrandpaGenerateKeyOwnershipProof returns grandpa key ownership proof from the runtime.
 func (in *Instance) GrandpaGenerateKeyOwnershipProof(authSetID uint64, authorityID ed25519.PublicKeyBytes) (
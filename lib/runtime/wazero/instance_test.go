@@ -1339,3 +1339,68 @@ func TestInstance_GrandpaSubmitReportEquivocationUnsignedExtrinsic(t *testing.T)
 	err = runtime.GrandpaSubmitReportEquivocationUnsignedExtrinsic(equivocationProof, opaqueKeyOwnershipProof)
 	require.NoError(t, err)
 }
+
+func Test_decodeBabeConfiguration(t *testing.T) {
+	t.Parallel()
+
+	authorities := []types.AuthorityRaw{{Key: [32]byte{1}, Weight: 1}}
+	randomness := [types.RandomnessLength]byte{2}
+
+	encodedV1 := scale.MustMarshal(babeConfigurationV1{
+		SlotDuration:       6000,
+		EpochLength:        600,
+		C1:                 1,
+		C2:                 4,
+		GenesisAuthorities: authorities,
+		Randomness:         randomness,
+		SecondarySlots:     true,
+	})
+	expected := &types.BabeConfiguration{
+		SlotDuration:       6000,
+		EpochLength:        600,
+		C1:                 1,
+		C2:                 4,
+		GenesisAuthorities: authorities,
+		Randomness:         randomness,
+		SecondarySlots:     1,
+	}
+	encodedV2 := scale.MustMarshal(*expected)
+
+	testCases := map[string]struct {
+		apiVersion uint32
+		data       []byte
+		expected   *types.BabeConfiguration
+		errMessage string
+	}{
+		"version_1": {
+			apiVersion: 1,
+			data:       encodedV1,
+			expected:   expected,
+		},
+		"version_2": {
+			apiVersion: 2,
+			data:       encodedV2,
+			expected:   expected,
+		},
+		"unsupported_version": {
+			apiVersion: 3,
+			data:       encodedV2,
+			errMessage: "unsupported runtime API version: BabeApi version 3",
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			bc, err := decodeBabeConfiguration(testCase.apiVersion, testCase.data)
+			if testCase.errMessage != "" {
+				assert.EqualError(t, err, testCase.errMessage)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, testCase.expected, bc)
+		})
+	}
+}
@@ -277,19 +277,19 @@ func (rn *RoundNetwork) AddNode(
 }
 
 type GlobalMessageNetwork struct {
-	*BroadcastNetwork[globalInItem, CommunicationOut]
+	*BroadcastNetwork[GlobalInItem, CommunicationOut]
 }
 
 func NewGlobalMessageNetwork() *GlobalMessageNetwork {
-	bn := NewBroadcastNetwork[globalInItem, CommunicationOut]()
+	bn := NewBroadcastNetwork[GlobalInItem, CommunicationOut]()
 	gmn := GlobalMessageNetwork{bn}
 	return &gmn
 }
 
 func (gmn *GlobalMessageNetwork) AddNode(
-	f func(CommunicationOut) globalInItem,
+	f func(CommunicationOut) GlobalInItem,
 	out chan CommunicationOut,
-) (in chan globalInItem) {
+) (in chan GlobalInItem) {
 	return gmn.BroadcastNetwork.AddNode(f, out)
 }
 
@@ -339,22 +339,22 @@ func (n *Network) MakeRoundComms(
 	)
 }
 
-func (n *Network) MakeGlobalComms(out chan CommunicationOut) chan globalInItem {
+func (n *Network) MakeGlobalComms(out chan CommunicationOut) chan GlobalInItem {
 	n.mtx.Lock()
 	defer n.mtx.Unlock()
 
-	return n.globalMessages.AddNode(func(message CommunicationOut) globalInItem {
+	return n.globalMessages.AddNode(func(message CommunicationOut) GlobalInItem {
 		if message.variant == nil {
 			panic("nil message variant")
 		}
 		switch message := message.variant.(type) {
 		case CommunicationOutCommit[string, uint32, Signature, ID]:
-			ci := newCommunicationIn[string, uint32, Signature, ID](CommunicationInCommit[string, uint32, Signature, ID]{
+			ci := NewCommunicationIn[string, uint32, Signature, ID](CommunicationInCommit[string, uint32, Signature, ID]{
 				Number:        message.Number,
 				CompactCommit: message.Commit.CompactCommit(),
 				Callback:      nil,
 			})
-			return globalInItem{
+			return GlobalInItem{
 				CommunicationIn: ci,
 			}
 		default:
@@ -364,5 +364,5 @@ func (n *Network) MakeGlobalComms(out chan CommunicationOut) chan globalInItem {
 }
 
 func (n *Network) SendMessage(message CommunicationIn) {
-	n.globalMessages.SendMessage(globalInItem{message, nil})
+	n.globalMessages.SendMessage(GlobalInItem{message, nil})
 }
@@ -426,6 +426,11 @@ func (hv *HistoricalVotes[Hash, Number, Signature, ID]) PushVote(msg SignedMessa
 	hv.seen = append(hv.seen, msg)
 }
 
+// Seen returns all the votes seen in the round.
+func (hv HistoricalVotes[Hash, Number, Signature, ID]) Seen() []SignedMessage[Hash, Number, Signature, ID] {
+	return hv.seen
+}
+
 // SetPrevotedIdx sets the number of messages seen before prevoting.
 func (hv *HistoricalVotes[Hash, Number, Signature, ID]) SetPrevotedIdx() {
 	pi := uint64(len(hv.seen))
@@ -0,0 +1,45 @@
+// Copyright 2024 ChainSafe Systems (ON)
+// SPDX-License-Identifier: LGPL-3.0-only
+
+package grandpa
+
+import (
+	"sync"
+	"time"
+)
+
+// durationTimer is a `Timer` which elapses once the given duration has passed.
+type durationTimer struct {
+	mtx     sync.Mutex
+	waker   *waker
+	elapsed bool
+}
+
+// NewTimer returns a `Timer` which elapses after the given duration, for environments
+// to return in `RoundData` and from `RoundCommitTimer`.
+func NewTimer(duration time.Duration) Timer {
+	t := &durationTimer{}
+	time.AfterFunc(duration, t.elapse)
+	return t
+}
+
+func (t *durationTimer) elapse() {
+	t.mtx.Lock()
+	defer t.mtx.Unlock()
+	t.elapsed = true
+	if t.waker != nil {
+		t.waker.wake()
+	}
+}
+
+func (t *durationTimer) SetWaker(waker *waker) {
+	t.mtx.Lock()
+	defer t.mtx.Unlock()
+	t.waker = waker
+}
+
+func (t *durationTimer) Elapsed() (bool, error) {
+	t.mtx.Lock()
+	defer t.mtx.Unlock()
+	return t.elapsed, nil
+}
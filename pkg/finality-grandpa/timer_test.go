@@ -0,0 +1,33 @@
+// Copyright 2024 ChainSafe Systems (ON)
+// SPDX-License-Identifier: LGPL-3.0-only
+
+package grandpa
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewTimer(t *testing.T) {
+	t.Parallel()
+
+	timer := NewTimer(10 * time.Millisecond)
+	waker := newWaker()
+	timer.SetWaker(waker)
+
+	elapsed, err := timer.Elapsed()
+	require.NoError(t, err)
+	require.False(t, elapsed)
+
+	select {
+	case <-waker.channel():
+	case <-time.After(time.Second):
+		t.Fatal("timer did not wake the waker")
+	}
+
+	elapsed, err = timer.Elapsed()
+	require.NoError(t, err)
+	require.True(t, elapsed)
+}
@@ -16,6 +16,7 @@ type wakerChan[Item any] struct {
 	in    chan Item
 	out   chan Item
 	waker *waker
+	mtx   sync.Mutex
 }
 
 func newWakerChan[Item any](in chan Item) *wakerChan[Item] {
@@ -31,14 +32,18 @@ func newWakerChan[Item any](in chan Item) *wakerChan[Item] {
 func (wc *wakerChan[Item]) start() {
 	defer close(wc.out)
 	for item := range wc.in {
+		wc.mtx.Lock()
 		if wc.waker != nil {
 			wc.waker.wake()
 		}
+		wc.mtx.Unlock()
 		wc.out <- item
 	}
 }
 
 func (wc *wakerChan[Item]) setWaker(waker *waker) {
+	wc.mtx.Lock()
+	defer wc.mtx.Unlock()
 	wc.waker = waker
 }
 
@@ -329,6 +334,11 @@ type CommunicationOut struct {
 	variant any
 }
 
+// Variant returns the variant of the `CommunicationOut`.
+func (co CommunicationOut) Variant() any {
+	return co.variant
+}
+
 // CommuincationOutVariants is interface constraint of `CommunicationOut`
 type CommuincationOutVariants[
 	Hash constraints.Ordered,
@@ -453,6 +463,7 @@ type GoodCatchUp struct{
 // BadCatchUp is the result of processing for a bad catch up.
 type BadCatchUp struct{}
 
+// CommunicationIn is communication between nodes that is not round-localised.
 type CommunicationIn struct {
 	variant any
 }
@@ -464,7 +475,8 @@ func setCommunicationIn[
 	ci.variant = variant
 }
 
-func newCommunicationIn[
+// NewCommunicationIn creates a new `CommunicationIn` with the given variant.
+func NewCommunicationIn[
 	Hash constraints.Ordered, Number constraints.Unsigned, Signature comparable, ID constraints.Ordered,
 	T CommunicationInVariants[Hash, Number, Signature, ID],
 ](variant T) CommunicationIn {
@@ -473,6 +485,11 @@ func newCommunicationIn[
 	return ci
 }
 
+// Variant returns the variant of the `CommunicationIn`.
+func (ci CommunicationIn) Variant() any {
+	return ci.variant
+}
+
 type CommunicationInVariants[
 	Hash constraints.Ordered,
 	Number constraints.Unsigned,
@@ -502,7 +519,8 @@ func newCommunicationIn[
 	Callback func(CatchUpProcessingOutcome)
 }
 
-type globalInItem struct {
+// GlobalInItem is the item type of the input stream of commit and catch up messages of the voter.
+type GlobalInItem struct {
 	CommunicationIn
 	Error error
 }
@@ -531,7 +549,7 @@ type Voter[Hash constraints.Ordered, Number constraints.Unsigned, Signature comp
 	inner                  *innerVoterState[Hash, Number, Signature, ID, Environment[Hash, Number, Signature, ID]]
 	finalizedNotifications *wakerChan[finalizedNotification[Hash, Number, Signature, ID]]
 	lastFinalizedNumber    Number
-	globalIn               *wakerChan[globalInItem]
+	globalIn               *wakerChan[GlobalInItem]
 	globalOut              *buffered[CommunicationOut]
 	// the commit protocol might finalize further than the current round (if we're
 	// behind), we keep track of last finalized in round so we don't violate any
@@ -557,7 +575,7 @@ type Voter[Hash constraints.Ordered, Number constraints.Unsigned, Signature comp
 func NewVoter[Hash constraints.Ordered, Number constraints.Unsigned, Signature comparable, ID constraints.Ordered](
 	env Environment[Hash, Number, Signature, ID],
 	voters VoterSet[ID],
-	globalIn chan globalInItem,
+	globalIn chan GlobalInItem,
 	lastRoundNumber uint64,
 	lastRoundVotes []SignedMessage[Hash, Number, Signature, ID],
 	lastRoundBase HashNumber[Hash, Number],
@@ -752,6 +770,7 @@ func (v *Voter[Hash, Number, Signature, ID]) processIncoming(waker *waker) error
 
 				round := validateCatchUp(catchUp, v.env, v.voters, v.inner.bestRound.roundNumber())
 				if round == nil {
+					v.inner.Unlock()
 					if processCatchUpOutcome != nil {
 						processCatchUpOutcome(newCatchUpProcessingOutcome(CatchUpProcessingOutcomeBad{}))
 					}
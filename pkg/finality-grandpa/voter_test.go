@@ -33,7 +33,7 @@ func TestVoter_TalkingToMyself(t *testing.T) {
 	voter, globalOut := NewVoter[string, uint32, Signature, ID](
 		&env,
 		*voters,
-		make(chan globalInItem),
+		make(chan GlobalInItem),
 		0,
 		nil,
 		lastFinalized,
@@ -84,7 +84,7 @@ func TestVoter_FinalizingAtFaultThreshold(t *testing.T) {
 		voter, globalOut := NewVoter[string, uint32, Signature, ID](
 			&env,
 			*voters,
-			make(chan globalInItem),
+			make(chan GlobalInItem),
 			0,
 			nil,
 			lastFinalized,
@@ -138,7 +138,7 @@ func TestVoter_ExposingVoterState(t *testing.T) {
 		voter, globalOut := NewVoter[string, uint32, Signature, ID](
 			&env,
 			*voterSet,
-			make(chan globalInItem),
+			make(chan GlobalInItem),
 			0,
 			nil,
 			lastFinalized,
@@ -222,7 +222,7 @@ func TestVoter_BroadcastCommit(t *testing.T) {
 	voter, globalOut := NewVoter[string, uint32, Signature, ID](
 		&env,
 		*voterSet,
-		make(chan globalInItem),
+		make(chan GlobalInItem),
 		0,
 		nil,
 		lastFinalized,
@@ -462,7 +462,7 @@ func TestVoter_SkipsToLatestRoundAfterCatchUp(t *testing.T) {
 	}
 
 	// send in a catch-up message for round 5.
-	ci := newCommunicationIn[string, uint32, Signature, ID](CommunicationInCatchUp[string, uint32, Signature, ID]{
+	ci := NewCommunicationIn[string, uint32, Signature, ID](CommunicationInCatchUp[string, uint32, Signature, ID]{
 		CatchUp: CatchUp[string, uint32, Signature, ID]{
 			BaseNumber:  1,
 			BaseHash:    GenesisHash,
@@ -531,6 +531,79 @@ func TestVoter_SkipsToLatestRoundAfterCatchUp(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+func TestVoter_ProcessesCatchUpAfterInvalidCatchUp(t *testing.T) {
+	weights := make([]IDWeight[ID], 3)
+	for i := range weights {
+		weights[i] = IDWeight[ID]{ID(i), 1}
+	}
+	voterSet := NewVoterSet(weights)
+
+	network := NewNetwork()
+	defer network.Stop()
+
+	env := newEnvironment(network, ID(4))
+	var lastFinalized HashNumber[string, uint32]
+	env.WithChain(func(chain *dummyChain) {
+		chain.PushBlocks(GenesisHash, []string{"A", "B", "C", "D", "E"})
+		lastFinalized.Hash, lastFinalized.Number = chain.LastFinalized()
+	})
+
+	unsyncedVoter, globalOut := NewVoter[string, uint32, Signature, ID](
+		&env,
+		*voterSet,
+		nil,
+		0,
+		nil,
+		lastFinalized,
+		lastFinalized,
+	)
+	globalIn := network.MakeGlobalComms(globalOut)
+	unsyncedVoter.globalIn = newWakerChan(globalIn)
+
+	catchUp := func(ids ...uint32) CatchUp[string, uint32, Signature, ID] {
+		catchUp := CatchUp[string, uint32, Signature, ID]{
+			BaseNumber:  1,
+			BaseHash:    GenesisHash,
+			RoundNumber: 5,
+		}
+		for _, id := range ids {
+			catchUp.Prevotes = append(catchUp.Prevotes, SignedPrevote[string, uint32, Signature, ID]{
+				Prevote:   Prevote[string, uint32]{"C", 4},
+				ID:        ID(id),
+				Signature: Signature(99),
+			})
+			catchUp.Precommits = append(catchUp.Precommits, SignedPrecommit[string, uint32, Signature, ID]{
+				Precommit: Precommit[string, uint32]{"C", 4},
+				ID:        ID(id),
+				Signature: Signature(99),
+			})
+		}
+		return catchUp
+	}
+
+	// the catch-up signed by a non voter is invalid and must not stop the voter
+	// from processing the catch-up sent after it.
+	outcomes := make(chan CatchUpProcessingOutcome, 2)
+	for _, ids := range [][]uint32{{0, 1, 7}, {0, 1, 2}} {
+		network.SendMessage(NewCommunicationIn[string, uint32, Signature, ID](
+			CommunicationInCatchUp[string, uint32, Signature, ID]{
+				CatchUp: catchUp(ids...),
+				Callback: func(outcome CatchUpProcessingOutcome) {
+					outcomes <- outcome
+				},
+			}))
+	}
+
+	go unsyncedVoter.Start()
+
+	assert.IsType(t, CatchUpProcessingOutcomeBad{}, (<-outcomes).variant)
+	assert.IsType(t, CatchUpProcessingOutcomeGood{}, (<-outcomes).variant)
+
+	err := unsyncedVoter.Stop()
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(6), unsyncedVoter.VoterState().Get().BestRound.Number)
+}
+
 func TestVoter_PickUpFromPriorWithoutGrandparentState(t *testing.T) {
 	localID := ID(5)
 	voterSet := NewVoterSet([]IDWeight[ID]{{localID, 100}})
@@ -62,7 +62,7 @@ func Default() cfg.Config {
 			Host:              "localhost",
 			Modules: []string{
 				"system", "author", "chain", "state", "rpc",
-				"grandpa", "offchain", "childstate", "syncstate", "payment"},
+				"grandpa", "babe", "beefy", "offchain", "childstate", "syncstate", "payment"},
 		},
 		State:  &cfg.StateConfig{},
 		Pprof:  &cfg.PprofConfig{},